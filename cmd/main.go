@@ -2,9 +2,18 @@ package main
 
 import (
 	"blogg/config"
+	"blogg/internal/adapters/driven/mail"
+	"blogg/internal/adapters/driven/memory"
 	repository "blogg/internal/adapters/driven/mysql"
+	"blogg/internal/adapters/driven/oidc"
 	httpAdapter "blogg/internal/adapters/driving/http"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/adapters/driving/worker"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
 	"blogg/internal/core/service"
+	"blogg/utils/csrf"
+	jwthelper "blogg/utils/jwt"
 	"context"
 	"fmt"
 	"log"
@@ -25,16 +34,121 @@ func main() {
 		fmt.Println(err)
 	}
 
+	jwtKeys, err := jwthelper.DefaultKeySet()
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	if jwtKeys.UsesDefaultSecret() {
+		if cfg.IsProduction() {
+			log.Fatal("Refusing to start: JWT_SECRET is the built-in default. Set JWT_PRIVATE_KEY_FILE or a strong JWT_SECRET")
+		}
+		log.Println("Warning: JWT_SECRET is not set; tokens are signed with the built-in default secret")
+	}
+
+	var mailer port.MailerPort = mail.NewOutbox(cfg.Mail.OutboxDir, cfg.Mail.From)
+	if cfg.Mail.Driver == "smtp" {
+		mailer = mail.NewSMTPMailer(mail.SMTPConfig{
+			Host:     cfg.Mail.SMTPHost,
+			Port:     cfg.Mail.SMTPPort,
+			Username: cfg.Mail.SMTPUsername,
+			Password: cfg.Mail.SMTPPassword,
+			From:     cfg.Mail.From,
+		})
+	}
+
 	userRepo := repository.NewAuthRepository(db)
-	authService := service.NewAuthService(userRepo)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	sessionRepo := repository.NewSessionRepository(db)
+	var revocationStore port.TokenRevocationStorePort = repository.NewTokenRevocationRepository(db)
+	if cfg.Auth.RevocationStore == "memory" {
+		revocationStore = memory.NewTokenRevocationStore()
+	}
+	var loginAttempts port.LoginAttemptStorePort = repository.NewLoginAttemptRepository(db)
+	if cfg.Auth.LoginAttemptStore == "memory" {
+		loginAttempts = memory.NewLoginAttemptStore()
+	}
+	verificationRepo := repository.NewEmailVerificationRepository(db)
+	verificationService := service.NewEmailVerificationService(userRepo, verificationRepo, mailer, cfg.Auth.EmailVerificationURL)
+	verificationHandler := httpAdapter.NewVerificationHandler(verificationService)
+	mfaRepo := repository.NewMFARepository(db)
+	mfaService := service.NewMFAService(userRepo, mfaRepo, sessionRepo, loginAttempts, cfg.Auth.MFAIssuer)
+	mfaHandler := httpAdapter.NewMFAHandler(mfaService)
+	authService := service.NewAuthService(userRepo, refreshTokenRepo, sessionRepo, revocationStore, verificationService, mfaService, loginAttempts)
 	authHandler := httpAdapter.NewAuthHandler(authService)
+	oidcProviders := make(map[string]port.OIDCProviderPort, len(cfg.OIDC))
+	for _, p := range cfg.OIDC {
+		oidcProviders[p.Name] = oidc.NewProvider(oidc.Config{
+			Issuer:       p.Issuer,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+		})
+	}
+	identityRepo := repository.NewIdentityRepository(db)
+	oidcService := service.NewOIDCService(oidcProviders, identityRepo, userRepo, authService, verificationService)
+	oidcHandler := httpAdapter.NewOIDCHandler(oidcService)
+	accessTokenRepo := repository.NewAccessTokenRepository(db)
+	accessTokenService := service.NewAccessTokenService(userRepo, accessTokenRepo)
+	accessTokenHandler := httpAdapter.NewAccessTokenHandler(accessTokenService)
+
+	categoryRepo := repository.NewCategoryRepository(db)
+	categoryService := service.NewCategoryService(categoryRepo)
+	categoryHandler := httpAdapter.NewCategoryHandler(categoryService)
+
+	tagRepo := repository.NewTagRepository(db)
+	tagService := service.NewTagService(tagRepo)
+	tagHandler := httpAdapter.NewTagHandler(tagService)
 
 	postRepo := repository.NewPostRepository(db)
-	postService := service.NewPostService(postRepo)
+	revisionRepo := repository.NewRevisionRepository(db)
+	searchRepo := repository.NewSearchRepository(db)
+	postService := service.NewPostService(postRepo, categoryRepo, tagRepo, revisionRepo, searchRepo, userRepo, cfg.Auth.RequireVerifiedEmail)
 	postHandler := httpAdapter.NewPostHandler(postService)
 
+	userService := service.NewUserService(userRepo, authService, postService)
+	userHandler := httpAdapter.NewUserHandler(userService)
+
+	passwordResetRepo := repository.NewPasswordResetRepository(db)
+	passwordService := service.NewPasswordResetService(userRepo, passwordResetRepo, authService, accessTokenService, mailer, cfg.Auth.PasswordResetURL)
+	passwordHandler := httpAdapter.NewPasswordHandler(passwordService)
+
+	var rateLimitStore port.RateLimitStorePort = repository.NewRateLimitRepository(db)
+	if cfg.RateLimit.Store == "memory" {
+		rateLimitStore = memory.NewRateLimitStore()
+	}
+	rateLimitPolicies := make(map[string]domain.RateLimitPolicy, len(cfg.RateLimit.Policies))
+	for group, p := range cfg.RateLimit.Policies {
+		rateLimitPolicies[group] = domain.RateLimitPolicy{Limit: p.Limit, Period: p.Period, KeyBy: p.KeyBy}
+	}
+	rateLimiter := middleware.NewRateLimiter(rateLimitStore, rateLimitPolicies)
+
+	if cfg.Auth.CSRFSecret == "" {
+		log.Println("Warning: AUTH_CSRF_SECRET is not set; CSRF tokens will not survive a restart or work across instances")
+	}
+	csrfTokens := csrf.NewTokens([]byte(cfg.Auth.CSRFSecret))
+
+	// Background jobs
+	publisher := worker.NewScheduledPublisher(postService, cfg.Jobs.PublishInterval)
+	publisher.Start()
+	trashPurger := worker.NewTrashPurger(postService, cfg.Jobs.TrashRetention, cfg.Jobs.TrashPurgeInterval)
+	trashPurger.Start()
+	revocationCleaner := worker.NewRevocationCleaner(authService, cfg.Jobs.RevocationCleanup)
+	revocationCleaner.Start()
+	loginAttemptCleaner := worker.NewLoginAttemptCleaner(authService, cfg.Jobs.LoginAttemptCleanup)
+	loginAttemptCleaner.Start()
+	sessionCleaner := worker.NewSessionCleaner(authService, cfg.Jobs.SessionCleanup)
+	sessionCleaner.Start()
+	rateLimitCleaner := worker.NewRateLimitCleaner(rateLimitStore, cfg.Jobs.RateLimitCleanup)
+	rateLimitCleaner.Start()
+
+	ipExtractor, err := middleware.NewIPExtractor(cfg.Server.TrustedProxies)
+	if err != nil {
+		log.Fatalf("Invalid SERVER_TRUSTED_PROXIES: %v", err)
+	}
+
 	// Setup router
-	router := httpAdapter.NewRouter(authHandler, postHandler)
+	router := httpAdapter.NewRouter(authHandler, postHandler, categoryHandler, tagHandler, userHandler, passwordHandler, verificationHandler, mfaHandler, accessTokenHandler, oidcHandler, revocationStore, accessTokenService, rateLimiter, csrfTokens, ipExtractor)
 	router.SetupRoutes()
 
 	// Start server in goroutine
@@ -57,6 +171,13 @@ func main() {
 	if err := router.Shutdown(); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
+	passwordService.Wait()
+	publisher.Stop()
+	trashPurger.Stop()
+	revocationCleaner.Stop()
+	loginAttemptCleaner.Stop()
+	sessionCleaner.Stop()
+	rateLimitCleaner.Stop()
 
 	<-ctx.Done()
 	log.Println("Server exited")
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -23,12 +24,87 @@ type DatabaseConfig struct {
 type ServerConfig struct {
 	Host string
 	Port string
+	// TrustedProxies are the IPs or CIDR ranges whose X-Forwarded-For is
+	// believed. When empty, clients are identified by the connection address.
+	TrustedProxies []string
+}
+
+// minTrashRetentionDays keeps a misconfigured retention from purging posts
+// the moment they are deleted
+const minTrashRetentionDays = 1
+
+// JobsConfig controls the background jobs started with the server
+type JobsConfig struct {
+	PublishInterval     time.Duration // How often scheduled posts are checked
+	TrashRetention      time.Duration // How long deleted posts stay restorable
+	TrashPurgeInterval  time.Duration
+	RevocationCleanup   time.Duration // How often expired token revocations and refresh tokens are dropped
+	LoginAttemptCleanup time.Duration // How often old failed login counts are dropped
+	SessionCleanup      time.Duration // How often sessions past their refresh token lifetime are dropped
+	RateLimitCleanup    time.Duration // How often refilled rate limit buckets are dropped
+}
+
+type AuthConfig struct {
+	RevocationStore      string // "mysql" or "memory"; memory only suits a single instance
+	LoginAttemptStore    string // "mysql" or "memory", as RevocationStore
+	PasswordResetURL     string // Frontend page that reset links point to
+	EmailVerificationURL string // Frontend page that verification links point to
+	RequireVerifiedEmail bool   // Refuse publishing posts until the author's email is verified
+	MFAIssuer            string // Name authenticator apps show next to 2FA codes
+	CSRFSecret           string // Signs CSRF tokens; random per process when empty
+}
+
+// MailConfig selects how outgoing mail is delivered
+type MailConfig struct {
+	Driver       string // "smtp" or "outbox"; outbox keeps mail instead of sending it
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	OutboxDir    string // Outbox writes .eml files here when set
+}
+
+// OIDCProviderConfig describes one OpenID Connect provider users can log in with
+type OIDCProviderConfig struct {
+	Name         string // Used in the login URL, /auth/oidc/:provider
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string // Frontend page that receives the code and state
+	Scopes       []string
+}
+
+// RateLimitConfig declares the rate limit policy of each route group
+type RateLimitConfig struct {
+	Store    string // "mysql" or "memory"; memory counts per instance
+	Policies map[string]RateLimitPolicyConfig
+}
+
+type RateLimitPolicyConfig struct {
+	Limit  int           // Requests allowed at once
+	Period time.Duration // Time to earn back Limit requests
+	KeyBy  string        // "ip", "user" or "token"
+}
+
+// defaultRateLimits are the route groups the router limits, see
+// loadRateLimitPolicies
+var defaultRateLimits = map[string]RateLimitPolicyConfig{
+	"auth":    {Limit: 10, Period: time.Minute, KeyBy: "ip"},
+	"public":  {Limit: 300, Period: time.Minute, KeyBy: "ip"},
+	"account": {Limit: 120, Period: time.Minute, KeyBy: "user"},
+	"publish": {Limit: 30, Period: time.Hour, KeyBy: "user"},
 }
 
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	Env      string
+	Database  DatabaseConfig
+	Server    ServerConfig
+	Auth      AuthConfig
+	Mail      MailConfig
+	OIDC      []OIDCProviderConfig
+	RateLimit RateLimitConfig
+	Jobs      JobsConfig
+	Env       string
 }
 
 func Load() (*Config, error) {
@@ -49,8 +125,41 @@ func Load() (*Config, error) {
 			MaxLifeTime:  getEnvAsDuration("DB_MAX_LIFETIME", 5*time.Minute),
 		},
 		Server: ServerConfig{
-			Host: getEnv("SERVER_HOST", "localhost"),
-			Port: getEnv("SERVER_PORT", "8080"),
+			Host:           getEnv("SERVER_HOST", "localhost"),
+			Port:           getEnv("SERVER_PORT", "8080"),
+			TrustedProxies: getEnvAsList("SERVER_TRUSTED_PROXIES"),
+		},
+		Auth: AuthConfig{
+			RevocationStore:      getEnv("AUTH_REVOCATION_STORE", "mysql"),
+			LoginAttemptStore:    getEnv("AUTH_LOGIN_ATTEMPT_STORE", "mysql"),
+			PasswordResetURL:     getEnv("AUTH_PASSWORD_RESET_URL", "http://localhost:3000/reset-password"),
+			EmailVerificationURL: getEnv("AUTH_EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email"),
+			RequireVerifiedEmail: getEnvAsBool("AUTH_REQUIRE_VERIFIED_EMAIL", false),
+			MFAIssuer:            getEnv("AUTH_MFA_ISSUER", "Blogg"),
+			CSRFSecret:           getEnv("AUTH_CSRF_SECRET", ""),
+		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "outbox"),
+			From:         getEnv("MAIL_FROM", "Blogg <no-reply@localhost>"),
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			OutboxDir:    getEnv("MAIL_OUTBOX_DIR", "tmp/outbox"),
+		},
+		OIDC: loadOIDCProviders(),
+		RateLimit: RateLimitConfig{
+			Store:    getEnv("RATE_LIMIT_STORE", "mysql"),
+			Policies: loadRateLimitPolicies(),
+		},
+		Jobs: JobsConfig{
+			PublishInterval:     getEnvAsInterval("JOBS_PUBLISH_INTERVAL", time.Minute),
+			TrashRetention:      time.Duration(max(getEnvAsInt("TRASH_RETENTION_DAYS", 30), minTrashRetentionDays)) * 24 * time.Hour,
+			TrashPurgeInterval:  getEnvAsInterval("JOBS_TRASH_PURGE_INTERVAL", time.Hour),
+			RevocationCleanup:   getEnvAsInterval("JOBS_REVOCATION_CLEANUP_INTERVAL", 15*time.Minute),
+			LoginAttemptCleanup: getEnvAsInterval("JOBS_LOGIN_ATTEMPT_CLEANUP_INTERVAL", time.Hour),
+			SessionCleanup:      getEnvAsInterval("JOBS_SESSION_CLEANUP_INTERVAL", time.Hour),
+			RateLimitCleanup:    getEnvAsInterval("JOBS_RATE_LIMIT_CLEANUP_INTERVAL", 10*time.Minute),
 		},
 		Env: getEnv("ENV", "development"),
 	}
@@ -58,6 +167,58 @@ func Load() (*Config, error) {
 	return config, nil
 }
 
+// loadOIDCProviders reads the providers named in OIDC_PROVIDERS, e.g.
+// "google,gitlab", from OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_ID and so on
+func loadOIDCProviders() []OIDCProviderConfig {
+	var providers []OIDCProviderConfig
+	for _, name := range strings.Split(getEnv("OIDC_PROVIDERS", ""), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		providers = append(providers, OIDCProviderConfig{
+			Name:         name,
+			Issuer:       getEnv(prefix+"ISSUER", ""),
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  getEnv(prefix+"REDIRECT_URL", "http://localhost:3000/auth/callback/"+name),
+			Scopes:       strings.Fields(getEnv(prefix+"SCOPES", "openid email profile")),
+		})
+	}
+	return providers
+}
+
+// loadRateLimitPolicies overrides the default policy of a group with
+// RATE_LIMIT_<GROUP>, e.g. "10/1m" for 10 requests a minute or "off", and
+// RATE_LIMIT_<GROUP>_KEY. Values that do not parse keep the default.
+func loadRateLimitPolicies() map[string]RateLimitPolicyConfig {
+	policies := make(map[string]RateLimitPolicyConfig, len(defaultRateLimits))
+	for group, policy := range defaultRateLimits {
+		prefix := "RATE_LIMIT_" + strings.ToUpper(group)
+
+		value := getEnv(prefix, "")
+		if value == "off" {
+			continue
+		}
+		if limit, period, ok := strings.Cut(value, "/"); ok {
+			l, err := strconv.Atoi(limit)
+			p, perr := time.ParseDuration(period)
+			if err == nil && perr == nil && l > 0 && p > 0 {
+				policy.Limit, policy.Period = l, p
+			}
+		}
+		switch key := getEnv(prefix+"_KEY", policy.KeyBy); key {
+		case "ip", "user", "token":
+			policy.KeyBy = key
+		}
+
+		policies[group] = policy
+	}
+	return policies
+}
+
 func (c *Config) GetServerAddress() string {
 	return fmt.Sprintf("%s:%s", c.Server.Host, c.Server.Port)
 }
@@ -92,6 +253,20 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return value
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	valueStr := getEnv(key, "")
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	valueStr := getEnv(key, "")
 	if valueStr == "" {
@@ -105,3 +280,24 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 
 	return value
 }
+
+// getEnvAsInterval reads a duration that something repeats on, which must be
+// positive
+func getEnvAsInterval(key string, defaultValue time.Duration) time.Duration {
+	value := getEnvAsDuration(key, defaultValue)
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvAsList splits a comma separated value, dropping empty entries
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, ""), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -4,13 +4,14 @@ import { ContentLayout } from "@/components/admin-panel/content-layout";
 
 import { Button } from "@/components/ui/button";
 import { useRouter } from "next/navigation";
+import { csrfFetch } from "@/lib/csrf";
 
 export default function Dashboard() {
   const router = useRouter();
 
   async function handleLogout() {
     try {
-      await fetch("/api/auth/logout", {
+      await csrfFetch("/api/auth/logout", {
         method: "POST",
       });
 
@@ -1,6 +1,6 @@
 "use client";
 
-import { useState, useMemo, useEffect, useCallback } from "react";
+import { useState, useMemo, useCallback } from "react";
 
 import { categories, type Post } from "@/data/posts";
 import { PostCard } from "@/components/blog/post-card";
@@ -14,43 +14,40 @@ import { PullToRefreshIndicator } from "@/components/blog/pull-to-refresh-indica
 import { PostLayout } from "@/components/layout/post-layout";
 import { toast } from "sonner";
 
+const POSTS_PER_PAGE = 10;
+
 export default function Home() {
   const [searchQuery, setSearchQuery] = useState("");
   const [selectedCategory, setSelectedCategory] = useState("All");
-  const [isLoading, setIsLoading] = useState(true);
-  const [posts, setPosts] = useState<Post[]>([]);
 
-  // Fetch posts from API
-  const fetchPosts = useCallback(async () => {
+  // Fetch a page of posts from the API
+  // Cursor paging keeps pages stable while new posts are published
+  const fetchPage = useCallback(async (cursor: string) => {
+    let result;
     try {
-      setIsLoading(true);
-      const response = await fetch("/api/posts");
-      const result = await response.json();
-
-      if (result.success && result.data) {
-        setPosts(result.data);
-      } else {
-        toast.error("ไม่สามารถโหลดบทความได้", {
-          description: result.message || "กรุณาลองใหม่อีกครั้ง",
-        });
-        setPosts([]);
-      }
+      const response = await fetch(`/api/posts?cursor=${encodeURIComponent(cursor)}&limit=${POSTS_PER_PAGE}`);
+      result = await response.json();
     } catch (error) {
       console.error("Error fetching posts:", error);
       toast.error("เกิดข้อผิดพลาด", {
         description: "ไม่สามารถเชื่อมต่อกับเซิร์ฟเวอร์ได้",
       });
-      setPosts([]);
-    } finally {
-      setIsLoading(false);
+      throw error;
     }
-  }, []);
 
-  // Initial load
-  useEffect(() => {
-    fetchPosts();
+    if (!result.success || !result.data) {
+      toast.error("ไม่สามารถโหลดบทความได้", {
+        description: result.message || "กรุณาลองใหม่อีกครั้ง",
+      });
+      throw new Error(result.message);
+    }
+
+    return { items: result.data as Post[], nextCursor: result.meta?.next_cursor as string | undefined };
   }, []);
 
+  // Infinite scroll; search and category filter the posts loaded so far
+  const { items: posts, hasMore, isLoading, isLoadingMore, loaderRef, reset } = useInfiniteScroll<Post>({ fetchPage });
+
   // Count posts per category
   const categoryItems = useMemo(() => {
     return categories.map((cat) => ({
@@ -78,16 +75,10 @@ export default function Home() {
     });
   }, [posts, searchQuery, selectedCategory]);
 
-  // Infinite scroll
-  const { displayedItems, hasMore, isLoadingMore, loaderRef } = useInfiniteScroll<Post>({
-    items: filteredArticles,
-    itemsPerPage: 3,
-  });
-
   // Pull to refresh
   const handleRefresh = useCallback(async () => {
-    await fetchPosts();
-  }, [fetchPosts]);
+    await reset();
+  }, [reset]);
 
   const { containerRef, pullDistance, isRefreshing, progress, shouldRefresh } = usePullToRefresh({
     onRefresh: handleRefresh,
@@ -137,7 +128,7 @@ export default function Home() {
             {/* Post Content */}
             {isLoading ? (
               <PostCardSkeletonList count={4} />
-            ) : filteredArticles.length === 0 ? (
+            ) : filteredArticles.length === 0 && !hasMore ? (
               <div className="text-center py-16">
                 <div className="text-5xl mb-4">🔍</div>
                 <h3 className="text-lg font-medium text-foreground mb-2">No posts found</h3>
@@ -152,7 +143,7 @@ export default function Home() {
                 ) : null}
 
                 <div className="grid grid-cols-1 sm:grid-cols-2 xl:grid-cols-1 gap-4 sm:gap-6 xl:max-w-2xl ">
-                  {displayedItems.map((post, index) => (
+                  {filteredArticles.map((post, index) => (
                     <div key={post.id} className="animate-fade-in" style={{ animationDelay: `${(index % 3) * 100}ms` }}>
                       <PostCard post={post} />
                     </div>
@@ -0,0 +1,42 @@
+import { NextRequest, NextResponse } from "next/server";
+
+const BACKEND_URL = process.env.NEXT_PUBLIC_BACKEND_URL || "http://localhost:8080";
+
+export async function GET(request: NextRequest) {
+  try {
+    // The token is bound to the session in the auth cookie
+    const cookieHeader = request.headers.get("cookie");
+
+    const response = await fetch(`${BACKEND_URL}/api/v1/auth/csrf`, {
+      method: "GET",
+      headers: {
+        ...(cookieHeader && { Cookie: cookieHeader }),
+      },
+      cache: "no-store",
+    });
+
+    const data = await response.json();
+    const nextResponse = NextResponse.json(data, { status: response.status });
+
+    // Forward the csrf_token cookie to the client
+    for (const cookie of response.headers.getSetCookie()) {
+      nextResponse.headers.append("set-cookie", cookie);
+    }
+
+    return nextResponse;
+  } catch (error) {
+    console.error("CSRF token error:", error);
+    return NextResponse.json(
+      {
+        success: false,
+        code: 500,
+        message: "Internal server error",
+        error: {
+          code: "INTERNAL_ERROR",
+          message: "Failed to get CSRF token",
+        },
+      },
+      { status: 500 }
+    );
+  }
+}
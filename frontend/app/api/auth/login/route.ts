@@ -21,15 +21,12 @@ export async function POST(request: NextRequest) {
       return NextResponse.json(data, { status: response.status });
     }
 
-    // Get the auth_token cookie from backend response
-    const setCookieHeader = response.headers.get("set-cookie");
-
     // Create response with success data
     const nextResponse = NextResponse.json(data, { status: 200 });
 
-    // Forward the cookie to the client
-    if (setCookieHeader) {
-      nextResponse.headers.set("set-cookie", setCookieHeader);
+    // Forward the auth_token and refresh_token cookies to the client
+    for (const cookie of response.headers.getSetCookie()) {
+      nextResponse.headers.append("set-cookie", cookie);
     }
 
     return nextResponse;
@@ -4,15 +4,24 @@ const BACKEND_URL = process.env.NEXT_PUBLIC_BACKEND_URL || "http://localhost:808
 
 export async function POST(request: NextRequest) {
   try {
-    // Get the auth_token cookie from the request
+    // Get the auth cookies from the request
     const authToken = request.cookies.get("auth_token");
+    const refreshToken = request.cookies.get("refresh_token");
+    const csrfCookie = request.cookies.get("csrf_token");
+    const cookies = [
+      authToken && `auth_token=${authToken.value}`,
+      refreshToken && `refresh_token=${refreshToken.value}`,
+      csrfCookie && `csrf_token=${csrfCookie.value}`,
+    ].filter(Boolean);
+    const csrfToken = request.headers.get("x-csrf-token");
 
     // Call backend logout API
     const response = await fetch(`${BACKEND_URL}/api/v1/auth/logout`, {
       method: "POST",
       headers: {
         "Content-Type": "application/json",
-        ...(authToken && { Cookie: `auth_token=${authToken.value}` }),
+        ...(cookies.length > 0 && { Cookie: cookies.join("; ") }),
+        ...(csrfToken && { "X-CSRF-Token": csrfToken }),
       },
     });
 
@@ -23,6 +32,8 @@ export async function POST(request: NextRequest) {
 
     // Clear the cookie on the client side
     nextResponse.cookies.delete("auth_token");
+    nextResponse.cookies.delete("refresh_token");
+    nextResponse.cookies.delete("csrf_token");
 
     return nextResponse;
   } catch (error) {
@@ -39,6 +50,8 @@ export async function POST(request: NextRequest) {
     );
 
     nextResponse.cookies.delete("auth_token");
+    nextResponse.cookies.delete("refresh_token");
+    nextResponse.cookies.delete("csrf_token");
     return nextResponse;
   }
 }
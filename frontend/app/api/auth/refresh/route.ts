@@ -0,0 +1,43 @@
+import { NextRequest, NextResponse } from "next/server";
+
+const BACKEND_URL = process.env.NEXT_PUBLIC_BACKEND_URL || "http://localhost:8080";
+
+export async function POST(request: NextRequest) {
+  try {
+    const refreshToken = request.cookies.get("refresh_token");
+
+    // Call backend refresh API with the refresh_token cookie
+    const response = await fetch(`${BACKEND_URL}/api/v1/auth/refresh`, {
+      method: "POST",
+      headers: {
+        "Content-Type": "application/json",
+        ...(refreshToken && { Cookie: `refresh_token=${refreshToken.value}` }),
+      },
+    });
+
+    const data = await response.json();
+
+    const nextResponse = NextResponse.json(data, { status: response.status });
+
+    // Forward the rotated cookies (or their removal on failure) to the client
+    for (const cookie of response.headers.getSetCookie()) {
+      nextResponse.headers.append("set-cookie", cookie);
+    }
+
+    return nextResponse;
+  } catch (error) {
+    console.error("Refresh error:", error);
+    return NextResponse.json(
+      {
+        success: false,
+        code: 500,
+        message: "Internal server error",
+        error: {
+          code: "INTERNAL_ERROR",
+          message: "Failed to connect to authentication service",
+        },
+      },
+      { status: 500 }
+    );
+  }
+}
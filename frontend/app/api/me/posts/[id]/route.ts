@@ -46,8 +46,9 @@ export async function PATCH(request: NextRequest, { params }: { params: Promise<
     const { id } = await params;
     const body = await request.json();
 
-    // Get cookies from request
+    // Get cookies and the CSRF token from request
     const cookieHeader = request.headers.get("cookie");
+    const csrfToken = request.headers.get("x-csrf-token");
 
     // Call backend API
     const response = await fetch(`${BACKEND_URL}/api/v1/me/posts/${id}`, {
@@ -56,6 +57,7 @@ export async function PATCH(request: NextRequest, { params }: { params: Promise<
         "Content-Type": "application/json",
         // Forward cookies for authentication
         ...(cookieHeader && { Cookie: cookieHeader }),
+        ...(csrfToken && { "X-CSRF-Token": csrfToken }),
       },
       body: JSON.stringify(body),
     });
@@ -88,8 +90,9 @@ export async function DELETE(request: NextRequest, { params }: { params: Promise
   try {
     const { id } = await params;
 
-    // Get cookies from request
+    // Get cookies and the CSRF token from request
     const cookieHeader = request.headers.get("cookie");
+    const csrfToken = request.headers.get("x-csrf-token");
 
     // Call backend API
     const response = await fetch(`${BACKEND_URL}/api/v1/me/posts/${id}`, {
@@ -98,6 +101,7 @@ export async function DELETE(request: NextRequest, { params }: { params: Promise
         "Content-Type": "application/json",
         // Forward cookies for authentication
         ...(cookieHeader && { Cookie: cookieHeader }),
+        ...(csrfToken && { "X-CSRF-Token": csrfToken }),
       },
     });
 
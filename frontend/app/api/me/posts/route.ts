@@ -7,8 +7,12 @@ export async function GET(request: NextRequest) {
     // Get cookies from request (auth token)
     const cookieHeader = request.headers.get("cookie");
 
+    // Forward paging parameters (limit, cursor)
+    const queryString = request.nextUrl.searchParams.toString();
+    const url = queryString ? `${BACKEND_URL}/api/v1/me/posts?${queryString}` : `${BACKEND_URL}/api/v1/me/posts`;
+
     // Call backend my posts API
-    const response = await fetch(`${BACKEND_URL}/api/v1/me/posts`, {
+    const response = await fetch(url, {
       method: "GET",
       headers: {
         "Content-Type": "application/json",
@@ -45,8 +49,9 @@ export async function POST(request: NextRequest) {
   try {
     const body = await request.json();
 
-    // Get cookies from request
+    // Get cookies and the CSRF token from request
     const cookieHeader = request.headers.get("cookie");
+    const csrfToken = request.headers.get("x-csrf-token");
 
     // Call backend API
     const response = await fetch(`${BACKEND_URL}/api/v1/me/posts`, {
@@ -55,6 +60,7 @@ export async function POST(request: NextRequest) {
         "Content-Type": "application/json",
         // Forward cookies for authentication
         ...(cookieHeader && { Cookie: cookieHeader }),
+        ...(csrfToken && { "X-CSRF-Token": csrfToken }),
       },
       body: JSON.stringify(body),
     });
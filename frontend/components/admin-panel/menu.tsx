@@ -5,6 +5,7 @@ import { Ellipsis, LogOut } from "lucide-react";
 import { usePathname, useRouter } from "next/navigation";
 
 import { cn } from "@/lib/utils";
+import { csrfFetch } from "@/lib/csrf";
 import { getMenuList } from "@/lib/menu-list";
 import { Button } from "@/components/ui/button";
 import { ScrollArea } from "@/components/ui/scroll-area";
@@ -23,7 +24,7 @@ export function Menu({ isOpen }: MenuProps) {
 
   async function handleLogout() {
     try {
-      await fetch("/api/auth/logout", {
+      await csrfFetch("/api/auth/logout", {
         method: "POST",
       });
 
@@ -16,13 +16,14 @@ import {
   DropdownMenuTrigger,
 } from "@/components/ui/dropdown-menu";
 import { useRouter } from "next/navigation";
+import { csrfFetch } from "@/lib/csrf";
 
 export function UserNav() {
   const router = useRouter();
 
   async function handleLogout() {
     try {
-      await fetch("/api/auth/logout", {
+      await csrfFetch("/api/auth/logout", {
         method: "POST",
       });
 
@@ -14,6 +14,7 @@ import { toast } from "sonner";
 import { categories, allTags } from "@/data/posts";
 
 import { cn } from "@/lib/utils";
+import { csrfFetch } from "@/lib/csrf";
 import { Badge } from "@/components/ui/badge";
 import { ContentEditor } from "@/components/blog/content-editor";
 import { ContentLayout } from "@/components/admin-panel/content-layout";
@@ -190,7 +191,7 @@ export const PostEditor: React.FC<PostEditorProps> = ({ postId }) => {
 
   // Create new post
   const createPost = async (data: PostFormValues) => {
-    const response = await fetch("/api/me/posts", {
+    const response = await csrfFetch("/api/me/posts", {
       method: "POST",
       headers: {
         "Content-Type": "application/json",
@@ -213,7 +214,7 @@ export const PostEditor: React.FC<PostEditorProps> = ({ postId }) => {
       throw new Error("Post ID is required for update");
     }
 
-    const response = await fetch(`/api/me/posts/${postId}`, {
+    const response = await csrfFetch(`/api/me/posts/${postId}`, {
       method: "PATCH",
       headers: {
         "Content-Type": "application/json",
@@ -1,49 +1,73 @@
 import { useState, useEffect, useRef, useCallback } from "react";
 
-interface UseInfiniteScrollOptions<T> {
+export interface InfinitePage<T> {
   items: T[];
-  itemsPerPage?: number;
+  // Cursor of the page after this one; absent on the last page
+  nextCursor?: string;
+}
+
+interface UseInfiniteScrollOptions<T> {
+  // Loads the page after cursor from the backend, the first page for an
+  // empty cursor; keep it stable with useCallback
+  fetchPage: (cursor: string) => Promise<InfinitePage<T>>;
   threshold?: number;
 }
 
-export function useInfiniteScroll<T>({ items, itemsPerPage = 4, threshold = 100 }: UseInfiniteScrollOptions<T>) {
-  // Lazily initialize displayed items to avoid calling setState synchronously in an effect
-  const [displayedItems, setDisplayedItems] = useState<T[]>(() => items.slice(0, itemsPerPage));
-  const [hasMore, setHasMore] = useState(() => items.length > itemsPerPage);
+export function useInfiniteScroll<T>({ fetchPage, threshold = 100 }: UseInfiniteScrollOptions<T>) {
+  const [items, setItems] = useState<T[]>([]);
+  const [hasMore, setHasMore] = useState(true);
+  const [isLoading, setIsLoading] = useState(true);
   const [isLoadingMore, setIsLoadingMore] = useState(false);
   const loaderRef = useRef<HTMLDivElement>(null);
+  const nextCursor = useRef("");
+  // Bumped by reset so that pages requested before it are dropped
+  const generation = useRef(0);
 
-  // When `items` or `itemsPerPage` change after mount, update state using an effect but avoid synchronous setState during render
-  useEffect(() => {
-    // Use a microtask so we don't synchronously set state during render and cause cascading updates
-    const id = setTimeout(() => {
-      setDisplayedItems(items.slice(0, itemsPerPage));
-      setHasMore(items.length > itemsPerPage);
-    }, 0);
+  // Loads the first page again, replacing everything loaded so far
+  const reset = useCallback(async () => {
+    const current = ++generation.current;
+    setIsLoading(true);
+    setIsLoadingMore(false);
 
-    return () => clearTimeout(id);
-  }, [items, itemsPerPage]);
+    try {
+      const page = await fetchPage("");
+      if (current !== generation.current) return;
+      setItems(page.items);
+      setHasMore(!!page.nextCursor);
+      nextCursor.current = page.nextCursor ?? "";
+    } catch {
+      if (current !== generation.current) return;
+      setItems([]);
+      setHasMore(false);
+    } finally {
+      if (current === generation.current) setIsLoading(false);
+    }
+  }, [fetchPage]);
 
-  const loadMore = useCallback(() => {
-    if (isLoadingMore || !hasMore) return;
+  const loadMore = useCallback(async () => {
+    if (isLoading || isLoadingMore || !hasMore) return;
 
+    const current = generation.current;
     setIsLoadingMore(true);
 
-    // Simulate network delay for smooth UX
-    setTimeout(() => {
-      const currentLength = displayedItems.length;
-      const nextItems = items.slice(currentLength, currentLength + itemsPerPage);
-
-      if (nextItems.length > 0) {
-        setDisplayedItems((prev) => [...prev, ...nextItems]);
-        setHasMore(currentLength + nextItems.length < items.length);
-      } else {
-        setHasMore(false);
-      }
+    try {
+      const page = await fetchPage(nextCursor.current);
+      if (current !== generation.current) return;
+      setItems((prev) => [...prev, ...page.items]);
+      setHasMore(!!page.nextCursor);
+      nextCursor.current = page.nextCursor ?? "";
+    } catch {
+      // Stop asking; a refresh starts over
+      if (current === generation.current) setHasMore(false);
+    } finally {
+      if (current === generation.current) setIsLoadingMore(false);
+    }
+  }, [fetchPage, hasMore, isLoading, isLoadingMore]);
 
-      setIsLoadingMore(false);
-    }, 500);
-  }, [displayedItems.length, hasMore, isLoadingMore, items, itemsPerPage]);
+  // Initial load
+  useEffect(() => {
+    reset();
+  }, [reset]);
 
   // Intersection Observer for infinite scroll
   useEffect(() => {
@@ -70,10 +94,12 @@ export function useInfiniteScroll<T>({ items, itemsPerPage = 4, threshold = 100
   }, [hasMore, isLoadingMore, loadMore, threshold]);
 
   return {
-    displayedItems,
+    items,
     hasMore,
+    isLoading,
     isLoadingMore,
     loaderRef,
     loadMore,
+    reset,
   };
 }
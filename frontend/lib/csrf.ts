@@ -0,0 +1,42 @@
+// The backend requires a CSRF token on changes made with the auth cookie. It
+// is kept in the csrf_token cookie and sent back in the X-CSRF-Token header.
+export const CSRF_HEADER = "X-CSRF-Token";
+
+function readCsrfCookie(): string | undefined {
+  return document.cookie
+    .split("; ")
+    .find((cookie) => cookie.startsWith("csrf_token="))
+    ?.slice("csrf_token=".length);
+}
+
+async function fetchCsrfToken(): Promise<string | undefined> {
+  const response = await fetch("/api/auth/csrf", { credentials: "include" });
+  if (!response.ok) {
+    return undefined;
+  }
+  const data = await response.json();
+  return data.data?.csrf_token;
+}
+
+// csrfFetch is fetch with the CSRF header. Tokens belong to a session, so a
+// token from before the last login is refused once and then replaced.
+export async function csrfFetch(input: string, init: RequestInit = {}): Promise<Response> {
+  const send = (token: string | undefined) => {
+    const headers = new Headers(init.headers);
+    if (token) {
+      headers.set(CSRF_HEADER, token);
+    }
+    return fetch(input, { ...init, credentials: "include", headers });
+  };
+
+  const response = await send(readCsrfCookie() ?? (await fetchCsrfToken()));
+  if (response.status !== 403) {
+    return response;
+  }
+
+  const body = await response.clone().json().catch(() => null);
+  if (body?.error?.code !== "CSRF_TOKEN_INVALID") {
+    return response;
+  }
+  return send(await fetchCsrfToken());
+}
@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Outbox keeps sent mail instead of delivering it, for development and tests.
+// With a directory set, each message is also written there as a .eml file.
+type Outbox struct {
+	mu   sync.Mutex
+	dir  string
+	from string
+	sent []domain.Mail
+}
+
+func NewOutbox(dir string, from string) *Outbox {
+	return &Outbox{dir: dir, from: from}
+}
+
+func (o *Outbox) Send(ctx context.Context, msg domain.Mail) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.dir != "" {
+		if err := os.MkdirAll(o.dir, 0o755); err != nil {
+			return err
+		}
+		name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405Z"), uuid.NewString()[:8])
+		path := filepath.Join(o.dir, name)
+		if err := os.WriteFile(path, formatMessage(o.from, msg), 0o644); err != nil {
+			return err
+		}
+		log.Printf("outbox: wrote mail to %s (%q) to %s", msg.To, msg.Subject, path)
+	}
+
+	o.sent = append(o.sent, msg)
+	return nil
+}
+
+// Sent returns a copy of every message sent so far
+func (o *Outbox) Sent() []domain.Mail {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return append([]domain.Mail(nil), o.sent...)
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"sync"
+	"time"
+)
+
+// LoginAttemptStore keeps failed login counts in process memory. Like
+// TokenRevocationStore it suits a single instance; counts reset on restart.
+type LoginAttemptStore struct {
+	mu       sync.Mutex
+	attempts map[string]domain.LoginAttempts
+}
+
+func NewLoginAttemptStore() *LoginAttemptStore {
+	return &LoginAttemptStore{attempts: make(map[string]domain.LoginAttempts)}
+}
+
+func (s *LoginAttemptStore) GetLoginAttempts(ctx context.Context, key string) (*domain.LoginAttempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+	return &a, nil
+}
+
+func (s *LoginAttemptStore) RecordLoginFailure(ctx context.Context, key string, at time.Time, resetBefore time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.attempts[key]
+	if !ok || a.LastFailureAt.Before(resetBefore) {
+		a = domain.LoginAttempts{Key: key}
+	}
+	a.Failures++
+	a.LastFailureAt = at
+	s.attempts[key] = a
+	return nil
+}
+
+func (s *LoginAttemptStore) ResetLoginAttempts(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+	return nil
+}
+
+func (s *LoginAttemptStore) DeleteLoginAttemptsBefore(ctx context.Context, before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for key, a := range s.attempts {
+		if a.LastFailureAt.Before(before) {
+			delete(s.attempts, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
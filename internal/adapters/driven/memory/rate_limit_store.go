@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"sync"
+	"time"
+)
+
+type rateLimitBucket struct {
+	domain.RateLimitBucket
+	fullAt time.Time
+}
+
+// RateLimitStore keeps token buckets in process memory, so each instance
+// counts its own requests
+type RateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]rateLimitBucket
+}
+
+func NewRateLimitStore() *RateLimitStore {
+	return &RateLimitStore{buckets: make(map[string]rateLimitBucket)}
+}
+
+func (s *RateLimitStore) TakeToken(ctx context.Context, key string, policy domain.RateLimitPolicy, now time.Time) (*domain.RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var current *domain.RateLimitBucket
+	if b, ok := s.buckets[key]; ok {
+		current = &b.RateLimitBucket
+	}
+
+	next, result := policy.Take(current, now)
+	s.buckets[key] = rateLimitBucket{RateLimitBucket: next, fullAt: result.FullAt}
+	return &result, nil
+}
+
+func (s *RateLimitStore) DeleteFullBuckets(ctx context.Context, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for key, b := range s.buckets {
+		if !b.fullAt.After(now) {
+			delete(s.buckets, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
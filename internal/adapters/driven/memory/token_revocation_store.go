@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type userRevocation struct {
+	revokedBefore time.Time
+	expiresAt     time.Time
+}
+
+// TokenRevocationStore keeps revocations in process memory. It suits single
+// instance deployments and tests; revocations are lost on restart.
+type TokenRevocationStore struct {
+	mu     sync.RWMutex
+	tokens map[string]time.Time // jti or sid -> expiry of the tokens it covers
+	users  map[string]userRevocation
+}
+
+func NewTokenRevocationStore() *TokenRevocationStore {
+	return &TokenRevocationStore{
+		tokens: make(map[string]time.Time),
+		users:  make(map[string]userRevocation),
+	}
+}
+
+func (s *TokenRevocationStore) RevokeToken(ctx context.Context, id string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[id] = expiresAt
+	return nil
+}
+
+func (s *TokenRevocationStore) RevokeUserTokens(ctx context.Context, userID string, issuedBefore time.Time, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users[userID] = userRevocation{revokedBefore: issuedBefore, expiresAt: expiresAt}
+	return nil
+}
+
+func (s *TokenRevocationStore) IsTokenRevoked(ctx context.Context, tokenID string, sessionID string, userID string, issuedAt time.Time) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.tokens[tokenID]; ok {
+		return true, nil
+	}
+	if _, ok := s.tokens[sessionID]; ok && sessionID != "" {
+		return true, nil
+	}
+	if u, ok := s.users[userID]; ok && !u.revokedBefore.Before(issuedAt) {
+		return true, nil
+	}
+	return false, nil
+}
+
+func (s *TokenRevocationStore) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for id, expiresAt := range s.tokens {
+		if expiresAt.Before(now) {
+			delete(s.tokens, id)
+			deleted++
+		}
+	}
+	for id, u := range s.users {
+		if u.expiresAt.Before(now) {
+			delete(s.users, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type AccessTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewAccessTokenRepository(db *sqlx.DB) *AccessTokenRepository {
+	return &AccessTokenRepository{db: db}
+}
+
+// accessTokenRow holds the scopes column, which is stored comma separated
+type accessTokenRow struct {
+	domain.PersonalAccessToken
+	ScopeList string `db:"scopes"`
+}
+
+func (r accessTokenRow) toDomain() domain.PersonalAccessToken {
+	t := r.PersonalAccessToken
+	t.Scopes = strings.Split(r.ScopeList, ",")
+	return t
+}
+
+const accessTokenColumns = `id, user_id, name, token_hash, scopes, mfa, expires_at, last_used_at, created_at`
+
+func (r *AccessTokenRepository) CreateAccessToken(ctx context.Context, t *domain.PersonalAccessToken) error {
+	query := `INSERT INTO personal_access_tokens (` + accessTokenColumns + `)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, NULL, ?)`
+	_, err := r.db.ExecContext(ctx, query, t.ID, t.UserID, t.Name, t.TokenHash,
+		strings.Join(t.Scopes, ","), t.MFA, t.ExpiresAt, t.CreatedAt)
+	return err
+}
+
+func (r *AccessTokenRepository) FindAccessTokenByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	var row accessTokenRow
+	query := `SELECT ` + accessTokenColumns + ` FROM personal_access_tokens WHERE token_hash = ?`
+	err := r.db.GetContext(ctx, &row, query, tokenHash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	t := row.toDomain()
+	return &t, nil
+}
+
+func (r *AccessTokenRepository) FindAccessTokensByUserID(ctx context.Context, userID string) ([]domain.PersonalAccessToken, error) {
+	var rows []accessTokenRow
+	query := `SELECT ` + accessTokenColumns + ` FROM personal_access_tokens WHERE user_id = ? ORDER BY created_at DESC, id DESC`
+	if err := r.db.SelectContext(ctx, &rows, query, userID); err != nil {
+		return nil, err
+	}
+
+	tokens := make([]domain.PersonalAccessToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, row.toDomain())
+	}
+	return tokens, nil
+}
+
+func (r *AccessTokenRepository) DeleteAccessToken(ctx context.Context, userID string, tokenID string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM personal_access_tokens WHERE id = ? AND user_id = ?`, tokenID, userID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *AccessTokenRepository) DeleteUserAccessTokens(ctx context.Context, userID string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM personal_access_tokens WHERE user_id = ?`, userID)
+	return err
+}
+
+func (r *AccessTokenRepository) TouchAccessToken(ctx context.Context, tokenID string, usedAt time.Time) error {
+	query := `UPDATE personal_access_tokens SET last_used_at = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, usedAt, tokenID)
+	return err
+}
@@ -4,10 +4,14 @@ import (
 	"blogg/internal/core/domain"
 	"blogg/internal/core/port"
 	"context"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 )
 
+const userColumns = `id, username, email, email_verified_at, password, role, suspended_at, created_at, updated_at`
+
 type authRepository struct {
 	db *sqlx.DB
 }
@@ -31,7 +35,7 @@ func (arp *authRepository) CreateUser(ctx context.Context, u *domain.User) error
 func (arp *authRepository) FindUserByID(ctx context.Context, userID string) (*domain.User, error) {
 	var user domain.User
 
-	query := `SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE id = ?`
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = ?`
 	err := arp.db.GetContext(ctx, &user, query, userID)
 	if err != nil {
 		return nil, err
@@ -43,7 +47,7 @@ func (arp *authRepository) FindUserByID(ctx context.Context, userID string) (*do
 func (arp *authRepository) FindUserByUsername(ctx context.Context, username string) (*domain.User, error) {
 	var user domain.User
 
-	query := `SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE username = ?`
+	query := `SELECT ` + userColumns + ` FROM users WHERE username = ?`
 	err := arp.db.GetContext(ctx, &user, query, username)
 	if err != nil {
 		return nil, err
@@ -55,7 +59,7 @@ func (arp *authRepository) FindUserByUsername(ctx context.Context, username stri
 func (arp *authRepository) FindUserByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user domain.User
 
-	query := `SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE email = ?`
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = ?`
 	err := arp.db.GetContext(ctx, &user, query, email)
 	if err != nil {
 		return nil, err
@@ -63,3 +67,66 @@ func (arp *authRepository) FindUserByEmail(ctx context.Context, email string) (*
 
 	return &user, nil
 }
+
+// ListUsers returns one page of users, newest first, and the total number of
+// users matching opts.Query
+func (arp *authRepository) ListUsers(ctx context.Context, opts domain.UserListOptions) ([]domain.User, int, error) {
+	where := ""
+	var args []interface{}
+	if opts.Query != "" {
+		pattern := "%" + escapeLike(opts.Query) + "%"
+		where = ` WHERE username LIKE ? OR email LIKE ?`
+		args = append(args, pattern, pattern)
+	}
+
+	var total int
+	if err := arp.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM users`+where, args...); err != nil {
+		return nil, 0, err
+	}
+
+	users := []domain.User{}
+	query := `SELECT ` + userColumns + ` FROM users` + where + ` ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+	args = append(args, opts.Limit, (opts.Page-1)*opts.Limit)
+	if err := arp.db.SelectContext(ctx, &users, query, args...); err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func (arp *authRepository) UpdateUserRole(ctx context.Context, userID string, role string) error {
+	query := `UPDATE users SET role = ?, updated_at = NOW() WHERE id = ?`
+	_, err := arp.db.ExecContext(ctx, query, role, userID)
+	return err
+}
+
+func (arp *authRepository) SetUserSuspended(ctx context.Context, userID string, suspendedAt *time.Time) error {
+	query := `UPDATE users SET suspended_at = ?, updated_at = NOW() WHERE id = ?`
+	_, err := arp.db.ExecContext(ctx, query, suspendedAt, userID)
+	return err
+}
+
+// DeleteUser removes the user. Sessions and refresh tokens go with it through
+// their ON DELETE CASCADE keys; posts must have been handed over beforehand.
+func (arp *authRepository) DeleteUser(ctx context.Context, userID string) error {
+	query := `DELETE FROM users WHERE id = ?`
+	_, err := arp.db.ExecContext(ctx, query, userID)
+	return err
+}
+
+func (arp *authRepository) UpdateUserPassword(ctx context.Context, userID string, passwordHash string) error {
+	query := `UPDATE users SET password = ?, updated_at = NOW() WHERE id = ?`
+	_, err := arp.db.ExecContext(ctx, query, passwordHash, userID)
+	return err
+}
+
+func (arp *authRepository) MarkEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error {
+	query := `UPDATE users SET email_verified_at = ?, updated_at = NOW() WHERE id = ?`
+	_, err := arp.db.ExecContext(ctx, query, verifiedAt, userID)
+	return err
+}
+
+// escapeLike escapes the LIKE wildcards in s so that it matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -16,10 +16,16 @@ func NewCategoryRepository(db *sqlx.DB) *CategoryRepository {
 	return &CategoryRepository{db: db}
 }
 
+// categorySummaryQuery counts only posts that are visible to the public
+const categorySummaryQuery = `SELECT c.id, c.name, c.slug, c.parent_id, COUNT(p.id) AS post_count
+			  FROM categories c
+			  LEFT JOIN posts_categories pc ON pc.category_id = c.id
+			  LEFT JOIN posts p ON p.id = pc.post_id AND p.deleted_at IS NULL AND p.is_published = true`
+
 func (r *CategoryRepository) CreateCategory(ctx context.Context, c *domain.Category) error {
-	query := `INSERT INTO categories (id, name, slug)
-			  VALUES (?, ?, ?)`
-	_, err := r.db.ExecContext(ctx, query, c.ID, c.Name, c.Slug)
+	query := `INSERT INTO categories (id, name, slug, parent_id)
+			  VALUES (?, ?, ?, ?)`
+	_, err := r.db.ExecContext(ctx, query, c.ID, c.Name, c.Slug, c.ParentID)
 	return err
 }
 
@@ -36,9 +42,136 @@ func (r *CategoryRepository) FindCategoryByID(ctx context.Context, categoryID st
 	return &c, nil
 }
 
+func (r *CategoryRepository) FindCategoryBySlug(ctx context.Context, slug string) (*domain.Category, error) {
+	var c domain.Category
+	query := `SELECT * FROM categories WHERE slug = ?`
+	err := r.db.GetContext(ctx, &c, query, slug)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *CategoryRepository) FindCategoriesByIDs(ctx context.Context, categoryIDs []string) ([]domain.Category, error) {
+	var categories []domain.Category
+	if len(categoryIDs) == 0 {
+		return categories, nil
+	}
+
+	query, args, err := sqlx.In(`SELECT * FROM categories WHERE id IN (?)`, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.SelectContext(ctx, &categories, r.db.Rebind(query), args...)
+	return categories, err
+}
+
 func (r *CategoryRepository) ListCategories(ctx context.Context) ([]domain.Category, error) {
 	var categories []domain.Category
 	query := `SELECT * FROM categories ORDER BY name ASC`
 	err := r.db.SelectContext(ctx, &categories, query)
 	return categories, err
 }
+
+func (r *CategoryRepository) ListCategorySummaries(ctx context.Context) ([]domain.CategorySummary, error) {
+	var categories []domain.CategorySummary
+	query := categorySummaryQuery + ` GROUP BY c.id, c.name, c.slug, c.parent_id ORDER BY c.name ASC`
+	err := r.db.SelectContext(ctx, &categories, query)
+	return categories, err
+}
+
+func (r *CategoryRepository) FindCategorySummaryBySlug(ctx context.Context, slug string) (*domain.CategorySummary, error) {
+	var c domain.CategorySummary
+	query := categorySummaryQuery + ` WHERE c.slug = ? GROUP BY c.id, c.name, c.slug, c.parent_id`
+	err := r.db.GetContext(ctx, &c, query, slug)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *CategoryRepository) UpdateCategory(ctx context.Context, c *domain.Category) error {
+	query := `UPDATE categories SET name = ?, slug = ?, parent_id = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, c.Name, c.Slug, c.ParentID, c.ID)
+	return err
+}
+
+// FindCategoryAncestors returns the category and all of its ancestors, root first
+func (r *CategoryRepository) FindCategoryAncestors(ctx context.Context, categoryID string) ([]domain.Category, error) {
+	var categories []domain.Category
+	query := `WITH RECURSIVE ancestors AS (
+				  SELECT id, name, slug, parent_id, 0 AS depth FROM categories WHERE id = ?
+				  UNION ALL
+				  SELECT c.id, c.name, c.slug, c.parent_id, a.depth + 1
+				  FROM categories c INNER JOIN ancestors a ON c.id = a.parent_id
+			  )
+			  SELECT id, name, slug, parent_id FROM ancestors ORDER BY depth DESC`
+	err := r.db.SelectContext(ctx, &categories, query, categoryID)
+	return categories, err
+}
+
+func (r *CategoryRepository) FindCategoryChildren(ctx context.Context, parentID string) ([]domain.Category, error) {
+	var categories []domain.Category
+	query := `SELECT * FROM categories WHERE parent_id = ? ORDER BY name ASC`
+	err := r.db.SelectContext(ctx, &categories, query, parentID)
+	return categories, err
+}
+
+func (r *CategoryRepository) DeleteCategory(ctx context.Context, categoryID string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Children move up to the deleted category's parent
+	var parentID *string
+	if err := tx.GetContext(ctx, &parentID, `SELECT parent_id FROM categories WHERE id = ?`, categoryID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE categories SET parent_id = ? WHERE parent_id = ?`, parentID, categoryID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM posts_categories WHERE category_id = ?`, categoryID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM categories WHERE id = ?`, categoryID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MergeCategories moves every post and child category of sourceID to targetID
+// and deletes sourceID. Posts already in both categories keep a single link.
+func (r *CategoryRepository) MergeCategories(ctx context.Context, sourceID string, targetID string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT IGNORE INTO posts_categories (post_id, category_id)
+			  SELECT post_id, ? FROM posts_categories WHERE category_id = ?`
+	if _, err := tx.ExecContext(ctx, query, targetID, sourceID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM posts_categories WHERE category_id = ?`, sourceID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE categories SET parent_id = ? WHERE parent_id = ?`, targetID, sourceID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM categories WHERE id = ?`, sourceID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type EmailVerificationRepository struct {
+	db *sqlx.DB
+}
+
+func NewEmailVerificationRepository(db *sqlx.DB) *EmailVerificationRepository {
+	return &EmailVerificationRepository{db: db}
+}
+
+func (r *EmailVerificationRepository) CreateEmailVerificationToken(ctx context.Context, t *domain.EmailVerificationToken) error {
+	query := `INSERT INTO email_verification_tokens (id, user_id, token_hash, expires_at, created_at)
+			  VALUES (?, ?, ?, ?, ?)`
+	_, err := r.db.ExecContext(ctx, query, t.ID, t.UserID, t.TokenHash, t.ExpiresAt, t.CreatedAt)
+	return err
+}
+
+func (r *EmailVerificationRepository) FindEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	var t domain.EmailVerificationToken
+	query := `SELECT id, user_id, token_hash, expires_at, created_at, used_at
+			  FROM email_verification_tokens WHERE token_hash = ?`
+	err := r.db.GetContext(ctx, &t, query, tokenHash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *EmailVerificationRepository) FindLatestEmailVerificationToken(ctx context.Context, userID string) (*domain.EmailVerificationToken, error) {
+	var t domain.EmailVerificationToken
+	query := `SELECT id, user_id, token_hash, expires_at, created_at, used_at
+			  FROM email_verification_tokens WHERE user_id = ?
+			  ORDER BY created_at DESC LIMIT 1`
+	err := r.db.GetContext(ctx, &t, query, userID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *EmailVerificationRepository) ConsumeEmailVerificationToken(ctx context.Context, tokenID string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `UPDATE email_verification_tokens SET used_at = NOW() WHERE id = ? AND used_at IS NULL`, tokenID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// erDupEntry is MySQL's error number for a row that repeats a unique key
+const erDupEntry = 1062
+
+// isDuplicateKey reports whether err is MySQL rejecting a write for repeating
+// the unique index key. MySQL 8 names the key with its table prefixed.
+func isDuplicateKey(err error, key string) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == erDupEntry &&
+		strings.HasSuffix(mysqlErr.Message, key+"'")
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type IdentityRepository struct {
+	db *sqlx.DB
+}
+
+func NewIdentityRepository(db *sqlx.DB) *IdentityRepository {
+	return &IdentityRepository{db: db}
+}
+
+func (r *IdentityRepository) FindIdentity(ctx context.Context, provider, subject string) (*domain.Identity, error) {
+	var identity domain.Identity
+	query := `SELECT id, user_id, provider, subject, email, created_at, last_login_at
+			  FROM identities WHERE provider = ? AND subject = ?`
+	err := r.db.GetContext(ctx, &identity, query, provider, subject)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *IdentityRepository) CreateUserWithIdentity(ctx context.Context, u *domain.User, identity *domain.Identity) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO users (id, username, email, email_verified_at, password, role, created_at, updated_at)
+			  VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())`,
+		u.ID, u.Username, u.Email, u.EmailVerifiedAt, u.Password, u.Role)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO identities (id, user_id, provider, subject, email, created_at, last_login_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		identity.ID, identity.UserID, identity.Provider, identity.Subject, identity.Email, identity.CreatedAt, identity.LastLoginAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *IdentityRepository) TouchIdentity(ctx context.Context, identityID string, loginAt time.Time) error {
+	query := `UPDATE identities SET last_login_at = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, loginAt, identityID)
+	return err
+}
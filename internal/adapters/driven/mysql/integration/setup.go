@@ -81,8 +81,10 @@ func runMigrations(db *sqlx.DB) error {
 			id VARCHAR(36) NOT NULL, -- แนะนำลดขนาดถ้าใช้ UUID (36 chars)
 			username VARCHAR(50) NOT NULL,
 			email VARCHAR(255) NOT NULL,
+			email_verified_at DATETIME NULL,
 			password VARCHAR(255) NOT NULL,
 			role ENUM('admin', 'editor', 'user') DEFAULT 'user', -- เพิ่ม role เผื่ออนาคต
+			suspended_at DATETIME NULL,
 			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
 			PRIMARY KEY (id),
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type LoginAttemptRepository struct {
+	db *sqlx.DB
+}
+
+func NewLoginAttemptRepository(db *sqlx.DB) *LoginAttemptRepository {
+	return &LoginAttemptRepository{db: db}
+}
+
+func (r *LoginAttemptRepository) GetLoginAttempts(ctx context.Context, key string) (*domain.LoginAttempts, error) {
+	var a domain.LoginAttempts
+	query := `SELECT attempt_key, failures, last_failure_at FROM login_attempts WHERE attempt_key = ?`
+	if err := r.db.GetContext(ctx, &a, query, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &a, nil
+}
+
+// RecordLoginFailure counts the failure in one statement so that concurrent
+// guesses cannot overwrite each other's counts. failures is assigned first
+// and so still sees the previous last_failure_at.
+func (r *LoginAttemptRepository) RecordLoginFailure(ctx context.Context, key string, at time.Time, resetBefore time.Time) error {
+	query := `INSERT INTO login_attempts (attempt_key, failures, last_failure_at) VALUES (?, 1, ?)
+			  ON DUPLICATE KEY UPDATE failures = IF(last_failure_at < ?, 1, failures + 1), last_failure_at = VALUES(last_failure_at)`
+	_, err := r.db.ExecContext(ctx, query, key, at, resetBefore)
+	return err
+}
+
+func (r *LoginAttemptRepository) ResetLoginAttempts(ctx context.Context, key string) error {
+	query := `DELETE FROM login_attempts WHERE attempt_key = ?`
+	_, err := r.db.ExecContext(ctx, query, key)
+	return err
+}
+
+func (r *LoginAttemptRepository) DeleteLoginAttemptsBefore(ctx context.Context, before time.Time) (int, error) {
+	query := `DELETE FROM login_attempts WHERE last_failure_at < ?`
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(rows), nil
+}
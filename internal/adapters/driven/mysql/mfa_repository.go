@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type MFARepository struct {
+	db *sqlx.DB
+}
+
+func NewMFARepository(db *sqlx.DB) *MFARepository {
+	return &MFARepository{db: db}
+}
+
+func (r *MFARepository) FindMFAByUserID(ctx context.Context, userID string) (*domain.MFA, error) {
+	var m domain.MFA
+	query := `SELECT user_id, secret, enabled_at, last_used_step, created_at FROM user_mfa WHERE user_id = ?`
+	err := r.db.GetContext(ctx, &m, query, userID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *MFARepository) SaveMFASecret(ctx context.Context, m *domain.MFA) error {
+	query := `INSERT INTO user_mfa (user_id, secret, enabled_at, last_used_step, created_at)
+			  VALUES (?, ?, NULL, 0, ?)
+			  ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled_at = NULL,
+			  last_used_step = 0, created_at = VALUES(created_at)`
+	_, err := r.db.ExecContext(ctx, query, m.UserID, m.Secret, m.CreatedAt)
+	return err
+}
+
+func (r *MFARepository) EnableMFA(ctx context.Context, userID string, enabledAt time.Time, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = ?`, userID); err != nil {
+		return err
+	}
+	for _, hash := range recoveryCodeHashes {
+		_, err := tx.ExecContext(ctx, `INSERT INTO mfa_recovery_codes (id, user_id, code_hash) VALUES (?, ?, ?)`,
+			uuid.NewString(), userID, hash)
+		if err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE user_mfa SET enabled_at = ? WHERE user_id = ?`, enabledAt, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *MFARepository) UseMFAStep(ctx context.Context, userID string, step int64) (bool, error) {
+	query := `UPDATE user_mfa SET last_used_step = ? WHERE user_id = ? AND last_used_step < ?`
+	result, err := r.db.ExecContext(ctx, query, step, userID, step)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *MFARepository) ConsumeRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	query := `UPDATE mfa_recovery_codes SET used_at = NOW()
+			  WHERE user_id = ? AND code_hash = ? AND used_at IS NULL LIMIT 1`
+	result, err := r.db.ExecContext(ctx, query, userID, codeHash)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *MFARepository) DeleteMFA(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = ?`, userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_mfa WHERE user_id = ?`, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type PasswordResetRepository struct {
+	db *sqlx.DB
+}
+
+func NewPasswordResetRepository(db *sqlx.DB) *PasswordResetRepository {
+	return &PasswordResetRepository{db: db}
+}
+
+func (r *PasswordResetRepository) CreatePasswordResetToken(ctx context.Context, t *domain.PasswordResetToken) error {
+	query := `INSERT INTO password_reset_tokens (id, user_id, token_hash, expires_at, created_at)
+			  VALUES (?, ?, ?, ?, ?)`
+	_, err := r.db.ExecContext(ctx, query, t.ID, t.UserID, t.TokenHash, t.ExpiresAt, t.CreatedAt)
+	return err
+}
+
+func (r *PasswordResetRepository) FindPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	var t domain.PasswordResetToken
+	query := `SELECT id, user_id, token_hash, expires_at, created_at, used_at
+			  FROM password_reset_tokens WHERE token_hash = ?`
+	err := r.db.GetContext(ctx, &t, query, tokenHash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *PasswordResetRepository) ConsumePasswordResetToken(ctx context.Context, tokenID string) (bool, error) {
+	// The used_at guard lets only one of two concurrent resets through
+	result, err := r.db.ExecContext(ctx, `UPDATE password_reset_tokens SET used_at = NOW() WHERE id = ? AND used_at IS NULL`, tokenID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *PasswordResetRepository) DeleteUserPasswordResetTokens(ctx context.Context, userID string) error {
+	query := `DELETE FROM password_reset_tokens WHERE user_id = ?`
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
@@ -4,10 +4,15 @@ import (
 	"blogg/internal/core/domain"
 	"context"
 	"database/sql"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 )
 
+// postSlugKey is the unique index on the slugs of posts not in the trash
+const postSlugKey = "uk_posts_live_slug"
+
 type PostRepository struct {
 	db *sqlx.DB
 }
@@ -17,9 +22,12 @@ func NewPostRepository(db *sqlx.DB) *PostRepository {
 }
 
 func (r *PostRepository) CreatePost(ctx context.Context, p *domain.Post) error {
-	query := `INSERT INTO posts (id, user_id, title, slug, image, content, excerpt, is_published, published_at, created_at, updated_at)
-			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
-	_, err := r.db.ExecContext(ctx, query, p.ID, p.UserID, p.Title, p.Slug, p.CoverImage, p.Content, p.Excerpt, p.IsPublished, p.PublishedAt, p.CreatedAt, p.UpdatedAt)
+	query := `INSERT INTO posts (id, user_id, title, slug, image, content, excerpt, is_published, published_at, publish_at, created_at, updated_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := r.db.ExecContext(ctx, query, p.ID, p.UserID, p.Title, p.Slug, p.CoverImage, p.Content, p.Excerpt, p.IsPublished, p.PublishedAt, p.PublishAt, p.CreatedAt, p.UpdatedAt)
+	if isDuplicateKey(err, postSlugKey) {
+		return domain.ErrSlugExists
+	}
 	return err
 }
 
@@ -49,30 +57,338 @@ func (r *PostRepository) FindPostBySlug(ctx context.Context, slug string) (*doma
 	return &p, nil
 }
 
+// FindPublishedPostBySlug is FindPostBySlug for readers: drafts and posts
+// scheduled for later are not found
+func (r *PostRepository) FindPublishedPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	var p domain.Post
+	query := `SELECT * FROM posts WHERE slug = ? AND deleted_at IS NULL AND is_published = true`
+	err := r.db.GetContext(ctx, &p, query, slug)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// FindPostBySlugHistory returns the post that used to be reachable under slug,
+// or nil when no post that is not in the trash ever used it
+func (r *PostRepository) FindPostBySlugHistory(ctx context.Context, slug string) (*domain.Post, error) {
+	var p domain.Post
+	query := `SELECT p.* FROM post_slug_history h
+			  INNER JOIN posts p ON p.id = h.post_id
+			  WHERE h.slug = ? AND p.deleted_at IS NULL`
+	err := r.db.GetContext(ctx, &p, query, slug)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// FindPublishedPostBySlugHistory is FindPostBySlugHistory for readers, so an
+// old slug does not give away the new slug of a post that is hidden again
+func (r *PostRepository) FindPublishedPostBySlugHistory(ctx context.Context, slug string) (*domain.Post, error) {
+	var p domain.Post
+	query := `SELECT p.* FROM post_slug_history h
+			  INNER JOIN posts p ON p.id = h.post_id
+			  WHERE h.slug = ? AND p.deleted_at IS NULL AND p.is_published = true`
+	err := r.db.GetContext(ctx, &p, query, slug)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// RecordSlugChange keeps oldSlug pointing at the post. newSlug leaves the
+// history since it is the post's live slug again.
+func (r *PostRepository) RecordSlugChange(ctx context.Context, postID string, oldSlug string, newSlug string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO post_slug_history (slug, post_id, created_at) VALUES (?, ?, NOW())
+			  ON DUPLICATE KEY UPDATE post_id = VALUES(post_id), created_at = VALUES(created_at)`
+	if _, err := tx.ExecContext(ctx, query, oldSlug, postID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM post_slug_history WHERE slug = ?`, newSlug); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (r *PostRepository) UpdatePost(ctx context.Context, p *domain.Post) error {
-	query := `UPDATE posts SET title = ?, slug = ?, image = ?, content = ?, excerpt = ?, is_published = ?, published_at = ?, updated_at = ?
+	query := `UPDATE posts SET title = ?, slug = ?, image = ?, content = ?, excerpt = ?, is_published = ?, published_at = ?, publish_at = ?, updated_at = ?
 			  WHERE id = ?`
-	_, err := r.db.ExecContext(ctx, query, p.Title, p.Slug, p.CoverImage, p.Content, p.Excerpt, p.IsPublished, p.PublishedAt, p.UpdatedAt, p.ID)
+	_, err := r.db.ExecContext(ctx, query, p.Title, p.Slug, p.CoverImage, p.Content, p.Excerpt, p.IsPublished, p.PublishedAt, p.PublishAt, p.UpdatedAt, p.ID)
+	if isDuplicateKey(err, postSlugKey) {
+		return domain.ErrSlugExists
+	}
 	return err
 }
 
+// PublishDuePosts locks due scheduled posts with SKIP LOCKED so several
+// publishers can run at once without claiming the same post twice.
+func (r *PostRepository) PublishDuePosts(ctx context.Context, now time.Time, limit int) ([]*domain.Post, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var posts []*domain.Post
+	query := `SELECT * FROM posts
+			  WHERE is_published = false AND publish_at IS NOT NULL AND publish_at <= ? AND deleted_at IS NULL
+			  ORDER BY publish_at ASC LIMIT ?
+			  FOR UPDATE SKIP LOCKED`
+	if err := tx.SelectContext(ctx, &posts, query, now, limit); err != nil {
+		return nil, err
+	}
+	if len(posts) == 0 {
+		return posts, nil
+	}
+
+	// The post goes live at its scheduled time, not at the time the job ran
+	update := `UPDATE posts SET is_published = true, published_at = publish_at, publish_at = NULL, updated_at = ? WHERE id = ?`
+	for _, p := range posts {
+		if _, err := tx.ExecContext(ctx, update, now, p.ID); err != nil {
+			return nil, err
+		}
+		p.IsPublished = true
+		p.PublishedAt = p.PublishAt
+		p.PublishAt = nil
+		p.UpdatedAt = now
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
 func (r *PostRepository) DeletePost(ctx context.Context, postID string) error {
 	query := `UPDATE posts SET deleted_at = NOW(), updated_at = NOW() WHERE id = ?`
 	_, err := r.db.ExecContext(ctx, query, postID)
 	return err
 }
 
-func (r *PostRepository) ListPosts(ctx context.Context) ([]*domain.Post, error) {
+func (r *PostRepository) FindTrashedPostByID(ctx context.Context, postID string) (*domain.Post, error) {
+	var p domain.Post
+	query := `SELECT * FROM posts WHERE id = ? AND deleted_at IS NOT NULL`
+	err := r.db.GetContext(ctx, &p, query, postID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PostRepository) FindTrashedPostsByUserID(ctx context.Context, userID string) ([]*domain.Post, error) {
 	var posts []*domain.Post
-	query := `SELECT * FROM posts WHERE deleted_at IS NULL AND is_published = true ORDER BY published_at DESC`
+	query := `SELECT * FROM posts WHERE user_id = ? AND deleted_at IS NOT NULL ORDER BY deleted_at DESC, id DESC`
+	err := r.db.SelectContext(ctx, &posts, query, userID)
+	return posts, err
+}
+
+func (r *PostRepository) FindTrashedPosts(ctx context.Context) ([]*domain.Post, error) {
+	var posts []*domain.Post
+	query := `SELECT * FROM posts WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC, id DESC`
 	err := r.db.SelectContext(ctx, &posts, query)
 	return posts, err
 }
 
-func (r *PostRepository) FindPostsByUserID(ctx context.Context, userID string) ([]*domain.Post, error) {
+func (r *PostRepository) TrashPostsByUserID(ctx context.Context, userID string) ([]string, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var ids []string
+	query := `SELECT id FROM posts WHERE user_id = ? AND deleted_at IS NULL FOR UPDATE`
+	if err := tx.SelectContext(ctx, &ids, query, userID); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	update := `UPDATE posts SET deleted_at = NOW(), updated_at = NOW() WHERE user_id = ? AND deleted_at IS NULL`
+	if _, err := tx.ExecContext(ctx, update, userID); err != nil {
+		return nil, err
+	}
+
+	return ids, tx.Commit()
+}
+
+func (r *PostRepository) ReassignPosts(ctx context.Context, fromUserID string, toUserID string) error {
+	query := `UPDATE posts SET user_id = ?, updated_at = NOW() WHERE user_id = ?`
+	_, err := r.db.ExecContext(ctx, query, toUserID, fromUserID)
+	return err
+}
+
+func (r *PostRepository) RestorePost(ctx context.Context, postID string) error {
+	query := `UPDATE posts SET deleted_at = NULL, updated_at = NOW() WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, postID)
+	if isDuplicateKey(err, postSlugKey) {
+		return domain.ErrSlugExists
+	}
+	return err
+}
+
+// PurgePost permanently deletes a trashed post and its category links. Tags and
+// revisions go with it through their ON DELETE CASCADE keys.
+func (r *PostRepository) PurgePost(ctx context.Context, postID string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM posts_categories WHERE post_id = ?`, postID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM posts WHERE id = ? AND deleted_at IS NOT NULL`, postID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PurgeTrashedPosts permanently deletes every post trashed before the given
+// time, along with its category links, and returns how many were removed
+func (r *PostRepository) PurgeTrashedPosts(ctx context.Context, before time.Time) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	query := `DELETE pc FROM posts_categories pc
+			  INNER JOIN posts p ON p.id = pc.post_id
+			  WHERE p.deleted_at IS NOT NULL AND p.deleted_at < ?`
+	if _, err := tx.ExecContext(ctx, query, before); err != nil {
+		return 0, err
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM posts WHERE deleted_at IS NOT NULL AND deleted_at < ?`, before)
+	if err != nil {
+		return 0, err
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(purged), nil
+}
+
+func (r *PostRepository) ListPosts(ctx context.Context, opts domain.PostListOptions) ([]*domain.Post, error) {
 	var posts []*domain.Post
-	query := `SELECT * FROM posts WHERE user_id = ? AND deleted_at IS NULL ORDER BY created_at DESC`
-	err := r.db.SelectContext(ctx, &posts, query, userID)
+	where, args := buildPostListFilter(opts)
+
+	var query string
+	if opts.UseCursor {
+		// Keyset pagination: continue strictly after the cursor position
+		if opts.Cursor != nil {
+			where += ` AND (p.published_at < ? OR (p.published_at = ? AND p.id < ?))`
+			args = append(args, opts.Cursor.Time, opts.Cursor.Time, opts.Cursor.ID)
+		}
+		query = `SELECT p.* FROM posts p` + where + ` ORDER BY p.published_at DESC, p.id DESC LIMIT ?`
+		args = append(args, opts.Limit)
+	} else {
+		query = `SELECT p.* FROM posts p` + where + ` ORDER BY p.published_at DESC, p.id DESC LIMIT ? OFFSET ?`
+		args = append(args, opts.Limit, opts.Offset())
+	}
+
+	err := r.db.SelectContext(ctx, &posts, query, args...)
+	return posts, err
+}
+
+func (r *PostRepository) CountPosts(ctx context.Context, opts domain.PostListOptions) (int, error) {
+	var total int
+	where, args := buildPostListFilter(opts)
+	query := `SELECT COUNT(*) FROM posts p` + where
+	err := r.db.GetContext(ctx, &total, query, args...)
+	return total, err
+}
+
+// buildPostListFilter builds the WHERE clause shared by ListPosts and CountPosts
+func buildPostListFilter(opts domain.PostListOptions) (string, []any) {
+	conditions := []string{"p.deleted_at IS NULL", "p.is_published = true"}
+	var args []any
+
+	if opts.CategorySlug != "" && opts.IncludeSubcategories {
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM posts_categories pc
+			WHERE pc.post_id = p.id AND pc.category_id IN (
+				WITH RECURSIVE subtree AS (
+					SELECT id FROM categories WHERE slug = ?
+					UNION ALL
+					SELECT c.id FROM categories c INNER JOIN subtree s ON c.parent_id = s.id
+				)
+				SELECT id FROM subtree))`)
+		args = append(args, opts.CategorySlug)
+	} else if opts.CategorySlug != "" {
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM posts_categories pc
+			INNER JOIN categories c ON c.id = pc.category_id
+			WHERE pc.post_id = p.id AND c.slug = ?)`)
+		args = append(args, opts.CategorySlug)
+	}
+	if opts.TagSlug != "" {
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM posts_tags pt
+			INNER JOIN tags t ON t.id = pt.tag_id
+			WHERE pt.post_id = p.id AND t.slug = ?)`)
+		args = append(args, opts.TagSlug)
+	}
+	if opts.Author != "" {
+		conditions = append(conditions, `p.user_id IN (SELECT id FROM users WHERE username = ?)`)
+		args = append(args, opts.Author)
+	}
+	if opts.PublishedFrom != nil {
+		conditions = append(conditions, "p.published_at >= ?")
+		args = append(args, *opts.PublishedFrom)
+	}
+	if opts.PublishedTo != nil {
+		conditions = append(conditions, "p.published_at < ?")
+		args = append(args, *opts.PublishedTo)
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *PostRepository) FindPostsByUserID(ctx context.Context, userID string, opts domain.PostListOptions) ([]*domain.Post, error) {
+	var posts []*domain.Post
+	query := `SELECT * FROM posts WHERE user_id = ? AND deleted_at IS NULL`
+	args := []any{userID}
+
+	// Without a cursor the whole list is returned
+	if !opts.UseCursor {
+		err := r.db.SelectContext(ctx, &posts, query+` ORDER BY created_at DESC, id DESC`, args...)
+		return posts, err
+	}
+
+	if opts.Cursor != nil {
+		query += ` AND (created_at < ? OR (created_at = ? AND id < ?))`
+		args = append(args, opts.Cursor.Time, opts.Cursor.Time, opts.Cursor.ID)
+	}
+	query += ` ORDER BY created_at DESC, id DESC LIMIT ?`
+	args = append(args, opts.Limit)
+
+	err := r.db.SelectContext(ctx, &posts, query, args...)
 	return posts, err
 }
 
@@ -101,3 +417,30 @@ func (r *PostRepository) GetPostCategories(ctx context.Context, postID string) (
 	err := r.db.SelectContext(ctx, &categories, query, postID)
 	return categories, err
 }
+
+func (r *PostRepository) AddTagsToPost(ctx context.Context, postID string, tagIDs []string) error {
+	query := `INSERT INTO posts_tags (post_id, tag_id) VALUES (?, ?)`
+	for _, tagID := range tagIDs {
+		_, err := r.db.ExecContext(ctx, query, postID, tagID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *PostRepository) RemoveTagsFromPost(ctx context.Context, postID string) error {
+	query := `DELETE FROM posts_tags WHERE post_id = ?`
+	_, err := r.db.ExecContext(ctx, query, postID)
+	return err
+}
+
+func (r *PostRepository) GetPostTags(ctx context.Context, postID string) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	query := `SELECT t.id, t.name, t.slug FROM tags t
+			  INNER JOIN posts_tags pt ON t.id = pt.tag_id
+			  WHERE pt.post_id = ?
+			  ORDER BY t.name ASC`
+	err := r.db.SelectContext(ctx, &tags, query, postID)
+	return tags, err
+}
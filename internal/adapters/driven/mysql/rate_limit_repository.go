@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type RateLimitRepository struct {
+	db *sqlx.DB
+}
+
+func NewRateLimitRepository(db *sqlx.DB) *RateLimitRepository {
+	return &RateLimitRepository{db: db}
+}
+
+// TakeToken locks the bucket row while it is updated. A full bucket is
+// inserted first if there is none, so that concurrent first requests wait
+// for each other on the row instead of racing to insert it.
+func (r *RateLimitRepository) TakeToken(ctx context.Context, key string, policy domain.RateLimitPolicy, now time.Time) (*domain.RateLimitResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	insert := `INSERT IGNORE INTO rate_limit_buckets (bucket_key, tokens, updated_at, full_at) VALUES (?, ?, ?, ?)`
+	if _, err := tx.ExecContext(ctx, insert, key, policy.Limit, now, now); err != nil {
+		return nil, err
+	}
+
+	var current domain.RateLimitBucket
+	query := `SELECT tokens, updated_at FROM rate_limit_buckets WHERE bucket_key = ? FOR UPDATE`
+	if err := tx.GetContext(ctx, &current, query, key); err != nil {
+		return nil, err
+	}
+
+	next, result := policy.Take(&current, now)
+	update := `UPDATE rate_limit_buckets SET tokens = ?, updated_at = ?, full_at = ? WHERE bucket_key = ?`
+	if _, err := tx.ExecContext(ctx, update, next.Tokens, next.UpdatedAt, result.FullAt, key); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *RateLimitRepository) DeleteFullBuckets(ctx context.Context, now time.Time) (int, error) {
+	query := `DELETE FROM rate_limit_buckets WHERE full_at <= ?`
+	result, err := r.db.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(rows), nil
+}
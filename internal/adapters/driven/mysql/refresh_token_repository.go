@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type RefreshTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewRefreshTokenRepository(db *sqlx.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+func (r *RefreshTokenRepository) CreateRefreshToken(ctx context.Context, t *domain.RefreshToken) error {
+	return insertRefreshToken(ctx, r.db, t)
+}
+
+func (r *RefreshTokenRepository) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var t domain.RefreshToken
+	query := `SELECT id, user_id, family_id, token_hash, expires_at, created_at, revoked_at
+			  FROM refresh_tokens WHERE token_hash = ?`
+	err := r.db.GetContext(ctx, &t, query, tokenHash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *RefreshTokenRepository) RotateRefreshToken(ctx context.Context, oldID string, next *domain.RefreshToken) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// The revoked_at guard makes two concurrent rotations of one token race for
+	// a single row update; only the winner gets a new token
+	result, err := tx.ExecContext(ctx, `UPDATE refresh_tokens SET revoked_at = NOW() WHERE id = ? AND revoked_at IS NULL`, oldID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rows == 0 {
+		return false, nil
+	}
+
+	if err := insertRefreshToken(ctx, tx, next); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (r *RefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	query := `UPDATE refresh_tokens SET revoked_at = NOW() WHERE family_id = ? AND revoked_at IS NULL`
+	_, err := r.db.ExecContext(ctx, query, familyID)
+	return err
+}
+
+func (r *RefreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	query := `UPDATE refresh_tokens SET revoked_at = NOW() WHERE user_id = ? AND revoked_at IS NULL`
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
+
+// DeleteExpiredRefreshTokenFamilies keeps a family whole while any of its
+// tokens can still be used, so that replaying a rotated one is still caught
+func (r *RefreshTokenRepository) DeleteExpiredRefreshTokenFamilies(ctx context.Context, before time.Time) (int, error) {
+	query := `DELETE t FROM refresh_tokens t
+			  JOIN (SELECT family_id FROM refresh_tokens GROUP BY family_id HAVING MAX(expires_at) < ?) expired
+			  ON expired.family_id = t.family_id`
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(rows), nil
+}
+
+func insertRefreshToken(ctx context.Context, db sqlx.ExecerContext, t *domain.RefreshToken) error {
+	query := `INSERT INTO refresh_tokens (id, user_id, family_id, token_hash, expires_at, created_at)
+			  VALUES (?, ?, ?, ?, ?, ?)`
+	_, err := db.ExecContext(ctx, query, t.ID, t.UserID, t.FamilyID, t.TokenHash, t.ExpiresAt, t.CreatedAt)
+	return err
+}
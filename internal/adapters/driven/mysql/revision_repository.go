@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type RevisionRepository struct {
+	db *sqlx.DB
+}
+
+func NewRevisionRepository(db *sqlx.DB) *RevisionRepository {
+	return &RevisionRepository{db: db}
+}
+
+func (r *RevisionRepository) CreateRevision(ctx context.Context, rev *domain.PostRevision) error {
+	query := `INSERT INTO post_revisions (id, post_id, author_id, title, content, excerpt, created_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?)`
+	_, err := r.db.ExecContext(ctx, query, rev.ID, rev.PostID, rev.AuthorID, rev.Title, rev.Content, rev.Excerpt, rev.CreatedAt)
+	return err
+}
+
+func (r *RevisionRepository) FindRevisionsByPostID(ctx context.Context, postID string) ([]domain.PostRevision, error) {
+	var revisions []domain.PostRevision
+	query := `SELECT id, post_id, author_id, title, excerpt, created_at FROM post_revisions
+			  WHERE post_id = ? ORDER BY created_at DESC, id DESC`
+	err := r.db.SelectContext(ctx, &revisions, query, postID)
+	return revisions, err
+}
+
+func (r *RevisionRepository) FindRevisionByID(ctx context.Context, postID string, revisionID string) (*domain.PostRevision, error) {
+	var rev domain.PostRevision
+	query := `SELECT * FROM post_revisions WHERE id = ? AND post_id = ?`
+	err := r.db.GetContext(ctx, &rev, query, revisionID, postID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
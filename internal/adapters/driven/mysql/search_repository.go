@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const snippetLength = 160
+
+// SearchRepository answers searches from the FULLTEXT indexes on the posts
+// table, which MySQL keeps current on every write.
+type SearchRepository struct {
+	db *sqlx.DB
+}
+
+func NewSearchRepository(db *sqlx.DB) *SearchRepository {
+	return &SearchRepository{db: db}
+}
+
+// IndexPost is a no-op: the FULLTEXT index is maintained by MySQL
+func (r *SearchRepository) IndexPost(ctx context.Context, p *domain.Post) error {
+	return nil
+}
+
+// RemovePost is a no-op: soft-deleted posts are filtered out at query time
+func (r *SearchRepository) RemovePost(ctx context.Context, postID string) error {
+	return nil
+}
+
+type searchRow struct {
+	domain.Post
+	Score float64 `db:"score"`
+}
+
+func (r *SearchRepository) SearchPosts(ctx context.Context, q domain.PostSearchQuery) ([]domain.PostSearchHit, int, error) {
+	const where = ` WHERE p.deleted_at IS NULL AND p.is_published = true
+			  AND MATCH(p.title, p.excerpt, p.content) AGAINST (? IN NATURAL LANGUAGE MODE)`
+
+	var total int
+	err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM posts p`+where, q.Query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Title matches weigh more than matches in the body
+	var rows []searchRow
+	query := `SELECT p.*,
+			  MATCH(p.title) AGAINST (? IN NATURAL LANGUAGE MODE) * 3
+			  + MATCH(p.title, p.excerpt, p.content) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+			  FROM posts p` + where + `
+			  ORDER BY score DESC, p.published_at DESC, p.id DESC
+			  LIMIT ? OFFSET ?`
+	err = r.db.SelectContext(ctx, &rows, query, q.Query, q.Query, q.Query, q.Limit, q.Offset())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	terms := strings.Fields(q.Query)
+	hits := make([]domain.PostSearchHit, 0, len(rows))
+	for i := range rows {
+		post := rows[i].Post
+		source := post.Content
+		if post.Excerpt != "" && !containsAny(post.Content, terms) {
+			source = post.Excerpt
+		}
+		hits = append(hits, domain.PostSearchHit{
+			Post:    &post,
+			Score:   rows[i].Score,
+			Snippet: highlightSnippet(source, terms, snippetLength),
+		})
+	}
+
+	return hits, total, nil
+}
+
+func containsAny(text string, terms []string) bool {
+	lower := strings.ToLower(text)
+	for _, term := range terms {
+		if strings.Contains(lower, strings.ToLower(term)) {
+			return true
+		}
+	}
+	return false
+}
+
+// highlightSnippet cuts a window of at most length runes around the first term
+// match, HTML-escapes it and wraps every match in <mark>.
+func highlightSnippet(text string, terms []string, length int) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	lowerTerms := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		if t := []rune(strings.ToLower(term)); len(t) > 0 {
+			lowerTerms = append(lowerTerms, t)
+		}
+	}
+
+	// matchAt reports the length of the term matching at i, or 0
+	matchAt := func(i int) int {
+		for _, t := range lowerTerms {
+			if i+len(t) <= len(lower) && string(lower[i:i+len(t)]) == string(t) {
+				return len(t)
+			}
+		}
+		return 0
+	}
+
+	start := 0
+	for i := range lower {
+		if matchAt(i) > 0 {
+			start = max(0, i-length/4)
+			break
+		}
+	}
+	end := min(len(runes), start+length)
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; {
+		if n := matchAt(i); n > 0 {
+			stop := min(i+n, end)
+			b.WriteString("<mark>")
+			b.WriteString(html.EscapeString(string(runes[i:stop])))
+			b.WriteString("</mark>")
+			i = stop
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+		i++
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type SessionRepository struct {
+	db *sqlx.DB
+}
+
+func NewSessionRepository(db *sqlx.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+func (r *SessionRepository) CreateSession(ctx context.Context, s *domain.Session) error {
+	query := `INSERT INTO sessions (id, user_id, user_agent, ip, mfa, created_at, last_seen_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?)`
+	_, err := r.db.ExecContext(ctx, query, s.ID, s.UserID, s.UserAgent, s.IP, s.MFA, s.CreatedAt, s.LastSeenAt)
+	return err
+}
+
+func (r *SessionRepository) FindSessionByID(ctx context.Context, sessionID string) (*domain.Session, error) {
+	var s domain.Session
+	query := `SELECT * FROM sessions WHERE id = ?`
+	err := r.db.GetContext(ctx, &s, query, sessionID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *SessionRepository) FindActiveSessionsByUserID(ctx context.Context, userID string, seenAfter time.Time) ([]domain.Session, error) {
+	var sessions []domain.Session
+	query := `SELECT * FROM sessions WHERE user_id = ? AND revoked_at IS NULL AND last_seen_at > ?
+			  ORDER BY last_seen_at DESC, id DESC`
+	err := r.db.SelectContext(ctx, &sessions, query, userID, seenAfter)
+	return sessions, err
+}
+
+func (r *SessionRepository) TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error {
+	query := `UPDATE sessions SET last_seen_at = ? WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, seenAt, sessionID)
+	return err
+}
+
+func (r *SessionRepository) MarkSessionMFA(ctx context.Context, sessionID string) error {
+	query := `UPDATE sessions SET mfa = TRUE WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, sessionID)
+	return err
+}
+
+func (r *SessionRepository) RevokeSession(ctx context.Context, sessionID string) error {
+	query := `UPDATE sessions SET revoked_at = NOW() WHERE id = ? AND revoked_at IS NULL`
+	_, err := r.db.ExecContext(ctx, query, sessionID)
+	return err
+}
+
+func (r *SessionRepository) RevokeUserSessions(ctx context.Context, userID string) error {
+	query := `UPDATE sessions SET revoked_at = NOW() WHERE user_id = ? AND revoked_at IS NULL`
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
+
+func (r *SessionRepository) DeleteSessionsSeenBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM sessions WHERE last_seen_at < ?`, before)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	return int(rows), err
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type TagRepository struct {
+	db *sqlx.DB
+}
+
+func NewTagRepository(db *sqlx.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+func (r *TagRepository) UpsertTags(ctx context.Context, tags []domain.Tag) ([]domain.Tag, error) {
+	var stored []domain.Tag
+	if len(tags) == 0 {
+		return stored, nil
+	}
+
+	// Existing slugs keep their original ID and name
+	query := `INSERT IGNORE INTO tags (id, name, slug) VALUES (?, ?, ?)`
+	slugs := make([]string, 0, len(tags))
+	for _, t := range tags {
+		_, err := r.db.ExecContext(ctx, query, t.ID, t.Name, t.Slug)
+		if err != nil {
+			return nil, err
+		}
+		slugs = append(slugs, t.Slug)
+	}
+
+	selectQuery, args, err := sqlx.In(`SELECT id, name, slug FROM tags WHERE slug IN (?) ORDER BY name ASC`, slugs)
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.SelectContext(ctx, &stored, r.db.Rebind(selectQuery), args...)
+	return stored, err
+}
+
+func (r *TagRepository) FindTagBySlug(ctx context.Context, slug string) (*domain.Tag, error) {
+	var t domain.Tag
+	query := `SELECT id, name, slug FROM tags WHERE slug = ?`
+	err := r.db.GetContext(ctx, &t, query, slug)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *TagRepository) ListTagSummaries(ctx context.Context) ([]domain.TagSummary, error) {
+	var tags []domain.TagSummary
+	query := `SELECT t.id, t.name, t.slug, COUNT(p.id) AS post_count
+			  FROM tags t
+			  LEFT JOIN posts_tags pt ON pt.tag_id = t.id
+			  LEFT JOIN posts p ON p.id = pt.post_id AND p.deleted_at IS NULL AND p.is_published = true
+			  GROUP BY t.id, t.name, t.slug
+			  ORDER BY post_count DESC, t.name ASC`
+	err := r.db.SelectContext(ctx, &tags, query)
+	return tags, err
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type TokenRevocationRepository struct {
+	db *sqlx.DB
+}
+
+func NewTokenRevocationRepository(db *sqlx.DB) *TokenRevocationRepository {
+	return &TokenRevocationRepository{db: db}
+}
+
+func (r *TokenRevocationRepository) RevokeToken(ctx context.Context, id string, expiresAt time.Time) error {
+	query := `INSERT IGNORE INTO revoked_tokens (jti, expires_at) VALUES (?, ?)`
+	_, err := r.db.ExecContext(ctx, query, id, expiresAt)
+	return err
+}
+
+func (r *TokenRevocationRepository) RevokeUserTokens(ctx context.Context, userID string, issuedBefore time.Time, expiresAt time.Time) error {
+	query := `INSERT INTO user_token_revocations (user_id, revoked_before, expires_at) VALUES (?, ?, ?)
+			  ON DUPLICATE KEY UPDATE revoked_before = VALUES(revoked_before), expires_at = VALUES(expires_at)`
+	_, err := r.db.ExecContext(ctx, query, userID, issuedBefore, expiresAt)
+	return err
+}
+
+func (r *TokenRevocationRepository) IsTokenRevoked(ctx context.Context, tokenID string, sessionID string, userID string, issuedAt time.Time) (bool, error) {
+	var revoked bool
+	query := `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti IN (?, ?))
+			  OR EXISTS (SELECT 1 FROM user_token_revocations WHERE user_id = ? AND revoked_before >= ?)`
+	err := r.db.GetContext(ctx, &revoked, query, tokenID, sessionID, userID, issuedAt)
+	return revoked, err
+}
+
+func (r *TokenRevocationRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	deleted := 0
+	for _, query := range []string{
+		`DELETE FROM revoked_tokens WHERE expires_at < ?`,
+		`DELETE FROM user_token_revocations WHERE expires_at < ?`,
+	} {
+		result, err := tx.ExecContext(ctx, query, now)
+		if err != nil {
+			return 0, err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		deleted += int(rows)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
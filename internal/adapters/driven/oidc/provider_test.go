@@ -0,0 +1,89 @@
+//go:build unit
+
+package oidc_test
+
+import (
+	"blogg/internal/adapters/driven/oidc"
+	"blogg/internal/adapters/driven/oidc/oidctest"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider(t *testing.T) {
+	const verifier = "a-code-verifier-long-enough-for-pkce-0123456789"
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	setup := func(t *testing.T) (*oidctest.Server, *oidc.Provider) {
+		server := oidctest.NewServer()
+		t.Cleanup(server.Close)
+		provider := oidc.NewProvider(oidc.Config{
+			Issuer:       server.Issuer(),
+			ClientID:     server.ClientID,
+			ClientSecret: server.ClientSecret,
+			RedirectURL:  "http://localhost:3000/auth/callback/test",
+			Scopes:       []string{"email", "profile"},
+		})
+		return server, provider
+	}
+
+	t.Run("complete a PKCE code flow and verify the ID token", func(t *testing.T) {
+		server, provider := setup(t)
+		authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", challenge)
+		require.NoError(t, err)
+		assert.Contains(t, authURL, "code_challenge_method=S256")
+		assert.Contains(t, authURL, "scope=openid+email+profile")
+
+		code, state, err := server.Authorize(authURL)
+		require.NoError(t, err)
+		assert.Equal(t, "state-1", state)
+
+		claims, err := provider.Exchange(context.Background(), code, verifier)
+		require.NoError(t, err)
+		assert.Equal(t, server.User.Subject, claims.Subject)
+		assert.Equal(t, server.User.Email, claims.Email)
+		assert.True(t, claims.EmailVerified)
+		assert.Equal(t, "nonce-1", claims.Nonce)
+	})
+
+	t.Run("fail with the wrong code verifier", func(t *testing.T) {
+		server, provider := setup(t)
+		authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", challenge)
+		require.NoError(t, err)
+		code, _, err := server.Authorize(authURL)
+		require.NoError(t, err)
+
+		_, err = provider.Exchange(context.Background(), code, "another-verifier")
+		assert.Error(t, err)
+	})
+
+	t.Run("reject an ID token not signed by a published key", func(t *testing.T) {
+		server, provider := setup(t)
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		server.SigningKey = other
+
+		authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", challenge)
+		require.NoError(t, err)
+		code, _, err := server.Authorize(authURL)
+		require.NoError(t, err)
+
+		_, err = provider.Exchange(context.Background(), code, verifier)
+		assert.Error(t, err)
+	})
+
+	t.Run("refuse a provider whose discovery names another issuer", func(t *testing.T) {
+		server, _ := setup(t)
+		provider := oidc.NewProvider(oidc.Config{Issuer: server.Issuer() + "/", ClientID: server.ClientID})
+
+		_, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", challenge)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// AccessTokenHandler lets users manage their personal access tokens
+type AccessTokenHandler struct {
+	tokenService port.AccessTokenServicePort
+	validate     *validator.Validate
+}
+
+func NewAccessTokenHandler(tokenService port.AccessTokenServicePort) *AccessTokenHandler {
+	return &AccessTokenHandler{
+		tokenService: tokenService,
+		validate:     newValidator(),
+	}
+}
+
+func (h *AccessTokenHandler) CreateToken(c echo.Context) error {
+	var req domain.CreateAccessTokenReq
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+	claims := middleware.GetClaims(c)
+	mfa := claims != nil && claims.MFA
+
+	result, err := h.tokenService.CreateToken(c.Request().Context(), actor, mfa, &req)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusCreated,
+		Message:    "Access token created; copy it now, it will not be shown again",
+		Data:       result,
+	})
+}
+
+func (h *AccessTokenHandler) ListTokens(c echo.Context) error {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	tokens, err := h.tokenService.ListTokens(c.Request().Context(), userID)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Access tokens retrieved successfully",
+		Data:       tokens,
+	})
+}
+
+func (h *AccessTokenHandler) RevokeToken(c echo.Context) error {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	if err := h.tokenService.RevokeToken(c.Request().Context(), userID, c.Param("id")); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Access token revoked successfully",
+		Data:       nil,
+	})
+}
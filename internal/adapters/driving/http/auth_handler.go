@@ -2,6 +2,7 @@ package http
 
 import (
 	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/adapters/driving/http/middleware"
 	"blogg/internal/core/domain"
 	"blogg/utils/errs"
 
@@ -13,6 +14,10 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 type AuthHandler struct {
 	authService port.AuthServicePort
 	validate    *validator.Validate
@@ -91,24 +96,202 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	}
 
 	// 3. Call service
+	req.UserAgent = c.Request().UserAgent()
+	req.IP = c.RealIP()
 	result, err := h.authService.Login(context.Background(), &req)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
 
-	// 4. Set JWT token in HttpOnly cookie
-	cookie := &http.Cookie{
-		Name:     "auth_token",
-		Value:    result.AccessToken,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   true, // Set to true in production with HTTPS
-		SameSite: http.SameSiteStrictMode,
-		MaxAge:   86400, // 24 hours
+	// 4. Set JWT and refresh tokens in HttpOnly cookies
+	return loginResponse(c, result)
+}
+
+// LoginMFA completes a login with the MFA token and a TOTP or recovery code
+func (h *AuthHandler) LoginMFA(c echo.Context) error {
+	var req domain.LoginMFAReq
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	req.UserAgent = c.Request().UserAgent()
+	req.IP = c.RealIP()
+	result, err := h.authService.LoginMFA(c.Request().Context(), &req)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return loginResponse(c, result)
+}
+
+// Refresh rotates the refresh token and issues a new access token. Browsers
+// send the token as a cookie; other clients send it in the body and get the
+// new tokens back in the body.
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	var req RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return httphelper.HandleServiceError(
+			c,
+			errs.NewBadRequestError("Invalid JSON format"),
+		)
+	}
+
+	refreshToken := req.RefreshToken
+	fromBody := refreshToken != ""
+	if !fromBody {
+		if cookie, err := c.Cookie("refresh_token"); err == nil {
+			refreshToken = cookie.Value
+		}
+	}
+	if refreshToken == "" {
+		return httphelper.HandleServiceError(c, domain.ErrInvalidRefreshToken)
+	}
+
+	result, err := h.authService.Refresh(c.Request().Context(), refreshToken)
+	if err != nil {
+		clearAuthCookies(c)
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	setAuthCookies(c, result)
+
+	var data any = map[string]interface{}{
+		"username": result.Username,
+	}
+	if fromBody {
+		data = result
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Token refreshed successfully",
+		Data:       data,
+	})
+}
+
+// Logout revokes the current access and refresh tokens when present. It runs
+// behind OptionalAuth, so an expired session can still log out.
+func (h *AuthHandler) Logout(c echo.Context) error {
+	var req domain.LogoutReq
+	if claims := middleware.GetClaims(c); claims != nil {
+		req.TokenID = claims.ID
+		req.SessionID = claims.SessionID
+		req.TokenExpiresAt = claims.ExpiresAt.Time
+	}
+
+	if cookie, err := c.Cookie("refresh_token"); err == nil {
+		req.RefreshToken = cookie.Value
+	}
+
+	if err := h.authService.Logout(c.Request().Context(), &req); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	// Clear the auth cookies
+	clearAuthCookies(c)
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Logout successful",
+		Data:       nil,
+	})
+}
+
+// LogoutAll revokes every token of the current user on every device
+func (h *AuthHandler) LogoutAll(c echo.Context) error {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	if err := h.authService.LogoutEverywhere(c.Request().Context(), userID); err != nil {
+		return httphelper.HandleServiceError(c, err)
 	}
-	c.SetCookie(cookie)
 
-	// Return response without token in body (stored in cookie)
+	clearAuthCookies(c)
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Logged out of all sessions",
+		Data:       nil,
+	})
+}
+
+// ListSessions returns the devices the current user is logged in on
+func (h *AuthHandler) ListSessions(c echo.Context) error {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	var currentSessionID string
+	if claims := middleware.GetClaims(c); claims != nil {
+		currentSessionID = claims.SessionID
+	}
+
+	sessions, err := h.authService.ListSessions(c.Request().Context(), userID, currentSessionID)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Sessions retrieved successfully",
+		Data:       sessions,
+	})
+}
+
+// RevokeSession logs one of the current user's sessions out. Revoking the
+// current session also clears the auth cookies.
+func (h *AuthHandler) RevokeSession(c echo.Context) error {
+	id := c.Param("id")
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	if err := h.authService.RevokeSession(c.Request().Context(), userID, id); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	if claims := middleware.GetClaims(c); claims != nil && claims.SessionID == id {
+		clearAuthCookies(c)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Session revoked successfully",
+		Data:       nil,
+	})
+}
+
+// loginResponse sets the auth cookies of a new session and answers without
+// the tokens in the body. Users with 2FA get the MFA token to finish at
+// LoginMFA instead; no session exists for them yet.
+func loginResponse(c echo.Context, result *domain.UserLoginRes) error {
+	if result.MFARequired {
+		return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+			StatusCode: http.StatusOK,
+			Message:    "Two-factor authentication required",
+			Data: map[string]interface{}{
+				"username":     result.Username,
+				"mfa_required": true,
+				"mfa_token":    result.MFAToken,
+			},
+		})
+	}
+
+	setAuthCookies(c, result)
+
 	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
 		StatusCode: http.StatusOK,
 		Message:    "Login successful",
@@ -118,22 +301,37 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	})
 }
 
-func (h *AuthHandler) Logout(c echo.Context) error {
-	// Clear the auth cookie
-	cookie := &http.Cookie{
+func setAuthCookies(c echo.Context, result *domain.UserLoginRes) {
+	c.SetCookie(&http.Cookie{
 		Name:     "auth_token",
-		Value:    "",
+		Value:    result.AccessToken,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true, // Set to true in production with HTTPS
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   result.ExpiresIn,
+	})
+	c.SetCookie(&http.Cookie{
+		Name:     "refresh_token",
+		Value:    result.RefreshToken,
 		Path:     "/",
 		HttpOnly: true,
 		Secure:   true,
 		SameSite: http.SameSiteStrictMode,
-		MaxAge:   -1, // Delete cookie
-	}
-	c.SetCookie(cookie)
-
-	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
-		StatusCode: http.StatusOK,
-		Message:    "Logout successful",
-		Data:       nil,
+		MaxAge:   int(domain.RefreshTokenTTL.Seconds()),
 	})
 }
+
+func clearAuthCookies(c echo.Context) {
+	for _, name := range []string{"auth_token", "refresh_token"} {
+		c.SetCookie(&http.Cookie{
+			Name:     name,
+			Value:    "",
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteStrictMode,
+			MaxAge:   -1, // Delete cookie
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryHandler struct {
+	categoryService port.CategoryServicePort
+	validate        *validator.Validate
+}
+
+func NewCategoryHandler(categoryService port.CategoryServicePort) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+		validate:        newValidator(),
+	}
+}
+
+type CreateCategoryRequest struct {
+	Name     string  `json:"name" validate:"required,min=2,max=100"`
+	Slug     string  `json:"slug" validate:"required,slug,max=100"`
+	ParentID *string `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+type RenameCategoryRequest struct {
+	Name *string `json:"name" validate:"omitempty,min=2,max=100"`
+	Slug *string `json:"slug" validate:"omitempty,slug,max=100"`
+}
+
+// MoveCategoryRequest moves a category under ParentID; null moves it to the top level
+type MoveCategoryRequest struct {
+	ParentID *string `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+type MergeCategoryRequest struct {
+	TargetID string `json:"target_id" validate:"required,uuid"`
+}
+
+func (h *CategoryHandler) ListCategories(c echo.Context) error {
+	categories, err := h.categoryService.ListCategories(c.Request().Context())
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Categories retrieved successfully",
+		Data:       categories,
+	})
+}
+
+func (h *CategoryHandler) GetCategory(c echo.Context) error {
+	slug := c.Param("slug")
+
+	category, err := h.categoryService.GetCategoryBySlug(c.Request().Context(), slug)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Category retrieved successfully",
+		Data:       category,
+	})
+}
+
+func (h *CategoryHandler) CreateCategory(c echo.Context) error {
+	var req CreateCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	category := &domain.Category{Name: req.Name, Slug: req.Slug, ParentID: req.ParentID}
+	created, err := h.categoryService.CreateCategory(c.Request().Context(), actor, category)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusCreated,
+		Message:    "Category created successfully",
+		Data:       created,
+	})
+}
+
+func (h *CategoryHandler) RenameCategory(c echo.Context) error {
+	id := c.Param("id")
+
+	var req RenameCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	// Build partial update
+	category := &domain.Category{}
+	if req.Name != nil {
+		category.Name = *req.Name
+	}
+	if req.Slug != nil {
+		category.Slug = *req.Slug
+	}
+
+	updated, err := h.categoryService.RenameCategory(c.Request().Context(), actor, id, category)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Category updated successfully",
+		Data:       updated,
+	})
+}
+
+func (h *CategoryHandler) MoveCategory(c echo.Context) error {
+	id := c.Param("id")
+
+	var req MoveCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	moved, err := h.categoryService.MoveCategory(c.Request().Context(), actor, id, req.ParentID)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Category moved successfully",
+		Data:       moved,
+	})
+}
+
+func (h *CategoryHandler) DeleteCategory(c echo.Context) error {
+	id := c.Param("id")
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	err = h.categoryService.DeleteCategory(c.Request().Context(), actor, id)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Category deleted successfully",
+		Data:       nil,
+	})
+}
+
+func (h *CategoryHandler) MergeCategory(c echo.Context) error {
+	id := c.Param("id")
+
+	var req MergeCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	err = h.categoryService.MergeCategories(c.Request().Context(), actor, id, req.TargetID)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Categories merged successfully",
+		Data:       nil,
+	})
+}
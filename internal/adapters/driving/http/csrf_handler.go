@@ -0,0 +1,47 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/utils/csrf"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CSRFHandler struct {
+	tokens *csrf.Tokens
+}
+
+func NewCSRFHandler(tokens *csrf.Tokens) *CSRFHandler {
+	return &CSRFHandler{tokens: tokens}
+}
+
+// Token issues a CSRF token for the current session. It is returned in the
+// body and in a cookie that scripts can read; send it back in the
+// X-CSRF-Token header. Tokens change with the session, so fetch a new one
+// after logging in.
+func (h *CSRFHandler) Token(c echo.Context) error {
+	sessionID := ""
+	if claims := middleware.GetClaims(c); claims != nil {
+		sessionID = claims.SessionID
+	}
+	token := h.tokens.Issue(sessionID)
+
+	c.SetCookie(&http.Cookie{
+		Name:     middleware.CSRFCookie,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: false, // The frontend reads it to set the header
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "CSRF token issued",
+		Data: map[string]interface{}{
+			"csrf_token": token,
+		},
+	})
+}
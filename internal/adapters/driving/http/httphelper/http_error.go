@@ -4,6 +4,7 @@ import (
 	"blogg/utils/errs"
 	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -65,6 +66,9 @@ func HandleServiceError(c echo.Context, err error) error {
 	// Try to unwrap AppError
 	var appErr *errs.AppError
 	if errors.As(err, &appErr) {
+		if appErr.RetryAfter > 0 {
+			c.Response().Header().Set("Retry-After", retryAfterSeconds(appErr.RetryAfter))
+		}
 		return ErrorResponse(c, ErrorResponseParams{
 			StatusCode: appErr.StatusCode,
 			Message:    appErr.Message,
@@ -82,6 +86,11 @@ func HandleServiceError(c echo.Context, err error) error {
 	})
 }
 
+// retryAfterSeconds rounds d up to whole seconds, so clients never retry early
+func retryAfterSeconds(d time.Duration) string {
+	return strconv.FormatInt(int64((d+time.Second-1)/time.Second), 10)
+}
+
 // HandleValidationError handles validator.ValidationErrors
 func HandleValidationError(c echo.Context, err error) error {
 	ve, ok := err.(validator.ValidationErrors)
@@ -158,6 +167,8 @@ func FormatValidationMessage(tag, field, param string) string {
 		return field + " must be a valid URL"
 	case "uuid":
 		return field + " must be a valid UUID"
+	case "datetime":
+		return field + " must be a date in " + param + " format"
 	default:
 		return field + " is invalid"
 	}
@@ -20,6 +20,7 @@ type Meta struct {
 	Timestamp  string      `json:"timestamp"`
 	TraceID    string      `json:"trace_id,omitempty"`
 	Pagination *Pagination `json:"pagination,omitempty"`
+	NextCursor string      `json:"next_cursor,omitempty"`
 }
 
 type Pagination struct {
@@ -46,6 +47,7 @@ type SuccessListResponseParams struct {
 	Message    string
 	Data       any
 	Pagination *Pagination
+	NextCursor string
 }
 
 func SuccessResponse(c echo.Context, params SuccessResponseParams) error {
@@ -75,6 +77,7 @@ func SuccessListResponse(c echo.Context, params SuccessListResponseParams) error
 			Timestamp:  time.Now().UTC().Format(time.RFC3339),
 			TraceID:    traceID,
 			Pagination: params.Pagination,
+			NextCursor: params.NextCursor,
 		},
 	})
 }
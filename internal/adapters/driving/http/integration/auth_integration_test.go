@@ -3,15 +3,22 @@
 package integration
 
 import (
+	"blogg/internal/adapters/driven/mail"
+	"blogg/internal/adapters/driven/memory"
 	httpAdapter "blogg/internal/adapters/driving/http"
+	"blogg/internal/adapters/driving/http/middleware"
 	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
 	"blogg/internal/core/service"
 	"blogg/mocks"
+	"blogg/utils/csrf"
+	jwthelper "blogg/utils/jwt"
 	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -23,16 +30,42 @@ import (
 )
 
 func setupTestServer(t *testing.T) (*echo.Echo, *mocks.MockAuthRepositoryPort) {
+	return setupTestServerWithOIDC(t, mocks.NewMockOIDCServicePort(t))
+}
+
+func setupTestServerWithOIDC(t *testing.T, oidcService port.OIDCServicePort) (*echo.Echo, *mocks.MockAuthRepositoryPort) {
+	return setupTestServerWithPosts(t, oidcService, mocks.NewMockPostRepositoryPort(t))
+}
+
+func setupTestServerWithPosts(t *testing.T, oidcService port.OIDCServicePort, mockPostRepo *mocks.MockPostRepositoryPort) (*echo.Echo, *mocks.MockAuthRepositoryPort) {
 	mockRepo := mocks.NewMockAuthRepositoryPort(t)
-	authService := service.NewAuthService(mockRepo)
+	revocationStore := memory.NewTokenRevocationStore()
+	mockVerifier := mocks.NewMockEmailVerificationServicePort(t)
+	mockVerifier.On("SendVerification", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockMFA := mocks.NewMockMFAServicePort(t)
+	mockTokens := mocks.NewMockAccessTokenServicePort(t)
+	authService := service.NewAuthService(mockRepo, mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), revocationStore, mockVerifier, mockMFA, memory.NewLoginAttemptStore())
 	authHandler := httpAdapter.NewAuthHandler(authService)
 
-	// Create mock post repository and handler for router
-	mockPostRepo := mocks.NewMockPostRepositoryPort(t)
-	postService := service.NewPostService(mockPostRepo)
+	// Create post handler for router
+	mockCategoryRepo := mocks.NewMockCategoryRepositoryPort(t)
+	mockTagRepo := mocks.NewMockTagRepositoryPort(t)
+	mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+	mockSearch := mocks.NewMockSearchPort(t)
+	postService := service.NewPostService(mockPostRepo, mockCategoryRepo, mockTagRepo, mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
 	postHandler := httpAdapter.NewPostHandler(postService)
 
-	router := httpAdapter.NewRouter(authHandler, postHandler)
+	categoryService := service.NewCategoryService(mockCategoryRepo)
+	categoryHandler := httpAdapter.NewCategoryHandler(categoryService)
+
+	tagHandler := httpAdapter.NewTagHandler(service.NewTagService(mockTagRepo))
+
+	userHandler := httpAdapter.NewUserHandler(service.NewUserService(mockRepo, authService, postService))
+
+	passwordService := service.NewPasswordResetService(mockRepo, mocks.NewMockPasswordResetRepositoryPort(t), authService, mockTokens, mail.NewOutbox("", "test@localhost"), "http://localhost:3000/reset-password")
+	passwordHandler := httpAdapter.NewPasswordHandler(passwordService)
+
+	router := httpAdapter.NewRouter(authHandler, postHandler, categoryHandler, tagHandler, userHandler, passwordHandler, httpAdapter.NewVerificationHandler(mockVerifier), httpAdapter.NewMFAHandler(mockMFA), httpAdapter.NewAccessTokenHandler(mockTokens), httpAdapter.NewOIDCHandler(oidcService), revocationStore, mockTokens, middleware.NewRateLimiter(memory.NewRateLimitStore(), nil), csrf.NewTokens([]byte("test-csrf-secret")), nil)
 	router.SetupRoutes()
 
 	return router.GetEcho(), mockRepo
@@ -268,3 +301,135 @@ func TestIntegration_UserRegister_ContextCancellation(t *testing.T) {
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestIntegration_UserLogin_Lockout(t *testing.T) {
+	e, mockRepo := setupTestServer(t)
+	mockRepo.On("FindUserByUsername", mock.Anything, "guesser").Return((*domain.User)(nil), sql.ErrNoRows).
+		Times(domain.LoginUserFreeFailures + 1)
+
+	login := func() *httptest.ResponseRecorder {
+		reqBody, _ := json.Marshal(domain.UserLoginReq{Username: "guesser", Password: "password123"})
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", bytes.NewBuffer(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i <= domain.LoginUserFreeFailures; i++ {
+		rec := login()
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Empty(t, rec.Header().Get("Retry-After"))
+	}
+
+	rec := login()
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	var responseBody map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseBody))
+	errorInfo := responseBody["error"].(map[string]interface{})
+	assert.Equal(t, "ACCOUNT_LOCKED", errorInfo["code"])
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestIntegration_UserLogin_IPLockoutIgnoresForwardedFor(t *testing.T) {
+	e, mockRepo := setupTestServer(t)
+	mockRepo.On("FindUserByUsername", mock.Anything, mock.Anything).Return((*domain.User)(nil), sql.ErrNoRows).
+		Times(domain.LoginIPFreeFailures + 1)
+
+	// Each guess names another user and claims another client address
+	login := func(i int) *httptest.ResponseRecorder {
+		reqBody, _ := json.Marshal(domain.UserLoginReq{Username: fmt.Sprintf("guesser%d", i), Password: "password123"})
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", bytes.NewBuffer(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderXForwardedFor, fmt.Sprintf("198.51.100.%d", i))
+		req.Header.Set(echo.HeaderXRealIP, fmt.Sprintf("198.51.100.%d", i))
+		req.RemoteAddr = "203.0.113.7:1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i <= domain.LoginIPFreeFailures; i++ {
+		require.Equal(t, http.StatusUnauthorized, login(i).Code)
+	}
+
+	rec := login(domain.LoginIPFreeFailures + 1)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Contains(t, rec.Body.String(), "TOO_MANY_LOGIN_ATTEMPTS")
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestIntegration_CSRF(t *testing.T) {
+	e, _ := setupTestServer(t)
+	session, err := jwthelper.NewDefaultJWTManager().GenerateToken("user-1", "alice", "user", "session-1", false)
+	require.NoError(t, err)
+	authCookie := &http.Cookie{Name: "auth_token", Value: session}
+
+	// The frontend asks for a token for its session
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/csrf", nil)
+	req.AddCookie(authCookie)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var responseBody map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseBody))
+	token := responseBody["data"].(map[string]interface{})["csrf_token"].(string)
+	var csrfCookie *http.Cookie
+	for _, c := range rec.Result().Cookies() {
+		if c.Name == middleware.CSRFCookie {
+			csrfCookie = c
+		}
+	}
+	require.NotNil(t, csrfCookie)
+	assert.Equal(t, token, csrfCookie.Value)
+	assert.False(t, csrfCookie.HttpOnly, "the frontend reads the cookie")
+
+	t.Run("refuse a cookie-authenticated change without the token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/me/posts", bytes.NewBufferString(`{}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(authCookie)
+		req.AddCookie(csrfCookie)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), "CSRF_TOKEN_INVALID")
+	})
+
+	t.Run("let a change with the token reach the handler", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/me/posts", bytes.NewBufferString(`{}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(middleware.CSRFHeader, token)
+		req.AddCookie(authCookie)
+		req.AddCookie(csrfCookie)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		// The empty post fails validation, past the CSRF check
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "VALIDATION_ERROR")
+	})
+
+	t.Run("refuse the token of an anonymous visitor", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/csrf", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		anonymous := rec.Result().Cookies()[0]
+
+		req = httptest.NewRequest(http.MethodPost, "/api/v1/me/posts", bytes.NewBufferString(`{}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(middleware.CSRFHeader, anonymous.Value)
+		req.AddCookie(authCookie)
+		req.AddCookie(anonymous)
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
@@ -0,0 +1,105 @@
+//go:build integration
+
+package integration
+
+import (
+	"blogg/internal/adapters/driven/oidc"
+	"blogg/internal/adapters/driven/oidc/oidctest"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegration_OIDCLogin(t *testing.T) {
+	idp := oidctest.NewServer()
+	defer idp.Close()
+
+	provider := oidc.NewProvider(oidc.Config{
+		Issuer:       idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RedirectURL:  "http://localhost:3000/auth/callback/test",
+		Scopes:       []string{"email", "profile"},
+	})
+	mockIdentities := mocks.NewMockIdentityRepositoryPort(t)
+	mockUsers := mocks.NewMockAuthRepositoryPort(t)
+	mockAuth := mocks.NewMockAuthServicePort(t)
+	oidcService := service.NewOIDCService(map[string]port.OIDCProviderPort{"test": provider},
+		mockIdentities, mockUsers, mockAuth, mocks.NewMockEmailVerificationServicePort(t))
+	e, _ := setupTestServerWithOIDC(t, oidcService)
+
+	// The browser starts the login and is sent to the provider
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/oidc/test", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	var flowCookie *http.Cookie
+	for _, c := range rec.Result().Cookies() {
+		if c.Name == "oidc_flow" {
+			flowCookie = c
+		}
+	}
+	require.NotNil(t, flowCookie)
+
+	code, state, err := idp.Authorize(rec.Header().Get(echo.HeaderLocation))
+	require.NoError(t, err)
+
+	callback := func(state string, cookie *http.Cookie) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(map[string]string{"code": code, "state": state})
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/oidc/test/callback", bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("refuse a callback from another browser", func(t *testing.T) {
+		rec := callback(state, nil)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("refuse a callback with a forged state", func(t *testing.T) {
+		rec := callback("forged", flowCookie)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("create the user and log them in on the first login", func(t *testing.T) {
+		mockIdentities.On("FindIdentity", mock.Anything, "test", idp.User.Subject).Return(nil, nil).Once()
+		mockUsers.On("FindUserByEmail", mock.Anything, idp.User.Email).Return(nil, sql.ErrNoRows).Once()
+		mockUsers.On("FindUserByUsername", mock.Anything, idp.User.PreferredUsername).Return(nil, sql.ErrNoRows).Once()
+		mockIdentities.On("CreateUserWithIdentity", mock.Anything,
+			mock.MatchedBy(func(u *domain.User) bool {
+				return u.Username == idp.User.PreferredUsername && u.EmailVerifiedAt != nil
+			}),
+			mock.MatchedBy(func(i *domain.Identity) bool { return i.Subject == idp.User.Subject }),
+		).Return(nil).Once()
+		mockAuth.On("LoginAs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(&domain.UserLoginRes{Username: idp.User.PreferredUsername, AccessToken: "access", RefreshToken: "refresh"}, nil).Once()
+
+		rec := callback(state, flowCookie)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var names []string
+		for _, c := range rec.Result().Cookies() {
+			names = append(names, c.Name)
+		}
+		assert.Contains(t, names, "auth_token")
+		assert.Contains(t, names, "refresh_token")
+	})
+}
@@ -0,0 +1,47 @@
+//go:build integration
+
+package integration
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIntegration_GetPost_HidesScheduledPost(t *testing.T) {
+	mockPostRepo := mocks.NewMockPostRepositoryPort(t)
+	e, _ := setupTestServerWithPosts(t, mocks.NewMockOIDCServicePort(t), mockPostRepo)
+
+	// The scheduled post is only missing from the published lookup; the mock
+	// fails the test if the unfiltered FindPostBySlug is asked instead
+	mockPostRepo.On("FindPublishedPostBySlug", mock.Anything, "coming-soon").Return((*domain.Post)(nil), nil).Once()
+	mockPostRepo.On("FindPublishedPostBySlugHistory", mock.Anything, "coming-soon").Return((*domain.Post)(nil), nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/coming-soon", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestIntegration_GetPost_NoRedirectToHiddenPost(t *testing.T) {
+	mockPostRepo := mocks.NewMockPostRepositoryPort(t)
+	e, _ := setupTestServerWithPosts(t, mocks.NewMockOIDCServicePort(t), mockPostRepo)
+
+	// The post was renamed and then unpublished, so its old slug must not
+	// answer with the new one
+	mockPostRepo.On("FindPublishedPostBySlug", mock.Anything, "old-slug").Return((*domain.Post)(nil), nil).Once()
+	mockPostRepo.On("FindPublishedPostBySlugHistory", mock.Anything, "old-slug").Return((*domain.Post)(nil), nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/posts/old-slug", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, rec.Header().Get("Location"))
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	jwthelper "blogg/utils/jwt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// jwksCacheControl bounds how long clients cache the key set. A new signing key
+// should be published at least this long before tokens are signed with it.
+const jwksCacheControl = "public, max-age=300"
+
+type JWKSHandler struct {
+	jwtManager *jwthelper.JWTManager
+}
+
+func NewJWKSHandler(jwtManager *jwthelper.JWTManager) *JWKSHandler {
+	return &JWKSHandler{jwtManager: jwtManager}
+}
+
+// Keys serves the public keys access tokens are verified with, as a bare
+// JWK set (RFC 7517) rather than the usual response envelope so that JWT
+// libraries can read it directly. The same keys sign MFA and OIDC flow
+// tokens, so verifiers must also require the typ header
+// jwthelper.AccessTokenType and the audience jwthelper.AccessTokenAudience.
+func (h *JWKSHandler) Keys(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, jwksCacheControl)
+	return c.JSON(http.StatusOK, h.jwtManager.JWKS())
+}
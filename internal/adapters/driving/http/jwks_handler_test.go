@@ -0,0 +1,93 @@
+//go:build unit
+
+package http_test
+
+import (
+	"blogg/internal/adapters/driving/http"
+	"blogg/internal/core/domain"
+	jwthelper "blogg/utils/jwt"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKSHandler_Keys(t *testing.T) {
+	_, current, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	retired, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys, err := jwthelper.NewKeySet(current, retired.Public())
+	require.NoError(t, err)
+	jwtManager := jwthelper.NewJWTManagerWithKeys(keys, time.Minute)
+
+	e := echo.New()
+	e.GET("/.well-known/jwks.json", http.NewJWKSHandler(jwtManager).Keys)
+	req := httptest.NewRequest(echo.GET, "/.well-known/jwks.json", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderCacheControl), "max-age")
+
+	var set jwthelper.JWKSet
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &set))
+	require.Len(t, set.Keys, 2)
+	assert.Equal(t, "OKP", set.Keys[0].Kty)
+	assert.Equal(t, "EdDSA", set.Keys[0].Alg)
+	assert.Equal(t, "RSA", set.Keys[1].Kty)
+	assert.Equal(t, "RS256", set.Keys[1].Alg)
+
+	// A client holding only the key set verifies tokens the way RFC 9068 asks
+	verify := func(token string) error {
+		parsed, err := jwt.Parse(token, func(t *jwt.Token) (any, error) {
+			for _, k := range set.Keys {
+				if k.Kid == t.Header["kid"] && k.Kty == "OKP" {
+					x, err := base64.RawURLEncoding.DecodeString(k.X)
+					return ed25519.PublicKey(x), err
+				}
+			}
+			return nil, jwthelper.ErrInvalidToken
+		}, jwt.WithValidMethods([]string{"EdDSA"}), jwt.WithAudience(jwthelper.AccessTokenAudience))
+		if err != nil {
+			return err
+		}
+		if parsed.Header["typ"] != jwthelper.AccessTokenType {
+			return jwthelper.ErrInvalidToken
+		}
+		return nil
+	}
+
+	accessToken, err := jwtManager.GenerateToken("user-1", "user", domain.RoleUser, "session-1", false)
+	require.NoError(t, err)
+	assert.NoError(t, verify(accessToken))
+
+	// Tokens for other steps carry the same signature but not the access token marks
+	mfaToken, err := jwtManager.GenerateMFAToken("user-1")
+	require.NoError(t, err)
+	assert.Error(t, verify(mfaToken))
+	flowToken, err := jwtManager.GenerateOIDCFlowToken("test", "state", "nonce", "verifier")
+	require.NoError(t, err)
+	assert.Error(t, verify(flowToken))
+}
+
+func TestJWKSHandler_Keys_HMAC(t *testing.T) {
+	e := echo.New()
+	e.GET("/.well-known/jwks.json", http.NewJWKSHandler(jwthelper.NewJWTManager("test-secret", time.Minute)).Keys)
+	req := httptest.NewRequest(echo.GET, "/.well-known/jwks.json", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, 200, rec.Code)
+	assert.JSONEq(t, `{"keys":[]}`, rec.Body.String())
+}
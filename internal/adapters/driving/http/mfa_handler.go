@@ -0,0 +1,114 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type MFAHandler struct {
+	mfaService port.MFAServicePort
+	validate   *validator.Validate
+}
+
+func NewMFAHandler(mfaService port.MFAServicePort) *MFAHandler {
+	return &MFAHandler{
+		mfaService: mfaService,
+		validate:   newValidator(),
+	}
+}
+
+// Enroll returns a new TOTP secret and its otpauth URI for a QR code
+func (h *MFAHandler) Enroll(c echo.Context) error {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	enrollment, err := h.mfaService.Enroll(c.Request().Context(), userID)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Scan the code with an authenticator app, then confirm with a code",
+		Data:       enrollment,
+	})
+}
+
+// Enable confirms enrollment and returns the recovery codes, which are not
+// shown again
+func (h *MFAHandler) Enable(c echo.Context) error {
+	var req domain.EnableMFAReq
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+	var sessionID string
+	if claims := middleware.GetClaims(c); claims != nil {
+		sessionID = claims.SessionID
+	}
+
+	result, err := h.mfaService.Enable(c.Request().Context(), userID, sessionID, req.Code)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Two-factor authentication enabled",
+		Data:       result,
+	})
+}
+
+func (h *MFAHandler) Disable(c echo.Context) error {
+	var req domain.DisableMFAReq
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	req.IP = c.RealIP()
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	if err := h.mfaService.Disable(c.Request().Context(), userID, &req); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Two-factor authentication disabled",
+		Data:       nil,
+	})
+}
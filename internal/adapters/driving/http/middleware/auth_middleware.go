@@ -2,45 +2,45 @@ package middleware
 
 import (
 	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
 	"blogg/utils/errs"
 	jwthelper "blogg/utils/jwt"
+	"context"
+	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 )
 
 type AuthMiddleware struct {
-	jwtManager *jwthelper.JWTManager
+	jwtManager   *jwthelper.JWTManager
+	revocations  port.TokenRevocationStorePort
+	accessTokens port.AccessTokenServicePort
 }
 
-func NewAuthMiddleware(jwtManager *jwthelper.JWTManager) *AuthMiddleware {
+func NewAuthMiddleware(jwtManager *jwthelper.JWTManager, revocations port.TokenRevocationStorePort, accessTokens port.AccessTokenServicePort) *AuthMiddleware {
 	return &AuthMiddleware{
-		jwtManager: jwtManager,
+		jwtManager:   jwtManager,
+		revocations:  revocations,
+		accessTokens: accessTokens,
 	}
 }
 
-// RequireAuth middleware validates JWT token from cookie
+// RequireAuth middleware validates JWT token from cookie, or a personal access
+// token on routes that allow them through TokenScope
 func (m *AuthMiddleware) RequireAuth(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		// Try to get token from cookie first
-		cookie, err := c.Cookie("auth_token")
-		var token string
-
-		if err == nil && cookie.Value != "" {
-			token = cookie.Value
-		} else {
-			// Fallback: Try Authorization header
-			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
-				return httphelper.HandleServiceError(c, errs.NewUnauthorizedError("Missing authentication token"))
-			}
+		token, err := extractToken(c)
+		if err != nil {
+			return httphelper.HandleServiceError(c, err)
+		}
 
-			// Extract Bearer token
-			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				return httphelper.HandleServiceError(c, errs.NewUnauthorizedError("Invalid authorization header format"))
+		if strings.HasPrefix(token, domain.AccessTokenPrefix) {
+			if err := m.authenticateAccessToken(c, token); err != nil {
+				return httphelper.HandleServiceError(c, err)
 			}
-			token = parts[1]
+			return next(c)
 		}
 
 		// Validate token
@@ -49,9 +49,17 @@ func (m *AuthMiddleware) RequireAuth(next echo.HandlerFunc) echo.HandlerFunc {
 			return httphelper.HandleServiceError(c, errs.NewUnauthorizedError("Invalid or expired token"))
 		}
 
+		// Reject tokens revoked by logout
+		revoked, err := m.isRevoked(c.Request().Context(), claims)
+		if err != nil {
+			return httphelper.HandleServiceError(c, err)
+		}
+		if revoked {
+			return httphelper.HandleServiceError(c, errs.NewUnauthorizedError("Invalid or expired token"))
+		}
+
 		// Store user info in context
-		c.Set("user_id", claims.UserID)
-		c.Set("username", claims.Username)
+		setClaims(c, claims)
 
 		return next(c)
 	}
@@ -60,18 +68,132 @@ func (m *AuthMiddleware) RequireAuth(next echo.HandlerFunc) echo.HandlerFunc {
 // OptionalAuth middleware validates token if present but doesn't require it
 func (m *AuthMiddleware) OptionalAuth(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		cookie, err := c.Cookie("auth_token")
-		if err == nil && cookie.Value != "" {
-			claims, err := m.jwtManager.Validate(cookie.Value)
-			if err == nil {
-				c.Set("user_id", claims.UserID)
-				c.Set("username", claims.Username)
+		// Personal access tokens are only for routes that name a scope
+		token, err := extractToken(c)
+		if err != nil || strings.HasPrefix(token, domain.AccessTokenPrefix) {
+			return next(c)
+		}
+
+		claims, err := m.jwtManager.Validate(token)
+		if err == nil {
+			revoked, err := m.isRevoked(c.Request().Context(), claims)
+			if err != nil {
+				return httphelper.HandleServiceError(c, err)
+			}
+			if !revoked {
+				setClaims(c, claims)
 			}
 		}
 		return next(c)
 	}
 }
 
+// RequireRole allows only users with one of roles. It reads the role from the
+// token claims, so it must run after RequireAuth. Admins without 2FA get a
+// distinct error so that the frontend can send them to enrollment.
+func (m *AuthMiddleware) RequireRole(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			actor, err := GetActor(c)
+			if err != nil {
+				return httphelper.HandleServiceError(c, err)
+			}
+			if !actor.HasRole(roles...) {
+				role, _ := c.Get("role").(string)
+				if (domain.Actor{Role: role}).HasRole(roles...) {
+					return httphelper.HandleServiceError(c, domain.ErrMFARequired)
+				}
+				return httphelper.HandleServiceError(c, errs.NewForbiddenError("Insufficient permissions"))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// TokenScope names the scope a personal access token needs on the routes after
+// it: read for safe methods, write for the rest. Routes without it refuse
+// personal access tokens. It must run before RequireAuth.
+func (m *AuthMiddleware) TokenScope(read, write string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			scope := write
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				scope = read
+			}
+			c.Set("token_scope", scope)
+
+			return next(c)
+		}
+	}
+}
+
+// authenticateAccessToken stores the owner of a personal access token in the
+// context if the token holds the scope the route asks for
+func (m *AuthMiddleware) authenticateAccessToken(c echo.Context, raw string) error {
+	pat, user, err := m.accessTokens.Authenticate(c.Request().Context(), raw)
+	if err != nil {
+		return err
+	}
+
+	scope, _ := c.Get("token_scope").(string)
+	if scope == "" {
+		return domain.ErrAccessTokenNotAllowed
+	}
+	if !pat.HasScope(scope) {
+		return domain.ErrInsufficientScope
+	}
+
+	c.Set("user_id", user.ID)
+	c.Set("username", user.Username)
+	c.Set("role", user.Role)
+	c.Set("mfa", pat.MFA)
+	c.Set("access_token", pat)
+	return nil
+}
+
+// isRevoked checks the revocation store. Tokens without a jti predate
+// revocation support and cannot be revoked individually, so they are refused.
+func (m *AuthMiddleware) isRevoked(ctx context.Context, claims *jwthelper.JWTClaims) (bool, error) {
+	if claims.ID == "" || claims.IssuedAt == nil {
+		return true, nil
+	}
+
+	return m.revocations.IsTokenRevoked(ctx, claims.ID, claims.SessionID, claims.UserID, claims.IssuedAt.Time)
+}
+
+// extractToken reads the token from the auth_token cookie, falling back to a
+// Bearer Authorization header
+func extractToken(c echo.Context) (string, error) {
+	// Try to get token from cookie first
+	cookie, err := c.Cookie("auth_token")
+	if err == nil && cookie.Value != "" {
+		return cookie.Value, nil
+	}
+
+	// Fallback: Try Authorization header
+	authHeader := c.Request().Header.Get("Authorization")
+	if authHeader == "" {
+		return "", errs.NewUnauthorizedError("Missing authentication token")
+	}
+
+	// Extract Bearer token
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return "", errs.NewUnauthorizedError("Invalid authorization header format")
+	}
+	return parts[1], nil
+}
+
+func setClaims(c echo.Context, claims *jwthelper.JWTClaims) {
+	c.Set("user_id", claims.UserID)
+	c.Set("username", claims.Username)
+	c.Set("role", claims.Role)
+	c.Set("mfa", claims.MFA)
+	c.Set("claims", claims)
+}
+
 // GetUserID extracts user ID from context (set by auth middleware)
 // This is a defensive check - middleware should already validate and set this
 func GetUserID(c echo.Context) (string, error) {
@@ -81,3 +203,24 @@ func GetUserID(c echo.Context) (string, error) {
 	}
 	return userID, nil
 }
+
+// GetActor returns the current user and their role for policy checks. Admins
+// only act as admins in sessions started with 2FA; otherwise they get the
+// rights of a plain user.
+func GetActor(c echo.Context) (domain.Actor, error) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		return domain.Actor{}, err
+	}
+	role, _ := c.Get("role").(string)
+	if mfa, _ := c.Get("mfa").(bool); role == domain.RoleAdmin && !mfa {
+		role = domain.RoleUser
+	}
+	return domain.Actor{UserID: userID, Role: role}, nil
+}
+
+// GetClaims returns the validated token claims, or nil for anonymous requests
+func GetClaims(c echo.Context) *jwthelper.JWTClaims {
+	claims, _ := c.Get("claims").(*jwthelper.JWTClaims)
+	return claims
+}
@@ -0,0 +1,345 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"blogg/internal/adapters/driven/memory"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/core/domain"
+	"blogg/mocks"
+	jwthelper "blogg/utils/jwt"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMiddleware_RequireAuth_Revocation(t *testing.T) {
+	jwtManager := jwthelper.NewJWTManager("test-secret", time.Minute)
+
+	type revocationTest struct {
+		name           string
+		revoke         func(store *memory.TokenRevocationStore, claims *jwthelper.JWTClaims)
+		expectedStatus int
+	}
+
+	tests := []revocationTest{
+		{
+			name:           "accept a token that was not revoked",
+			revoke:         func(*memory.TokenRevocationStore, *jwthelper.JWTClaims) {},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "reject a token revoked by logout",
+			revoke: func(store *memory.TokenRevocationStore, claims *jwthelper.JWTClaims) {
+				store.RevokeToken(context.Background(), claims.ID, claims.ExpiresAt.Time)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "reject a token of a revoked session",
+			revoke: func(store *memory.TokenRevocationStore, claims *jwthelper.JWTClaims) {
+				store.RevokeToken(context.Background(), claims.SessionID, claims.ExpiresAt.Time)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "reject a token issued before log out everywhere",
+			revoke: func(store *memory.TokenRevocationStore, claims *jwthelper.JWTClaims) {
+				store.RevokeUserTokens(context.Background(), claims.UserID, time.Now(), time.Now().Add(time.Minute))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := memory.NewTokenRevocationStore()
+			token, err := jwtManager.GenerateToken("user-1", "user", domain.RoleUser, "session-1", false)
+			require.NoError(t, err)
+			claims, err := jwtManager.Validate(token)
+			require.NoError(t, err)
+			tc.revoke(store, claims)
+
+			e := echo.New()
+			m := middleware.NewAuthMiddleware(jwtManager, store, mocks.NewMockAccessTokenServicePort(t))
+			e.GET("/me", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, m.RequireAuth)
+
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestAuthMiddleware_RequireAuth_TokenIssuedAfterRevocation(t *testing.T) {
+	jwtManager := jwthelper.NewJWTManager("test-secret", time.Minute)
+	store := memory.NewTokenRevocationStore()
+
+	// The new token is issued within the same second as the revocation, but
+	// not within the same microsecond, the precision of iat
+	before, err := jwtManager.GenerateToken("user-1", "user", domain.RoleUser, "session-1", false)
+	require.NoError(t, err)
+	require.NoError(t, store.RevokeUserTokens(context.Background(), "user-1", time.Now(), time.Now().Add(time.Minute)))
+	time.Sleep(time.Millisecond)
+	after, err := jwtManager.GenerateToken("user-1", "user", domain.RoleUser, "session-1", false)
+	require.NoError(t, err)
+
+	e := echo.New()
+	m := middleware.NewAuthMiddleware(jwtManager, store, mocks.NewMockAccessTokenServicePort(t))
+	e.GET("/me", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, m.RequireAuth)
+	get := func(token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, get(before))
+	assert.Equal(t, http.StatusOK, get(after))
+}
+
+func TestAuthMiddleware_RequireRole(t *testing.T) {
+	jwtManager := jwthelper.NewJWTManager("test-secret", time.Minute)
+
+	tests := []struct {
+		name           string
+		role           string
+		mfa            bool
+		expectedStatus int
+		expectedCode   string
+	}{
+		{name: "allow a listed role", role: domain.RoleEditor, expectedStatus: http.StatusOK},
+		{name: "allow another listed role", role: domain.RoleAdmin, mfa: true, expectedStatus: http.StatusOK},
+		{name: "forbid other roles", role: domain.RoleUser, expectedStatus: http.StatusForbidden, expectedCode: "FORBIDDEN"},
+		{name: "require 2FA from admins", role: domain.RoleAdmin, expectedStatus: http.StatusForbidden, expectedCode: "MFA_REQUIRED"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			token, err := jwtManager.GenerateToken("user-1", "user", tc.role, "session-1", tc.mfa)
+			require.NoError(t, err)
+
+			e := echo.New()
+			m := middleware.NewAuthMiddleware(jwtManager, memory.NewTokenRevocationStore(), mocks.NewMockAccessTokenServicePort(t))
+			e.GET("/review", func(c echo.Context) error { return c.NoContent(http.StatusOK) },
+				m.RequireAuth, m.RequireRole(domain.RoleEditor, domain.RoleAdmin))
+
+			req := httptest.NewRequest(http.MethodGet, "/review", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestAuthMiddleware_RequireAuth_RejectsMFAToken(t *testing.T) {
+	jwtManager := jwthelper.NewJWTManager("test-secret", time.Minute)
+	token, err := jwtManager.GenerateMFAToken("user-1")
+	require.NoError(t, err)
+
+	e := echo.New()
+	m := middleware.NewAuthMiddleware(jwtManager, memory.NewTokenRevocationStore(), mocks.NewMockAccessTokenServicePort(t))
+	e.GET("/me", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, m.RequireAuth)
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestAuthMiddleware_AccessTokens(t *testing.T) {
+	const raw = domain.AccessTokenPrefix + "secret"
+	readToken := &domain.PersonalAccessToken{ID: "pat-1", UserID: "user-1", Scopes: []string{domain.ScopePostsRead}}
+	owner := &domain.User{ID: "user-1", Username: "ci", Role: domain.RoleUser}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		authErr        error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{name: "accept a token holding the read scope", method: http.MethodGet, path: "/me/posts", expectedStatus: http.StatusOK},
+		{name: "refuse a write without the write scope", method: http.MethodPost, path: "/me/posts", expectedStatus: http.StatusForbidden, expectedCode: "INSUFFICIENT_SCOPE"},
+		{name: "refuse tokens on routes without a scope", method: http.MethodGet, path: "/me/sessions", expectedStatus: http.StatusForbidden, expectedCode: "ACCESS_TOKEN_NOT_ALLOWED"},
+		{name: "reject an unknown or expired token", method: http.MethodGet, path: "/me/posts", authErr: domain.ErrInvalidAccessToken, expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens := mocks.NewMockAccessTokenServicePort(t)
+			if tc.authErr != nil {
+				tokens.On("Authenticate", mock.Anything, raw).Return(nil, nil, tc.authErr).Once()
+			} else {
+				tokens.On("Authenticate", mock.Anything, raw).Return(readToken, owner, nil).Once()
+			}
+
+			e := echo.New()
+			m := middleware.NewAuthMiddleware(jwthelper.NewJWTManager("test-secret", time.Minute), memory.NewTokenRevocationStore(), tokens)
+			ok := func(c echo.Context) error {
+				userID, err := middleware.GetUserID(c)
+				require.NoError(t, err)
+				assert.Equal(t, "user-1", userID)
+				return c.NoContent(http.StatusOK)
+			}
+			posts := e.Group("/me/posts", m.TokenScope(domain.ScopePostsRead, domain.ScopePostsWrite), m.RequireAuth)
+			posts.GET("", ok)
+			posts.POST("", ok)
+			e.GET("/me/sessions", ok, m.RequireAuth)
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+raw)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				assert.Contains(t, rec.Body.String(), tc.expectedCode)
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware_RequireAuth_SigningKeys(t *testing.T) {
+	current := newEd25519Key(t)
+	retired := newEd25519Key(t)
+	retiredRSA, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys, err := jwthelper.NewKeySet(current, retired.Public(), retiredRSA.Public())
+	require.NoError(t, err)
+	keys.AcceptSecret("previous-secret")
+	verifier := jwthelper.NewJWTManagerWithKeys(keys, time.Minute)
+
+	signWith := func(signer crypto.Signer) func(t *testing.T) string {
+		return func(t *testing.T) string {
+			keys, err := jwthelper.NewKeySet(signer)
+			require.NoError(t, err)
+			token, err := jwthelper.NewJWTManagerWithKeys(keys, time.Minute).GenerateToken("user-1", "user", domain.RoleUser, "session-1", false)
+			require.NoError(t, err)
+			return token
+		}
+	}
+	signWithSecret := func(secret string) func(t *testing.T) string {
+		return func(t *testing.T) string {
+			token, err := jwthelper.NewJWTManager(secret, time.Minute).GenerateToken("user-1", "user", domain.RoleUser, "session-1", false)
+			require.NoError(t, err)
+			return token
+		}
+	}
+
+	type signingKeyTest struct {
+		name           string
+		token          func(t *testing.T) string
+		expectedStatus int
+	}
+
+	tests := []signingKeyTest{
+		{
+			name:           "accept a token signed with the current key",
+			token:          signWith(current),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "accept a token signed with a retired Ed25519 key",
+			token:          signWith(retired),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "accept a token signed with a retired RSA key",
+			token:          signWith(retiredRSA),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "accept a token signed with the previous shared secret",
+			token:          signWithSecret("previous-secret"),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "reject a token signed with an unknown key",
+			token:          signWith(newEd25519Key(t)),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "reject a token signed with another secret",
+			token:          signWithSecret("other-secret"),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "reject a token of the current key without the access token type and audience",
+			token: func(t *testing.T) string {
+				valid, err := verifier.GenerateToken("user-1", "user", domain.RoleUser, "session-1", false)
+				require.NoError(t, err)
+				parsed, _, err := jwt.NewParser().ParseUnverified(valid, &jwthelper.JWTClaims{})
+				require.NoError(t, err)
+
+				claims := parsed.Claims.(*jwthelper.JWTClaims)
+				claims.Audience = nil
+				bare := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+				bare.Header["kid"] = parsed.Header["kid"]
+				token, err := bare.SignedString(current)
+				require.NoError(t, err)
+				return token
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "reject a token naming a known key with another algorithm",
+			token: func(t *testing.T) string {
+				valid, err := verifier.GenerateToken("user-1", "user", domain.RoleUser, "session-1", false)
+				require.NoError(t, err)
+				parsed, _, err := jwt.NewParser().ParseUnverified(valid, &jwthelper.JWTClaims{})
+				require.NoError(t, err)
+
+				forged := jwt.NewWithClaims(jwt.SigningMethodRS256, parsed.Claims)
+				forged.Header["kid"] = parsed.Header["kid"]
+				token, err := forged.SignedString(retiredRSA)
+				require.NoError(t, err)
+				return token
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			m := middleware.NewAuthMiddleware(verifier, memory.NewTokenRevocationStore(), mocks.NewMockAccessTokenServicePort(t))
+			e.GET("/me", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, m.RequireAuth)
+
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+tc.token(t))
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func newEd25519Key(t *testing.T) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return key
+}
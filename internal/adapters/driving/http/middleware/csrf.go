@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/core/domain"
+	"blogg/utils/csrf"
+	jwthelper "blogg/utils/jwt"
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// The CSRF token travels in a cookie the frontend can read and must be
+// echoed in the header (double submit). Other sites can make the browser
+// send the cookie but can neither read it nor set the header.
+const (
+	CSRFCookie = "csrf_token"
+	CSRFHeader = "X-CSRF-Token"
+)
+
+type CSRFMiddleware struct {
+	jwtManager *jwthelper.JWTManager
+	tokens     *csrf.Tokens
+}
+
+func NewCSRFMiddleware(jwtManager *jwthelper.JWTManager, tokens *csrf.Tokens) *CSRFMiddleware {
+	return &CSRFMiddleware{
+		jwtManager: jwtManager,
+		tokens:     tokens,
+	}
+}
+
+// Protect requires a CSRF token issued for the session on unsafe requests
+// authenticated by the auth_token cookie. Requests with a Bearer token or
+// without a valid session pass: browsers do not attach those on their own,
+// and RequireAuth rejects invalid sessions anyway.
+func (m *CSRFMiddleware) Protect(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		switch c.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+			return next(c)
+		}
+
+		session, err := c.Cookie("auth_token")
+		if err != nil || session.Value == "" {
+			return next(c)
+		}
+		claims, err := m.jwtManager.Validate(session.Value)
+		if err != nil {
+			return next(c)
+		}
+
+		header := c.Request().Header.Get(CSRFHeader)
+		cookie, err := c.Cookie(CSRFCookie)
+		if err != nil || header == "" ||
+			subtle.ConstantTimeCompare([]byte(header), []byte(cookie.Value)) != 1 ||
+			!m.tokens.Valid(claims.SessionID, header) {
+			return httphelper.HandleServiceError(c, domain.ErrInvalidCSRFToken)
+		}
+
+		return next(c)
+	}
+}
@@ -0,0 +1,123 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/utils/csrf"
+	jwthelper "blogg/utils/jwt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSRFMiddleware_Protect(t *testing.T) {
+	jwtManager := jwthelper.NewJWTManager("test-secret", time.Minute)
+	tokens := csrf.NewTokens([]byte("csrf-secret"))
+	session, err := jwtManager.GenerateToken("user-1", "alice", "user", "session-1", false)
+	require.NoError(t, err)
+	valid := tokens.Issue("session-1")
+	otherSession := tokens.Issue("session-2")
+	otherKey := csrf.NewTokens([]byte("other-secret")).Issue("session-1")
+
+	e := echo.New()
+	e.Use(middleware.NewCSRFMiddleware(jwtManager, tokens).Protect)
+	handler := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.GET("/me/posts", handler)
+	e.POST("/me/posts", handler)
+
+	type csrfTest struct {
+		name           string
+		method         string
+		authCookie     string
+		bearer         string
+		csrfCookie     string
+		csrfHeader     string
+		expectedStatus int
+	}
+
+	tests := []csrfTest{
+		{
+			name:           "let safe methods through without a token",
+			method:         http.MethodGet,
+			authCookie:     session,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "accept a token of the session in cookie and header",
+			method:         http.MethodPost,
+			authCookie:     session,
+			csrfCookie:     valid,
+			csrfHeader:     valid,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "reject a cookie-authenticated change without a token",
+			method:         http.MethodPost,
+			authCookie:     session,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "reject a header that does not match the cookie",
+			method:         http.MethodPost,
+			authCookie:     session,
+			csrfCookie:     valid,
+			csrfHeader:     tokens.Issue("session-1"),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "reject a token issued to another session",
+			method:         http.MethodPost,
+			authCookie:     session,
+			csrfCookie:     otherSession,
+			csrfHeader:     otherSession,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "reject a token signed with another key",
+			method:         http.MethodPost,
+			authCookie:     session,
+			csrfCookie:     otherKey,
+			csrfHeader:     otherKey,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "exempt Bearer requests",
+			method:         http.MethodPost,
+			bearer:         session,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "leave requests without a valid session to authentication",
+			method:         http.MethodPost,
+			authCookie:     "not-a-token",
+			expectedStatus: http.StatusOK,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "/me/posts", nil)
+			if tc.authCookie != "" {
+				req.AddCookie(&http.Cookie{Name: "auth_token", Value: tc.authCookie})
+			}
+			if tc.bearer != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+tc.bearer)
+			}
+			if tc.csrfCookie != "" {
+				req.AddCookie(&http.Cookie{Name: middleware.CSRFCookie, Value: tc.csrfCookie})
+			}
+			if tc.csrfHeader != "" {
+				req.Header.Set(middleware.CSRFHeader, tc.csrfHeader)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
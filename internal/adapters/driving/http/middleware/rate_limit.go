@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/utils/token"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RateLimiter applies the rate limit policy of a route group
+type RateLimiter struct {
+	store    port.RateLimitStorePort
+	policies map[string]domain.RateLimitPolicy
+}
+
+func NewRateLimiter(store port.RateLimitStorePort, policies map[string]domain.RateLimitPolicy) *RateLimiter {
+	return &RateLimiter{
+		store:    store,
+		policies: policies,
+	}
+}
+
+// Limit counts requests to the routes after it against the policy of group.
+// Groups without a policy are not limited. Policies keyed by user must run
+// after RequireAuth.
+func (l *RateLimiter) Limit(group string) echo.MiddlewareFunc {
+	policy, ok := l.policies[group]
+	if !ok {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			now := time.Now()
+			result, err := l.store.TakeToken(c.Request().Context(), group+":"+rateLimitKey(c, policy.KeyBy), policy, now)
+			if err != nil {
+				return httphelper.HandleServiceError(c, err)
+			}
+
+			setRateLimitHeaders(c, policy, result, now)
+			if !result.Allowed {
+				return httphelper.HandleServiceError(c, domain.ErrRateLimited.WithRetryAfter(result.RetryAfter))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// rateLimitKey names the bucket of the request. Tokens are hashed so that
+// stored keys cannot be used as credentials.
+func rateLimitKey(c echo.Context, keyBy string) string {
+	switch keyBy {
+	case domain.RateLimitKeyUser:
+		if userID, err := GetUserID(c); err == nil {
+			return "user:" + userID
+		}
+	case domain.RateLimitKeyToken:
+		if raw, err := extractToken(c); err == nil {
+			return "token:" + token.Hash(raw)
+		}
+	}
+	return "ip:" + c.RealIP()
+}
+
+// setRateLimitHeaders sends the RateLimit header fields of the IETF
+// httpapi-ratelimit-headers draft. Reset is when the bucket is full again.
+func setRateLimitHeaders(c echo.Context, policy domain.RateLimitPolicy, result *domain.RateLimitResult, now time.Time) {
+	h := c.Response().Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(policy.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	h.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(result.FullAt.Sub(now)), 10))
+	h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Limit, ceilSeconds(policy.Period)))
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,162 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"blogg/internal/adapters/driven/memory"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/core/domain"
+	"blogg/mocks"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	setup := func(policy domain.RateLimitPolicy, before ...echo.MiddlewareFunc) *echo.Echo {
+		limiter := middleware.NewRateLimiter(memory.NewRateLimitStore(), map[string]domain.RateLimitPolicy{"test": policy})
+		e := echo.New()
+		handler := func(c echo.Context) error { return c.String(http.StatusOK, "ok") }
+		e.GET("/limited", handler, append(before, limiter.Limit("test"))...)
+		e.GET("/unlimited", handler, limiter.Limit("other"))
+		return e
+	}
+	get := func(e *echo.Echo, path, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("allow a burst of Limit requests and then refuse with 429", func(t *testing.T) {
+		e := setup(domain.RateLimitPolicy{Limit: 3, Period: time.Minute, KeyBy: domain.RateLimitKeyIP})
+
+		for remaining := 2; remaining >= 0; remaining-- {
+			rec := get(e, "/limited", "203.0.113.7")
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "3", rec.Header().Get("RateLimit-Limit"))
+			assert.Equal(t, string(rune('0'+remaining)), rec.Header().Get("RateLimit-Remaining"))
+			assert.Equal(t, "3;w=60", rec.Header().Get("RateLimit-Policy"))
+		}
+
+		rec := get(e, "/limited", "203.0.113.7")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "20", rec.Header().Get("Retry-After"))
+		assert.Equal(t, "60", rec.Header().Get("RateLimit-Reset"))
+		assert.Contains(t, rec.Body.String(), "RATE_LIMITED")
+	})
+
+	t.Run("count each IP separately", func(t *testing.T) {
+		e := setup(domain.RateLimitPolicy{Limit: 1, Period: time.Minute, KeyBy: domain.RateLimitKeyIP})
+
+		assert.Equal(t, http.StatusOK, get(e, "/limited", "203.0.113.7").Code)
+		assert.Equal(t, http.StatusTooManyRequests, get(e, "/limited", "203.0.113.7").Code)
+		assert.Equal(t, http.StatusOK, get(e, "/limited", "203.0.113.8").Code)
+	})
+
+	getVia := func(e *echo.Echo, ip, forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/limited", nil)
+		req.RemoteAddr = ip + ":1234"
+		req.Header.Set(echo.HeaderXForwardedFor, forwardedFor)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("ignore X-Forwarded-For without a trusted proxy", func(t *testing.T) {
+		e := setup(domain.RateLimitPolicy{Limit: 1, Period: time.Minute, KeyBy: domain.RateLimitKeyIP})
+		extractor, err := middleware.NewIPExtractor(nil)
+		require.NoError(t, err)
+		e.IPExtractor = extractor
+
+		assert.Equal(t, http.StatusOK, getVia(e, "203.0.113.7", "198.51.100.1").Code)
+		// A new forwarded address does not buy a new bucket
+		assert.Equal(t, http.StatusTooManyRequests, getVia(e, "203.0.113.7", "198.51.100.2").Code)
+		// Nor can another client spend the bucket of the address it names
+		assert.Equal(t, http.StatusOK, getVia(e, "203.0.113.8", "203.0.113.7").Code)
+	})
+
+	t.Run("use X-Forwarded-For from a trusted proxy", func(t *testing.T) {
+		e := setup(domain.RateLimitPolicy{Limit: 1, Period: time.Minute, KeyBy: domain.RateLimitKeyIP})
+		extractor, err := middleware.NewIPExtractor([]string{"10.0.0.1", "192.0.2.0/24"})
+		require.NoError(t, err)
+		e.IPExtractor = extractor
+
+		assert.Equal(t, http.StatusOK, getVia(e, "10.0.0.1", "203.0.113.7").Code)
+		assert.Equal(t, http.StatusOK, getVia(e, "10.0.0.1", "203.0.113.8").Code)
+		// Entries the client prepended are not trusted, the proxy's is
+		assert.Equal(t, http.StatusTooManyRequests, getVia(e, "10.0.0.1", "198.51.100.1, 203.0.113.7, 192.0.2.5").Code)
+		// Headers from clients that are not proxies are still ignored
+		assert.Equal(t, http.StatusOK, getVia(e, "198.51.100.9", "203.0.113.7").Code)
+	})
+
+	t.Run("count a user across IPs", func(t *testing.T) {
+		authenticate := func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				c.Set("user_id", "user-1")
+				return next(c)
+			}
+		}
+		e := setup(domain.RateLimitPolicy{Limit: 1, Period: time.Minute, KeyBy: domain.RateLimitKeyUser}, authenticate)
+
+		assert.Equal(t, http.StatusOK, get(e, "/limited", "203.0.113.7").Code)
+		assert.Equal(t, http.StatusTooManyRequests, get(e, "/limited", "203.0.113.8").Code)
+	})
+
+	t.Run("leave groups without a policy alone", func(t *testing.T) {
+		e := setup(domain.RateLimitPolicy{Limit: 1, Period: time.Minute, KeyBy: domain.RateLimitKeyIP})
+
+		for i := 0; i < 3; i++ {
+			rec := get(e, "/unlimited", "203.0.113.7")
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+		}
+	})
+
+	t.Run("fail when the store fails", func(t *testing.T) {
+		store := mocks.NewMockRateLimitStorePort(t)
+		store.On("TakeToken", mock.Anything, "test:ip:203.0.113.7", mock.Anything, mock.Anything).
+			Return(nil, errors.New("connection refused")).Once()
+		limiter := middleware.NewRateLimiter(store, map[string]domain.RateLimitPolicy{
+			"test": {Limit: 1, Period: time.Minute, KeyBy: domain.RateLimitKeyIP},
+		})
+		e := echo.New()
+		e.GET("/limited", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, limiter.Limit("test"))
+
+		assert.Equal(t, http.StatusInternalServerError, get(e, "/limited", "203.0.113.7").Code)
+	})
+}
+
+func TestNewIPExtractor_InvalidProxy(t *testing.T) {
+	_, err := middleware.NewIPExtractor([]string{"not-an-ip"})
+	assert.Error(t, err)
+}
+
+func TestRateLimitPolicy_Take(t *testing.T) {
+	policy := domain.RateLimitPolicy{Limit: 10, Period: 10 * time.Second}
+	now := time.Now()
+
+	bucket, result := policy.Take(&domain.RateLimitBucket{Tokens: 0, UpdatedAt: now.Add(-2500 * time.Millisecond)}, now)
+	assert.True(t, result.Allowed, "2.5s refill one token a second")
+	assert.InDelta(t, 1.5, bucket.Tokens, 0.001)
+	assert.Equal(t, 1, result.Remaining)
+	assert.Equal(t, now.Add(8500*time.Millisecond), result.FullAt)
+
+	bucket, result = policy.Take(&domain.RateLimitBucket{Tokens: 0.25, UpdatedAt: now}, now)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 750*time.Millisecond, result.RetryAfter)
+	assert.InDelta(t, 0.25, bucket.Tokens, 0.001)
+
+	_, result = policy.Take(&domain.RateLimitBucket{Tokens: 3, UpdatedAt: now.Add(time.Hour)}, now)
+	assert.True(t, result.Allowed, "a bucket from a clock ahead of ours is not refilled backwards")
+	assert.Equal(t, 2, result.Remaining)
+}
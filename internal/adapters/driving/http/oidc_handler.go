@@ -0,0 +1,90 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	jwthelper "blogg/utils/jwt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// oidcFlowCookie holds the signed state of a login at an identity provider
+const oidcFlowCookie = "oidc_flow"
+
+// OIDCHandler logs users in through external OpenID Connect providers. The
+// provider redirects back to the frontend, which posts the code and state
+// to Callback.
+type OIDCHandler struct {
+	oidcService port.OIDCServicePort
+	validate    *validator.Validate
+}
+
+func NewOIDCHandler(oidcService port.OIDCServicePort) *OIDCHandler {
+	return &OIDCHandler{
+		oidcService: oidcService,
+		validate:    newValidator(),
+	}
+}
+
+// Start redirects the browser to the provider
+func (h *OIDCHandler) Start(c echo.Context) error {
+	start, err := h.oidcService.StartLogin(c.Request().Context(), c.Param("provider"))
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	setOIDCFlowCookie(c, start.FlowToken, int(jwthelper.OIDCFlowExpiration.Seconds()))
+
+	return c.Redirect(http.StatusFound, start.AuthURL)
+}
+
+func (h *OIDCHandler) Callback(c echo.Context) error {
+	var req domain.OIDCCallbackReq
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	cookie, err := c.Cookie(oidcFlowCookie)
+	if err != nil || cookie.Value == "" {
+		return httphelper.HandleServiceError(c, domain.ErrInvalidOIDCState)
+	}
+	// A flow can be finished once
+	setOIDCFlowCookie(c, "", -1)
+
+	req.Provider = c.Param("provider")
+	req.FlowToken = cookie.Value
+	req.UserAgent = c.Request().UserAgent()
+	req.IP = c.RealIP()
+	result, err := h.oidcService.Callback(c.Request().Context(), &req)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return loginResponse(c, result)
+}
+
+// setOIDCFlowCookie uses SameSite=Lax, unlike the auth cookies, so that the
+// cookie survives the top-level navigation back from the provider
+func setOIDCFlowCookie(c echo.Context, value string, maxAge int) {
+	c.SetCookie(&http.Cookie{
+		Name:     oidcFlowCookie,
+		Value:    value,
+		Path:     "/api/v1/auth/oidc",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   maxAge,
+	})
+}
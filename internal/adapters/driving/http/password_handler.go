@@ -0,0 +1,77 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type PasswordHandler struct {
+	passwordService port.PasswordResetServicePort
+	validate        *validator.Validate
+}
+
+func NewPasswordHandler(passwordService port.PasswordResetServicePort) *PasswordHandler {
+	return &PasswordHandler{
+		passwordService: passwordService,
+		validate:        newValidator(),
+	}
+}
+
+// ForgotPassword always answers 202 so that it cannot be used to find out
+// which addresses are registered
+func (h *PasswordHandler) ForgotPassword(c echo.Context) error {
+	var req domain.ForgotPasswordReq
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	if err := h.passwordService.ForgotPassword(c.Request().Context(), req.Email); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusAccepted,
+		Message:    "If the email is registered, a password reset link has been sent",
+		Data:       nil,
+	})
+}
+
+func (h *PasswordHandler) ResetPassword(c echo.Context) error {
+	var req domain.ResetPasswordReq
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	if err := h.passwordService.ResetPassword(c.Request().Context(), &req); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Password reset successfully",
+		Data:       nil,
+	})
+}
@@ -6,6 +6,8 @@ import (
 	"blogg/internal/core/domain"
 	"blogg/internal/core/port"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -17,42 +19,73 @@ type PostHandler struct {
 }
 
 func NewPostHandler(postService port.PostServicePort) *PostHandler {
-	validate := validator.New()
-	// Register custom slug validator
-	validate.RegisterValidation("slug", func(fl validator.FieldLevel) bool {
-		slug := fl.Field().String()
-		// Allow lowercase, numbers, and hyphens only
-		for _, char := range slug {
-			if !((char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-') {
-				return false
-			}
-		}
-		return len(slug) > 0
-	})
 	return &PostHandler{
 		postService: postService,
-		validate:    validate,
+		validate:    newValidator(),
 	}
 }
 
 type CreatePostRequest struct {
-	Title       string   `json:"title" validate:"required,min=3,max=200"`
-	Slug        string   `json:"slug" validate:"required,slug"`
-	Image       string   `json:"image" validate:"omitempty,url"`
-	Content     string   `json:"content" validate:"required,min=50,max=100000"`
-	Excerpt     string   `json:"excerpt" validate:"omitempty,max=300"`
-	Publish     bool     `json:"publish"`
-	CategoryIDs []string `json:"category_ids" validate:"omitempty,dive,uuid"`
+	Title       string     `json:"title" validate:"required,min=3,max=200"`
+	Slug        string     `json:"slug" validate:"omitempty,slug"` // Generated from the title when empty
+	Image       string     `json:"image" validate:"omitempty,url"`
+	Content     string     `json:"content" validate:"required,min=50,max=100000"`
+	Excerpt     string     `json:"excerpt" validate:"omitempty,max=300"`
+	Publish     bool       `json:"publish"`
+	PublishAt   *time.Time `json:"publish_at"` // Schedules the post instead of publishing it now
+	CategoryIDs []string   `json:"category_ids" validate:"omitempty,dive,uuid"`
+	Tags        []string   `json:"tags" validate:"omitempty,max=10,dive,min=1,max=50"`
 }
 
 type UpdatePostRequest struct {
-	Title       *string   `json:"title" validate:"omitempty,min=3,max=200"`
-	Slug        *string   `json:"slug" validate:"omitempty,slug"`
-	Image       *string   `json:"image" validate:"omitempty,url"`
-	Content     *string   `json:"content" validate:"omitempty,min=50,max=100000"`
-	Excerpt     *string   `json:"excerpt" validate:"omitempty,max=300"`
-	Publish     *bool     `json:"publish"`
-	CategoryIDs *[]string `json:"category_ids" validate:"omitempty,dive,uuid"`
+	Title       *string    `json:"title" validate:"omitempty,min=3,max=200"`
+	Slug        *string    `json:"slug" validate:"omitempty,slug"`
+	Image       *string    `json:"image" validate:"omitempty,url"`
+	Content     *string    `json:"content" validate:"omitempty,min=50,max=100000"`
+	Excerpt     *string    `json:"excerpt" validate:"omitempty,max=300"`
+	Publish     *bool      `json:"publish"`
+	PublishAt   *time.Time `json:"publish_at"` // Schedules the post instead of publishing it now
+	CategoryIDs *[]string  `json:"category_ids" validate:"omitempty,dive,uuid"`
+	Tags        *[]string  `json:"tags" validate:"omitempty,max=10,dive,min=1,max=50"`
+}
+
+// ListPostsQuery selects offset pagination via page, or cursor pagination when
+// the cursor parameter is present (empty for the first page).
+type ListPostsQuery struct {
+	Tag           string `param:"tag"` // Set by the /tags/:tag/posts route
+	Page          int    `query:"page" validate:"omitempty,min=1"`
+	Limit         int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor        string `query:"cursor"`
+	Category      string `query:"category" validate:"omitempty,slug"`
+	Subcategories bool   `query:"include_subcategories"` // Also match descendants of Category
+	Author        string `query:"author" validate:"omitempty,max=32"`
+	From          string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To            string `query:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type SearchPostsQuery struct {
+	Q     string `query:"q" validate:"required,min=2,max=200"`
+	Page  int    `query:"page" validate:"omitempty,min=1"`
+	Limit int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type ListMyPostsQuery struct {
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `query:"cursor"`
+}
+
+type PostRedirectResponse struct {
+	CanonicalSlug string `json:"canonical_slug"`
+}
+
+type SlugCheckQuery struct {
+	Slug   string `query:"slug" validate:"required,max=200"`
+	PostID string `query:"post_id" validate:"omitempty,uuid"` // The post being edited, if any
+}
+
+type DiffRevisionsQuery struct {
+	From string `query:"from" validate:"required,uuid"`
+	To   string `query:"to" validate:"required,uuid"`
 }
 
 func (h *PostHandler) CreatePost(c echo.Context) error {
@@ -89,9 +122,10 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 		Content:     req.Content,
 		Excerpt:     req.Excerpt,
 		IsPublished: req.Publish,
+		PublishAt:   req.PublishAt,
 	}
 
-	createdPost, err := h.postService.CreatePost(c.Request().Context(), post, req.CategoryIDs)
+	createdPost, err := h.postService.CreatePost(c.Request().Context(), post, req.CategoryIDs, req.Tags)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
@@ -104,25 +138,133 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 }
 
 func (h *PostHandler) ListPosts(c echo.Context) error {
-	posts, err := h.postService.ListPosts(c.Request().Context())
+	var query ListPostsQuery
+	if err := c.Bind(&query); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid query parameters",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	opts := domain.PostListOptions{
+		Page:                 query.Page,
+		Limit:                query.Limit,
+		CategorySlug:         query.Category,
+		IncludeSubcategories: query.Subcategories,
+		TagSlug:              query.Tag,
+		Author:               query.Author,
+	}
+	// Dates are already validated, so parsing cannot fail here
+	if query.From != "" {
+		from, _ := time.Parse(time.DateOnly, query.From)
+		opts.PublishedFrom = &from
+	}
+	if query.To != "" {
+		// Make the upper bound inclusive of the whole day
+		to, _ := time.Parse(time.DateOnly, query.To)
+		to = to.AddDate(0, 0, 1)
+		opts.PublishedTo = &to
+	}
+	if err := applyCursor(c, &opts, query.Cursor); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+	opts.Normalize()
+
+	page, err := h.postService.ListPosts(c.Request().Context(), opts)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
 
-	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+	params := httphelper.SuccessListResponseParams{
 		StatusCode: http.StatusOK,
 		Message:    "Posts retrieved successfully",
-		Data:       posts,
+		Data:       page.Posts,
+		NextCursor: page.NextCursor,
+	}
+	if !opts.UseCursor {
+		params.Pagination = httphelper.CalculatePagination(opts.Page, opts.Limit, page.Total)
+	}
+
+	return httphelper.SuccessListResponse(c, params)
+}
+
+// applyCursor switches opts to cursor pagination when the request carries a
+// cursor parameter
+func applyCursor(c echo.Context, opts *domain.PostListOptions, cursor string) error {
+	if !c.QueryParams().Has("cursor") {
+		return nil
+	}
+
+	opts.UseCursor = true
+	if cursor == "" {
+		return nil
+	}
+
+	decoded, err := domain.DecodePostCursor(cursor)
+	if err != nil {
+		return err
+	}
+	opts.Cursor = decoded
+
+	return nil
+}
+
+func (h *PostHandler) SearchPosts(c echo.Context) error {
+	var query SearchPostsQuery
+	if err := c.Bind(&query); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid query parameters",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	query.Q = strings.TrimSpace(query.Q)
+	if err := h.validate.Struct(query); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	q := domain.PostSearchQuery{Query: query.Q, Page: query.Page, Limit: query.Limit}
+	q.Normalize()
+
+	hits, total, err := h.postService.SearchPosts(c.Request().Context(), q)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessListResponse(c, httphelper.SuccessListResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Search results retrieved successfully",
+		Data:       hits,
+		Pagination: httphelper.CalculatePagination(q.Page, q.Limit, total),
 	})
 }
 
 func (h *PostHandler) GetPost(c echo.Context) error {
 	slug := c.Param("slug")
-	post, err := h.postService.GetPostBySlug(c.Request().Context(), slug)
+	post, err := h.postService.GetPublishedPostBySlug(c.Request().Context(), slug)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
 
+	// An old slug redirects to the canonical one. The body carries the canonical
+	// slug too, for clients that do not follow redirects.
+	if post.Slug != slug {
+		c.Response().Header().Set(echo.HeaderLocation, "/api/v1/posts/"+post.Slug)
+		return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+			StatusCode: http.StatusMovedPermanently,
+			Message:    "Post has moved to a new slug",
+			Data:       PostRedirectResponse{CanonicalSlug: post.Slug},
+		})
+	}
+
 	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
 		StatusCode: http.StatusOK,
 		Message:    "Post retrieved successfully",
@@ -132,8 +274,8 @@ func (h *PostHandler) GetPost(c echo.Context) error {
 func (h *PostHandler) GetPostMe(c echo.Context) error {
 	id := c.Param("id")
 
-	// Get user ID from JWT token
-	userID, err := middleware.GetUserID(c)
+	// Get the acting user from the JWT token
+	actor, err := middleware.GetActor(c)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
@@ -143,8 +285,8 @@ func (h *PostHandler) GetPostMe(c echo.Context) error {
 		return httphelper.HandleServiceError(c, err)
 	}
 
-	// Check if post belongs to the user
-	if post.UserID != userID {
+	// Editors may open any post to edit it
+	if !actor.CanEditPost(post) {
 		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
 			StatusCode: http.StatusForbidden,
 			Message:    "You don't have permission to access this post",
@@ -175,7 +317,7 @@ func (h *PostHandler) UpdatePost(c echo.Context) error {
 		return httphelper.HandleValidationError(c, err)
 	}
 
-	userID, err := middleware.GetUserID(c)
+	actor, err := middleware.GetActor(c)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
@@ -197,16 +339,14 @@ func (h *PostHandler) UpdatePost(c echo.Context) error {
 	if req.Excerpt != nil {
 		post.Excerpt = *req.Excerpt
 	}
-	if req.Publish != nil {
-		post.IsPublished = *req.Publish
-	}
+	post.PublishAt = req.PublishAt
 
 	var categoryIDs *[]string
 	if req.CategoryIDs != nil {
 		categoryIDs = req.CategoryIDs
 	}
 
-	updatedPost, err := h.postService.UpdatePost(c.Request().Context(), id, userID, post, categoryIDs)
+	updatedPost, err := h.postService.UpdatePost(c.Request().Context(), id, actor, post, req.Publish, categoryIDs, req.Tags)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
@@ -220,12 +360,12 @@ func (h *PostHandler) UpdatePost(c echo.Context) error {
 
 func (h *PostHandler) DeletePost(c echo.Context) error {
 	id := c.Param("id")
-	userID, err := middleware.GetUserID(c)
+	actor, err := middleware.GetActor(c)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
 
-	err = h.postService.DeletePost(c.Request().Context(), id, userID)
+	err = h.postService.DeletePost(c.Request().Context(), id, actor)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
@@ -243,14 +383,201 @@ func (h *PostHandler) ListMyPosts(c echo.Context) error {
 		return httphelper.HandleServiceError(c, err)
 	}
 
-	posts, err := h.postService.ListPostsByUser(c.Request().Context(), userID)
+	var query ListMyPostsQuery
+	if err := c.Bind(&query); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid query parameters",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	opts := domain.PostListOptions{Limit: query.Limit}
+	if err := applyCursor(c, &opts, query.Cursor); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	page, err := h.postService.ListPostsByUser(c.Request().Context(), userID, opts)
 	if err != nil {
 		return httphelper.HandleServiceError(c, err)
 	}
 
-	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+	return httphelper.SuccessListResponse(c, httphelper.SuccessListResponseParams{
 		StatusCode: http.StatusOK,
 		Message:    "My posts retrieved successfully",
+		Data:       page.Posts,
+		NextCursor: page.NextCursor,
+	})
+}
+
+func (h *PostHandler) ListRevisions(c echo.Context) error {
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	revisions, err := h.postService.ListRevisions(c.Request().Context(), c.Param("id"), actor)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Revisions retrieved successfully",
+		Data:       revisions,
+	})
+}
+
+func (h *PostHandler) GetRevision(c echo.Context) error {
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	revision, err := h.postService.GetRevision(c.Request().Context(), c.Param("id"), c.Param("revisionId"), actor)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Revision retrieved successfully",
+		Data:       revision,
+	})
+}
+
+func (h *PostHandler) DiffRevisions(c echo.Context) error {
+	var query DiffRevisionsQuery
+	if err := c.Bind(&query); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid query parameters",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	revisionDiff, err := h.postService.DiffRevisions(c.Request().Context(), c.Param("id"), query.From, query.To, actor)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Revision diff retrieved successfully",
+		Data:       revisionDiff,
+	})
+}
+
+func (h *PostHandler) RestoreRevision(c echo.Context) error {
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	post, err := h.postService.RestoreRevision(c.Request().Context(), c.Param("id"), c.Param("revisionId"), actor)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Revision restored successfully",
+		Data:       post,
+	})
+}
+
+func (h *PostHandler) ListTrash(c echo.Context) error {
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	posts, err := h.postService.ListTrash(c.Request().Context(), actor)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Trashed posts retrieved successfully",
 		Data:       posts,
 	})
 }
+
+func (h *PostHandler) RestorePost(c echo.Context) error {
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	post, err := h.postService.RestorePost(c.Request().Context(), c.Param("id"), actor)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Post restored successfully",
+		Data:       post,
+	})
+}
+
+func (h *PostHandler) PurgePost(c echo.Context) error {
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	err = h.postService.PurgePost(c.Request().Context(), c.Param("id"), actor)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Post permanently deleted",
+		Data:       nil,
+	})
+}
+
+func (h *PostHandler) CheckSlug(c echo.Context) error {
+	var query SlugCheckQuery
+	if err := c.Bind(&query); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid query parameters",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	result, err := h.postService.CheckSlug(c.Request().Context(), query.Slug, query.PostID)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Slug checked successfully",
+		Data:       result,
+	})
+}
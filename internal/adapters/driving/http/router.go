@@ -2,6 +2,9 @@ package http
 
 import (
 	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/utils/csrf"
 	jwthelper "blogg/utils/jwt"
 
 	"github.com/labstack/echo/v4"
@@ -9,15 +12,41 @@ import (
 )
 
 type Router struct {
-	echo           *echo.Echo
-	authHandler    *AuthHandler
-	postHandler    *PostHandler
-	authMiddleware *middleware.AuthMiddleware
+	echo            *echo.Echo
+	authHandler     *AuthHandler
+	postHandler     *PostHandler
+	categoryHandler *CategoryHandler
+	tagHandler      *TagHandler
+	userHandler     *UserHandler
+	passwordHandler *PasswordHandler
+	verifyHandler   *VerificationHandler
+	mfaHandler      *MFAHandler
+	tokenHandler    *AccessTokenHandler
+	oidcHandler     *OIDCHandler
+	authMiddleware  *middleware.AuthMiddleware
+	rateLimiter     *middleware.RateLimiter
+	csrfHandler     *CSRFHandler
+	jwksHandler     *JWKSHandler
 }
 
-func NewRouter(authHandler *AuthHandler, postHandler *PostHandler) *Router {
+// Route groups that rate limit policies are declared for
+const (
+	rateLimitAuth    = "auth"    // Credential checks and account recovery, per IP
+	rateLimitPublic  = "public"  // Anonymous reads
+	rateLimitAccount = "account" // Everything behind authentication, per user
+	rateLimitPublish = "publish" // Creating posts, on top of account
+)
+
+func NewRouter(authHandler *AuthHandler, postHandler *PostHandler, categoryHandler *CategoryHandler, tagHandler *TagHandler, userHandler *UserHandler, passwordHandler *PasswordHandler, verifyHandler *VerificationHandler, mfaHandler *MFAHandler, tokenHandler *AccessTokenHandler, oidcHandler *OIDCHandler, revocations port.TokenRevocationStorePort, accessTokens port.AccessTokenServicePort, rateLimiter *middleware.RateLimiter, csrfTokens *csrf.Tokens, ipExtractor echo.IPExtractor) *Router {
 	e := echo.New()
 
+	// Rate limits and login throttling key on c.RealIP, which must not come
+	// from headers the client controls; see middleware.NewIPExtractor
+	if ipExtractor == nil {
+		ipExtractor = echo.ExtractIPDirect()
+	}
+	e.IPExtractor = ipExtractor
+
 	// Middleware
 	e.Use(echoMiddleware.LoggerWithConfig(echoMiddleware.LoggerConfig{
 		Format: "${time_rfc3339} | ${status} | ${latency_human} | ${method} ${uri}\n",
@@ -26,43 +55,129 @@ func NewRouter(authHandler *AuthHandler, postHandler *PostHandler) *Router {
 	e.Use(echoMiddleware.CORSWithConfig(echoMiddleware.CORSConfig{
 		AllowOrigins:     []string{"http://localhost:3000", "http://localhost:3001"},
 		AllowMethods:     []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.PATCH},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, middleware.CSRFHeader},
+		ExposeHeaders:    []string{"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", echo.HeaderRetryAfter},
 		AllowCredentials: true,
 	}))
 
 	// Initialize auth middleware
 	jwtManager := jwthelper.NewDefaultJWTManager()
-	authMiddleware := middleware.NewAuthMiddleware(jwtManager)
+	authMiddleware := middleware.NewAuthMiddleware(jwtManager, revocations, accessTokens)
+
+	// Cookie-authenticated changes need a CSRF token; see CSRFMiddleware.Protect
+	e.Use(middleware.NewCSRFMiddleware(jwtManager, csrfTokens).Protect)
 
 	return &Router{
-		echo:           e,
-		authHandler:    authHandler,
-		postHandler:    postHandler,
-		authMiddleware: authMiddleware,
+		echo:            e,
+		authHandler:     authHandler,
+		postHandler:     postHandler,
+		categoryHandler: categoryHandler,
+		tagHandler:      tagHandler,
+		userHandler:     userHandler,
+		passwordHandler: passwordHandler,
+		verifyHandler:   verifyHandler,
+		mfaHandler:      mfaHandler,
+		tokenHandler:    tokenHandler,
+		oidcHandler:     oidcHandler,
+		authMiddleware:  authMiddleware,
+		rateLimiter:     rateLimiter,
+		csrfHandler:     NewCSRFHandler(csrfTokens),
+		jwksHandler:     NewJWKSHandler(jwtManager),
 	}
 }
 
 func (r *Router) SetupRoutes() {
 	api := r.echo.Group("/api/v1")
+	authLimit := r.rateLimiter.Limit(rateLimitAuth)
+	publicLimit := r.rateLimiter.Limit(rateLimitPublic)
+	accountLimit := r.rateLimiter.Limit(rateLimitAccount)
+
+	// Keys for verifying access tokens outside this service
+	r.echo.GET("/.well-known/jwks.json", r.jwksHandler.Keys, publicLimit)
 
 	// Auth routes (public)
 	auth := api.Group("/auth")
-	auth.POST("/register", r.authHandler.Register)
-	auth.POST("/login", r.authHandler.Login)
-	auth.POST("/logout", r.authHandler.Logout)
+	auth.POST("/register", r.authHandler.Register, authLimit)
+	auth.POST("/login", r.authHandler.Login, authLimit)
+	auth.POST("/login/mfa", r.authHandler.LoginMFA, authLimit)
+	auth.GET("/oidc/:provider", r.oidcHandler.Start, authLimit)
+	auth.POST("/oidc/:provider/callback", r.oidcHandler.Callback, authLimit)
+	auth.GET("/csrf", r.csrfHandler.Token, r.authMiddleware.OptionalAuth)
+	auth.POST("/refresh", r.authHandler.Refresh, authLimit)
+	auth.POST("/logout", r.authHandler.Logout, r.authMiddleware.OptionalAuth)
+	auth.POST("/logout-all", r.authHandler.LogoutAll, r.authMiddleware.RequireAuth, accountLimit)
+	auth.POST("/password/forgot", r.passwordHandler.ForgotPassword, authLimit)
+	auth.POST("/password/reset", r.passwordHandler.ResetPassword, authLimit)
+	auth.POST("/verify-email", r.verifyHandler.VerifyEmail, authLimit)
+	auth.POST("/verify-email/resend", r.verifyHandler.ResendVerification, r.authMiddleware.RequireAuth, accountLimit)
 
 	// Post routes (public)
-	posts := api.Group("/posts")
+	posts := api.Group("/posts", publicLimit)
 	posts.GET("", r.postHandler.ListPosts)
+	posts.GET("/search", r.postHandler.SearchPosts)
 	posts.GET("/:slug", r.postHandler.GetPost)
 
-	// Post routes (protected - require authentication)
-	postsAuth := api.Group("/me/posts", r.authMiddleware.RequireAuth)
+	// Session routes (protected - require authentication)
+	sessions := api.Group("/me/sessions", r.authMiddleware.RequireAuth, accountLimit)
+	sessions.GET("", r.authHandler.ListSessions)
+	sessions.DELETE("/:id", r.authHandler.RevokeSession)
+
+	// Two-factor authentication routes (protected - require authentication)
+	mfa := api.Group("/me/mfa", r.authMiddleware.RequireAuth, accountLimit)
+	mfa.POST("/enroll", r.mfaHandler.Enroll)
+	mfa.POST("/enable", r.mfaHandler.Enable)
+	mfa.POST("/disable", r.mfaHandler.Disable)
+
+	// Personal access token routes (protected - require authentication)
+	tokens := api.Group("/me/tokens", r.authMiddleware.RequireAuth, accountLimit)
+	tokens.GET("", r.tokenHandler.ListTokens)
+	tokens.POST("", r.tokenHandler.CreateToken)
+	tokens.DELETE("/:id", r.tokenHandler.RevokeToken)
+
+	// Post routes (protected - require authentication or a posts token)
+	postsAuth := api.Group("/me/posts", r.authMiddleware.TokenScope(domain.ScopePostsRead, domain.ScopePostsWrite), r.authMiddleware.RequireAuth, accountLimit)
 	postsAuth.GET("", r.postHandler.ListMyPosts)
+	postsAuth.GET("/trash", r.postHandler.ListTrash)
+	postsAuth.GET("/slug-check", r.postHandler.CheckSlug)
 	postsAuth.GET("/:id", r.postHandler.GetPostMe)
-	postsAuth.POST("", r.postHandler.CreatePost)
+	postsAuth.POST("", r.postHandler.CreatePost, r.rateLimiter.Limit(rateLimitPublish))
 	postsAuth.PATCH("/:id", r.postHandler.UpdatePost)
 	postsAuth.DELETE("/:id", r.postHandler.DeletePost)
+	postsAuth.POST("/:id/restore", r.postHandler.RestorePost)
+	postsAuth.DELETE("/:id/purge", r.postHandler.PurgePost)
+	postsAuth.GET("/:id/revisions", r.postHandler.ListRevisions)
+	postsAuth.GET("/:id/revisions/diff", r.postHandler.DiffRevisions)
+	postsAuth.GET("/:id/revisions/:revisionId", r.postHandler.GetRevision)
+	postsAuth.POST("/:id/revisions/:revisionId/restore", r.postHandler.RestoreRevision)
+
+	// Category routes (public)
+	categories := api.Group("/categories", publicLimit)
+	categories.GET("", r.categoryHandler.ListCategories)
+	categories.GET("/:slug", r.categoryHandler.GetCategory)
+
+	// Tag routes (public)
+	tags := api.Group("/tags", publicLimit)
+	tags.GET("", r.tagHandler.ListTags)
+	tags.GET("/:tag/posts", r.postHandler.ListPosts)
+
+	// Category routes (admin only - the category service re-checks the stored role)
+	categoriesAdmin := api.Group("/admin/categories",
+		r.authMiddleware.TokenScope(domain.ScopeCategoriesAdmin, domain.ScopeCategoriesAdmin),
+		r.authMiddleware.RequireAuth, accountLimit, r.authMiddleware.RequireRole(domain.RoleAdmin))
+	categoriesAdmin.POST("", r.categoryHandler.CreateCategory)
+	categoriesAdmin.PATCH("/:id", r.categoryHandler.RenameCategory)
+	categoriesAdmin.DELETE("/:id", r.categoryHandler.DeleteCategory)
+	categoriesAdmin.POST("/:id/move", r.categoryHandler.MoveCategory)
+	categoriesAdmin.POST("/:id/merge", r.categoryHandler.MergeCategory)
+
+	// User management routes (admin only)
+	usersAdmin := api.Group("/admin/users", r.authMiddleware.RequireAuth, accountLimit, r.authMiddleware.RequireRole(domain.RoleAdmin))
+	usersAdmin.GET("", r.userHandler.ListUsers)
+	usersAdmin.GET("/:id", r.userHandler.GetUser)
+	usersAdmin.PATCH("/:id/role", r.userHandler.ChangeRole)
+	usersAdmin.POST("/:id/suspend", r.userHandler.SuspendUser)
+	usersAdmin.POST("/:id/unsuspend", r.userHandler.UnsuspendUser)
+	usersAdmin.DELETE("/:id", r.userHandler.DeleteUser)
 }
 
 func (r *Router) Start(address string) error {
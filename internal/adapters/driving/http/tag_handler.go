@@ -0,0 +1,32 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/core/port"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TagHandler struct {
+	tagService port.TagServicePort
+}
+
+func NewTagHandler(tagService port.TagServicePort) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+func (h *TagHandler) ListTags(c echo.Context) error {
+	tags, err := h.tagService.ListTags(c.Request().Context())
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Tags retrieved successfully",
+		Data:       tags,
+	})
+}
@@ -0,0 +1,181 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// UserHandler serves the admin user management API
+type UserHandler struct {
+	userService port.UserServicePort
+	validate    *validator.Validate
+}
+
+func NewUserHandler(userService port.UserServicePort) *UserHandler {
+	return &UserHandler{
+		userService: userService,
+		validate:    newValidator(),
+	}
+}
+
+type ListUsersQuery struct {
+	Q     string `query:"q" validate:"omitempty,max=100"` // Matches username or email
+	Page  int    `query:"page" validate:"omitempty,min=1"`
+	Limit int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type ChangeRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin editor user"`
+}
+
+// DeleteUserQuery says what happens to the user's posts: reassign hands them
+// to ReassignTo, trash moves them to the acting admin's trash
+type DeleteUserQuery struct {
+	Posts      string `query:"posts" validate:"required,oneof=reassign trash"`
+	ReassignTo string `query:"reassign_to" validate:"required_if=Posts reassign,omitempty,uuid"`
+}
+
+func (h *UserHandler) ListUsers(c echo.Context) error {
+	var query ListUsersQuery
+	if err := c.Bind(&query); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid query parameters",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	opts := domain.UserListOptions{Query: query.Q, Page: query.Page, Limit: query.Limit}
+	opts.Normalize()
+
+	page, err := h.userService.ListUsers(c.Request().Context(), opts)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessListResponse(c, httphelper.SuccessListResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Users retrieved successfully",
+		Data:       page.Users,
+		Pagination: httphelper.CalculatePagination(opts.Page, opts.Limit, page.Total),
+	})
+}
+
+func (h *UserHandler) GetUser(c echo.Context) error {
+	user, err := h.userService.GetUser(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "User retrieved successfully",
+		Data:       user,
+	})
+}
+
+func (h *UserHandler) ChangeRole(c echo.Context) error {
+	var req ChangeRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	user, err := h.userService.ChangeRole(c.Request().Context(), actor, c.Param("id"), req.Role)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Role changed successfully",
+		Data:       user,
+	})
+}
+
+func (h *UserHandler) SuspendUser(c echo.Context) error {
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	user, err := h.userService.SuspendUser(c.Request().Context(), actor, c.Param("id"))
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "User suspended successfully",
+		Data:       user,
+	})
+}
+
+func (h *UserHandler) UnsuspendUser(c echo.Context) error {
+	user, err := h.userService.UnsuspendUser(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "User unsuspended successfully",
+		Data:       user,
+	})
+}
+
+func (h *UserHandler) DeleteUser(c echo.Context) error {
+	var query DeleteUserQuery
+	if err := c.Bind(&query); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid query parameters",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	actor, err := middleware.GetActor(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	req := domain.DeleteUserReq{Posts: query.Posts, ReassignTo: query.ReassignTo}
+	if err := h.userService.DeleteUser(c.Request().Context(), actor, c.Param("id"), req); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "User deleted successfully",
+		Data:       nil,
+	})
+}
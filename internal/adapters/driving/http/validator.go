@@ -0,0 +1,22 @@
+package http
+
+import "github.com/go-playground/validator/v10"
+
+// newValidator returns a validator with the custom tags shared by the handlers
+func newValidator() *validator.Validate {
+	validate := validator.New()
+
+	// Register custom slug validator
+	validate.RegisterValidation("slug", func(fl validator.FieldLevel) bool {
+		slug := fl.Field().String()
+		// Allow lowercase, numbers, and hyphens only
+		for _, char := range slug {
+			if !((char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-') {
+				return false
+			}
+		}
+		return len(slug) > 0
+	})
+
+	return validate
+}
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"blogg/internal/adapters/driving/http/httphelper"
+	"blogg/internal/adapters/driving/http/middleware"
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type VerificationHandler struct {
+	verificationService port.EmailVerificationServicePort
+	validate            *validator.Validate
+}
+
+func NewVerificationHandler(verificationService port.EmailVerificationServicePort) *VerificationHandler {
+	return &VerificationHandler{
+		verificationService: verificationService,
+		validate:            newValidator(),
+	}
+}
+
+func (h *VerificationHandler) VerifyEmail(c echo.Context) error {
+	var req domain.VerifyEmailReq
+	if err := c.Bind(&req); err != nil {
+		return httphelper.ErrorResponse(c, httphelper.ErrorResponseParams{
+			StatusCode: http.StatusBadRequest,
+			Message:    "Invalid request body",
+			ErrorCode:  "INVALID_REQUEST",
+			Details:    err.Error(),
+		})
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return httphelper.HandleValidationError(c, err)
+	}
+
+	if err := h.verificationService.VerifyEmail(c.Request().Context(), req.Token); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusOK,
+		Message:    "Email verified successfully",
+		Data:       nil,
+	})
+}
+
+// ResendVerification mails the current user a new verification link
+func (h *VerificationHandler) ResendVerification(c echo.Context) error {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	if err := h.verificationService.SendVerification(c.Request().Context(), userID); err != nil {
+		return httphelper.HandleServiceError(c, err)
+	}
+
+	return httphelper.SuccessResponse(c, httphelper.SuccessResponseParams{
+		StatusCode: http.StatusAccepted,
+		Message:    "Verification email sent",
+		Data:       nil,
+	})
+}
@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job runs a task on a fixed interval in its own goroutine until stopped
+type Job struct {
+	name     string
+	interval time.Duration
+	task     func(ctx context.Context) error
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func NewJob(name string, interval time.Duration, task func(ctx context.Context) error) *Job {
+	return &Job{
+		name:     name,
+		interval: interval,
+		task:     task,
+	}
+}
+
+// Start launches the job. The task runs once immediately and then on every tick.
+func (j *Job) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	j.done = make(chan struct{})
+
+	go j.loop(ctx)
+}
+
+// Stop cancels the job and waits for a task in progress to return
+func (j *Job) Stop() {
+	if j.cancel == nil {
+		return
+	}
+	j.cancel()
+	<-j.done
+}
+
+func (j *Job) loop(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		// Errors caused by the shutdown itself are not worth reporting
+		if err := j.task(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("%s: %v", j.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
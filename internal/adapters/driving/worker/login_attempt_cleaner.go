@@ -0,0 +1,16 @@
+package worker
+
+import (
+	"blogg/internal/core/port"
+	"context"
+	"time"
+)
+
+// NewLoginAttemptCleaner returns a job that drops failed login counts once
+// they are too old to affect a lockout
+func NewLoginAttemptCleaner(authService port.AuthServicePort, interval time.Duration) *Job {
+	return NewJob("login attempt cleaner", interval, func(ctx context.Context) error {
+		_, err := authService.CleanupLoginAttempts(ctx)
+		return err
+	})
+}
@@ -0,0 +1,20 @@
+package worker
+
+import (
+	"blogg/internal/core/port"
+	"context"
+	"log"
+	"time"
+)
+
+// NewScheduledPublisher returns a job that publishes scheduled posts once their
+// publish time has passed. It is safe to run on every instance.
+func NewScheduledPublisher(postService port.PostServicePort, interval time.Duration) *Job {
+	return NewJob("scheduled publisher", interval, func(ctx context.Context) error {
+		published, err := postService.PublishDuePosts(ctx)
+		if published > 0 {
+			log.Printf("scheduled publisher: published %d posts", published)
+		}
+		return err
+	})
+}
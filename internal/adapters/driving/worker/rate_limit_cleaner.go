@@ -0,0 +1,16 @@
+package worker
+
+import (
+	"blogg/internal/core/port"
+	"context"
+	"time"
+)
+
+// NewRateLimitCleaner returns a job that drops rate limit buckets once they
+// have refilled
+func NewRateLimitCleaner(store port.RateLimitStorePort, interval time.Duration) *Job {
+	return NewJob("rate limit cleaner", interval, func(ctx context.Context) error {
+		_, err := store.DeleteFullBuckets(ctx, time.Now())
+		return err
+	})
+}
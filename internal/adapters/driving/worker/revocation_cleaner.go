@@ -0,0 +1,19 @@
+package worker
+
+import (
+	"blogg/internal/core/port"
+	"context"
+	"time"
+)
+
+// NewRevocationCleaner returns a job that drops token revocations once the
+// tokens they cover have expired, along with expired refresh token families
+func NewRevocationCleaner(authService port.AuthServicePort, interval time.Duration) *Job {
+	return NewJob("revocation cleaner", interval, func(ctx context.Context) error {
+		if _, err := authService.CleanupRevokedTokens(ctx); err != nil {
+			return err
+		}
+		_, err := authService.CleanupRefreshTokens(ctx)
+		return err
+	})
+}
@@ -0,0 +1,16 @@
+package worker
+
+import (
+	"blogg/internal/core/port"
+	"context"
+	"time"
+)
+
+// NewSessionCleaner returns a job that drops sessions whose refresh tokens
+// have all expired
+func NewSessionCleaner(authService port.AuthServicePort, interval time.Duration) *Job {
+	return NewJob("session cleaner", interval, func(ctx context.Context) error {
+		_, err := authService.CleanupSessions(ctx)
+		return err
+	})
+}
@@ -0,0 +1,20 @@
+package worker
+
+import (
+	"blogg/internal/core/port"
+	"context"
+	"log"
+	"time"
+)
+
+// NewTrashPurger returns a job that permanently deletes posts that have been in
+// the trash for longer than retention
+func NewTrashPurger(postService port.PostServicePort, retention time.Duration, interval time.Duration) *Job {
+	return NewJob("trash purger", interval, func(ctx context.Context) error {
+		purged, err := postService.PurgeTrash(ctx, retention)
+		if purged > 0 {
+			log.Printf("trash purger: purged %d posts", purged)
+		}
+		return err
+	})
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"blogg/utils/errs"
+	"net/http"
+	"time"
+)
+
+// AccessTokenPrefix starts every personal access token, which tells them
+// apart from JWTs in an Authorization header and makes leaked ones easy to
+// search for
+const AccessTokenPrefix = "blogg_pat_"
+
+// Scopes a personal access token can be limited to
+const (
+	ScopePostsRead       = "posts:read"
+	ScopePostsWrite      = "posts:write"
+	ScopeCategoriesAdmin = "categories:admin"
+)
+
+// AccessTokenTouchInterval limits how often a token's last use is written
+const AccessTokenTouchInterval = time.Minute
+
+// PersonalAccessToken is a long-lived credential for scripts and CI. Only the
+// hash of the token is stored; the token itself is shown once on creation.
+type PersonalAccessToken struct {
+	ID         string     `json:"id" db:"id"`
+	UserID     string     `json:"-" db:"user_id"`
+	Name       string     `json:"name" db:"name"`
+	TokenHash  string     `json:"-" db:"token_hash"`
+	Scopes     []string   `json:"scopes" db:"-"`
+	MFA        bool       `json:"-" db:"mfa"` // Created from a session that passed 2FA
+	ExpiresAt  *time.Time `json:"expires_at" db:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at" db:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// HasScope reports whether the token was granted scope
+func (t *PersonalAccessToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired reports whether the token has run out at now
+func (t *PersonalAccessToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+type CreateAccessTokenReq struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,dive,oneof=posts:read posts:write categories:admin"`
+	ExpiresAt *time.Time `json:"expires_at"` // Never expires when omitted
+}
+
+type CreateAccessTokenRes struct {
+	Token       string               `json:"token"` // Shown once
+	AccessToken *PersonalAccessToken `json:"access_token"`
+}
+
+var (
+	ErrAccessTokenNotFound   = errs.New(errs.Params{Code: "ACCESS_TOKEN_NOT_FOUND", Message: "Access token not found", StatusCode: http.StatusNotFound})
+	ErrInvalidAccessToken    = errs.New(errs.Params{Code: "INVALID_ACCESS_TOKEN", Message: "Invalid or expired access token", StatusCode: http.StatusUnauthorized})
+	ErrInvalidTokenExpiry    = errs.New(errs.Params{Code: "INVALID_TOKEN_EXPIRY", Message: "Token expiry must be in the future", StatusCode: http.StatusBadRequest})
+	ErrScopeNotAllowed       = errs.New(errs.Params{Code: "SCOPE_NOT_ALLOWED", Message: "Only administrators can grant the categories:admin scope", StatusCode: http.StatusForbidden})
+	ErrInsufficientScope     = errs.New(errs.Params{Code: "INSUFFICIENT_SCOPE", Message: "The access token lacks the scope this route needs", StatusCode: http.StatusForbidden})
+	ErrAccessTokenNotAllowed = errs.New(errs.Params{Code: "ACCESS_TOKEN_NOT_ALLOWED", Message: "Personal access tokens cannot be used here; log in instead", StatusCode: http.StatusForbidden})
+)
@@ -3,16 +3,25 @@ package domain
 import (
 	"blogg/utils/errs"
 	"net/http"
+	"time"
+)
+
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleUser   = "user"
 )
 
 type User struct {
-	ID        string `json:"id" db:"id"`
-	Username  string `json:"username" db:"username"`
-	Password  string `json:"-" db:"password"` // Never expose password in JSON
-	Email     string `json:"email" db:"email"`
-	Role      string `json:"role" db:"role"`
-	CreatedAt string `json:"created_at" db:"created_at"`
-	UpdatedAt string `json:"updated_at" db:"updated_at"`
+	ID              string     `json:"id" db:"id"`
+	Username        string     `json:"username" db:"username"`
+	Password        string     `json:"-" db:"password"` // Never expose password in JSON
+	Email           string     `json:"email" db:"email"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty" db:"email_verified_at"`
+	Role            string     `json:"role" db:"role"`
+	SuspendedAt     *time.Time `json:"suspended_at,omitempty" db:"suspended_at"`
+	CreatedAt       string     `json:"created_at" db:"created_at"`
+	UpdatedAt       string     `json:"updated_at" db:"updated_at"`
 }
 
 type UserRegisterReq struct {
@@ -27,18 +36,123 @@ type UserRegisterRes struct {
 }
 
 type UserLoginReq struct {
-	Username string `json:"username" validate:"required"`
-	Password string `json:"password" validate:"required"`
+	Username  string `json:"username" validate:"required"`
+	Password  string `json:"password" validate:"required"`
+	UserAgent string `json:"-"` // Filled in by the handler for the session record
+	IP        string `json:"-"`
+}
+
+// LogoutReq names what a logout revokes. Any field may be empty.
+type LogoutReq struct {
+	TokenID        string
+	SessionID      string
+	TokenExpiresAt time.Time
+	RefreshToken   string
 }
 
+// Session is one login on one device. Its ID is also the family ID of the
+// refresh tokens and the sid claim of the access tokens issued to it.
+type Session struct {
+	ID         string     `json:"id" db:"id"`
+	UserID     string     `json:"-" db:"user_id"`
+	UserAgent  string     `json:"user_agent" db:"user_agent"`
+	IP         string     `json:"ip" db:"ip"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at" db:"last_seen_at"`
+	RevokedAt  *time.Time `json:"-" db:"revoked_at"`
+	MFA        bool       `json:"mfa" db:"mfa"`   // Started or confirmed with a second factor
+	Current    bool       `json:"current" db:"-"` // The session making the request
+}
+
+// UserLoginRes carries either the tokens of a new session or, when the user
+// has 2FA enabled, an MFA token to finish the login with
 type UserLoginRes struct {
-	AccessToken string `json:"access_token"`
-	Username    string `json:"username"`
+	AccessToken  string `json:"access_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int    `json:"expires_in,omitempty"` // Access token lifetime in seconds
+	Username     string `json:"username"`
+	MFARequired  bool   `json:"mfa_required,omitempty"`
+	MFAToken     string `json:"mfa_token,omitempty"`
+}
+
+// RefreshTokenTTL is how long a refresh token stays usable. Access tokens are
+// short-lived and renewed through it.
+const RefreshTokenTTL = 30 * 24 * time.Hour
+
+// RefreshToken is an opaque, single-use token exchanged for a new access token.
+// Tokens descending from one login share a FamilyID, so reuse of a rotated
+// token can revoke every token issued after it.
+type RefreshToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	FamilyID  string     `db:"family_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
+
+var (
+	ErrUsernameExists      = errs.New(errs.Params{Code: "USERNAME_EXISTS", Message: "Username already exists", StatusCode: http.StatusConflict})
+	ErrEmailExists         = errs.New(errs.Params{Code: "EMAIL_EXISTS", Message: "Email already exists", StatusCode: http.StatusConflict})
+	ErrInvalidCredentials  = errs.New(errs.Params{Code: "INVALID_CREDENTIALS", Message: "Invalid username or password", StatusCode: http.StatusUnauthorized})
+	ErrUserNotFound        = errs.New(errs.Params{Code: "USER_NOT_FOUND", Message: "User not found", StatusCode: http.StatusNotFound})
+	ErrInvalidRefreshToken = errs.New(errs.Params{Code: "INVALID_REFRESH_TOKEN", Message: "Invalid or expired refresh token", StatusCode: http.StatusUnauthorized})
+	ErrAccountSuspended    = errs.New(errs.Params{Code: "ACCOUNT_SUSPENDED", Message: "This account has been suspended", StatusCode: http.StatusForbidden})
+	ErrSessionNotFound     = errs.New(errs.Params{Code: "SESSION_NOT_FOUND", Message: "Session not found", StatusCode: http.StatusNotFound})
+	ErrRefreshTokenReused  = errs.New(errs.Params{Code: "REFRESH_TOKEN_REUSED", Message: "Refresh token was already used; please log in again", StatusCode: http.StatusUnauthorized})
+	ErrInvalidCSRFToken    = errs.New(errs.Params{Code: "CSRF_TOKEN_INVALID", Message: "Missing or invalid CSRF token", StatusCode: http.StatusForbidden})
+)
+
+// PasswordResetTTL is how long a password reset link stays valid
+const PasswordResetTTL = time.Hour
+
+type ForgotPasswordReq struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordReq struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=4"`
+}
+
+// PasswordResetToken is the stored half of a reset link; only the hash of the
+// token sent by mail is kept
+type PasswordResetToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	UsedAt    *time.Time `db:"used_at"`
+}
+
+var ErrInvalidResetToken = errs.New(errs.Params{Code: "INVALID_RESET_TOKEN", Message: "Invalid or expired password reset token", StatusCode: http.StatusBadRequest})
+
+const (
+	// EmailVerificationTTL is how long a verification link stays valid
+	EmailVerificationTTL = 24 * time.Hour
+	// VerificationResendCooldown is the minimum time between verification mails
+	VerificationResendCooldown = 2 * time.Minute
+)
+
+type VerifyEmailReq struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// EmailVerificationToken is the stored half of a verification link
+type EmailVerificationToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	UsedAt    *time.Time `db:"used_at"`
 }
 
 var (
-	ErrUsernameExists     = errs.New(errs.Params{Code: "USERNAME_EXISTS", Message: "Username already exists", StatusCode: http.StatusConflict})
-	ErrEmailExists        = errs.New(errs.Params{Code: "EMAIL_EXISTS", Message: "Email already exists", StatusCode: http.StatusConflict})
-	ErrInvalidCredentials = errs.New(errs.Params{Code: "INVALID_CREDENTIALS", Message: "Invalid username or password", StatusCode: http.StatusUnauthorized})
-	ErrUserNotFound       = errs.New(errs.Params{Code: "USER_NOT_FOUND", Message: "User not found", StatusCode: http.StatusNotFound})
+	ErrInvalidVerificationToken = errs.New(errs.Params{Code: "INVALID_VERIFICATION_TOKEN", Message: "Invalid or expired verification token", StatusCode: http.StatusBadRequest})
+	ErrEmailAlreadyVerified     = errs.New(errs.Params{Code: "EMAIL_ALREADY_VERIFIED", Message: "Email is already verified", StatusCode: http.StatusConflict})
+	ErrVerificationThrottled    = errs.New(errs.Params{Code: "VERIFICATION_THROTTLED", Message: "A verification email was sent recently; please wait before asking again", StatusCode: http.StatusTooManyRequests})
+	ErrEmailNotVerified         = errs.New(errs.Params{Code: "EMAIL_NOT_VERIFIED", Message: "Verify your email address before publishing posts", StatusCode: http.StatusForbidden})
 )
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"blogg/utils/errs"
+	"net/http"
+	"time"
+)
+
+// Identity links a user to an account at an external OpenID Connect provider
+type Identity struct {
+	ID          string    `json:"id" db:"id"`
+	UserID      string    `json:"-" db:"user_id"`
+	Provider    string    `json:"provider" db:"provider"`
+	Subject     string    `json:"-" db:"subject"` // The provider's stable user ID
+	Email       string    `json:"email" db:"email"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at" db:"last_login_at"`
+}
+
+// OIDCClaims are the verified claims of an ID token
+type OIDCClaims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Nonce             string
+}
+
+// OIDCStart is where to send the browser to log in at a provider, and the
+// flow token the callback must present
+type OIDCStart struct {
+	AuthURL   string
+	FlowToken string
+}
+
+type OIDCCallbackReq struct {
+	Provider  string `json:"-"`
+	Code      string `json:"code" validate:"required"`
+	State     string `json:"state" validate:"required"`
+	FlowToken string `json:"-"` // From the cookie set when the login started
+	UserAgent string `json:"-"`
+	IP        string `json:"-"`
+}
+
+var (
+	ErrUnknownProvider      = errs.New(errs.Params{Code: "UNKNOWN_PROVIDER", Message: "Unknown identity provider", StatusCode: http.StatusNotFound})
+	ErrInvalidOIDCState     = errs.New(errs.Params{Code: "INVALID_OIDC_STATE", Message: "Login with the identity provider expired or was tampered with; please start again", StatusCode: http.StatusBadRequest})
+	ErrOIDCLoginFailed      = errs.New(errs.Params{Code: "OIDC_LOGIN_FAILED", Message: "The identity provider did not confirm the login", StatusCode: http.StatusUnauthorized})
+	ErrIdentityEmailMissing = errs.New(errs.Params{Code: "IDENTITY_EMAIL_MISSING", Message: "The identity provider did not share an email address", StatusCode: http.StatusBadRequest})
+	ErrIdentityEmailTaken   = errs.New(errs.Params{Code: "IDENTITY_EMAIL_TAKEN", Message: "An account with this email already exists; log in with your password instead", StatusCode: http.StatusConflict})
+)
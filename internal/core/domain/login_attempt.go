@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"blogg/utils/errs"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Failed logins are counted per username and per client IP. Past the free
+// failures each further one doubles the wait before the next attempt, up to
+// LoginLockoutMax. The IP limit is higher so that users behind a shared
+// address do not lock each other out as easily.
+const (
+	LoginUserFreeFailures = 5
+	LoginIPFreeFailures   = 20
+	LoginBackoffBase      = time.Second
+	LoginLockoutMax       = 15 * time.Minute
+	// LoginFailureWindow is how long failures are remembered after the last one
+	LoginFailureWindow = 24 * time.Hour
+)
+
+// LoginAttempts are the recent failed logins for one key
+type LoginAttempts struct {
+	Key           string    `db:"attempt_key"`
+	Failures      int       `db:"failures"`
+	LastFailureAt time.Time `db:"last_failure_at"`
+}
+
+// LockedUntil is when the next attempt is allowed again, after freeFailures
+// failures that cost nothing
+func (a *LoginAttempts) LockedUntil(freeFailures int) time.Time {
+	over := a.Failures - freeFailures
+	if over <= 0 {
+		return time.Time{}
+	}
+
+	wait := LoginLockoutMax
+	// Shifting by 10 or more already passes the cap
+	if over <= 10 {
+		wait = min(LoginBackoffBase<<(over-1), LoginLockoutMax)
+	}
+	return a.LastFailureAt.Add(wait)
+}
+
+// LoginUserKey and LoginIPKey name the attempt counters. Usernames compare
+// case-insensitively, like the users table.
+func LoginUserKey(username string) string {
+	return "user:" + strings.ToLower(username)
+}
+
+func LoginIPKey(ip string) string {
+	return "ip:" + ip
+}
+
+var (
+	ErrAccountLocked        = errs.New(errs.Params{Code: "ACCOUNT_LOCKED", Message: "Too many failed login attempts for this account; try again later", StatusCode: http.StatusTooManyRequests})
+	ErrTooManyLoginAttempts = errs.New(errs.Params{Code: "TOO_MANY_LOGIN_ATTEMPTS", Message: "Too many failed login attempts; try again later", StatusCode: http.StatusTooManyRequests})
+)
@@ -0,0 +1,8 @@
+package domain
+
+// Mail is a plain-text message to a single recipient
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"blogg/utils/errs"
+	"net/http"
+	"time"
+)
+
+// RecoveryCodeCount is how many one-time recovery codes enabling 2FA hands out
+const RecoveryCodeCount = 10
+
+// MFA is a user's TOTP enrollment. It only guards logins once EnabledAt is
+// set, which happens when the user confirms it with a first code.
+type MFA struct {
+	UserID       string     `db:"user_id"`
+	Secret       string     `db:"secret"`
+	EnabledAt    *time.Time `db:"enabled_at"`
+	LastUsedStep int64      `db:"last_used_step"` // Codes up to this time step are spent
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+// Enabled reports whether logins require a second factor
+func (m *MFA) Enabled() bool {
+	return m != nil && m.EnabledAt != nil
+}
+
+// MFAEnrollment is what an authenticator app needs to start producing codes
+type MFAEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+type EnableMFAReq struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type EnableMFARes struct {
+	RecoveryCodes []string `json:"recovery_codes"` // Shown once; only hashes are kept
+}
+
+type DisableMFAReq struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"required"` // A TOTP code or a recovery code
+	IP       string `json:"-"`
+}
+
+// LoginMFAReq completes a login that Login answered with an MFA token
+type LoginMFAReq struct {
+	MFAToken  string `json:"mfa_token" validate:"required"`
+	Code      string `json:"code" validate:"required"` // A TOTP code or a recovery code
+	UserAgent string `json:"-"`
+	IP        string `json:"-"`
+}
+
+var (
+	ErrInvalidMFACode    = errs.New(errs.Params{Code: "INVALID_MFA_CODE", Message: "Invalid two-factor authentication code", StatusCode: http.StatusUnauthorized})
+	ErrInvalidMFAToken   = errs.New(errs.Params{Code: "INVALID_MFA_TOKEN", Message: "Invalid or expired two-factor login; please log in again", StatusCode: http.StatusUnauthorized})
+	ErrMFANotEnrolled    = errs.New(errs.Params{Code: "MFA_NOT_ENROLLED", Message: "Start two-factor enrollment first", StatusCode: http.StatusBadRequest})
+	ErrMFAAlreadyEnabled = errs.New(errs.Params{Code: "MFA_ALREADY_ENABLED", Message: "Two-factor authentication is already enabled", StatusCode: http.StatusConflict})
+	ErrMFANotEnabled     = errs.New(errs.Params{Code: "MFA_NOT_ENABLED", Message: "Two-factor authentication is not enabled", StatusCode: http.StatusConflict})
+	ErrMFARequired       = errs.New(errs.Params{Code: "MFA_REQUIRED", Message: "Administrators must log in with two-factor authentication", StatusCode: http.StatusForbidden})
+	ErrMFAMandatory      = errs.New(errs.Params{Code: "MFA_MANDATORY", Message: "Administrators cannot disable two-factor authentication", StatusCode: http.StatusForbidden})
+)
@@ -0,0 +1,46 @@
+package domain
+
+// Actor is the authenticated user a request is made on behalf of
+type Actor struct {
+	UserID string
+	Role   string
+}
+
+// HasRole reports whether the actor has one of roles
+func (a Actor) HasRole(roles ...string) bool {
+	for _, r := range roles {
+		if a.Role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// CanEditPost reports whether the actor may change p and read its revisions.
+// Editors and admins may edit any post; users only their own.
+func (a Actor) CanEditPost(p *Post) bool {
+	return p.UserID == a.UserID || a.HasRole(RoleEditor, RoleAdmin)
+}
+
+// CanPublishPost reports whether the actor may publish, schedule or unpublish p
+func (a Actor) CanPublishPost(p *Post) bool {
+	return p.UserID == a.UserID || a.HasRole(RoleEditor, RoleAdmin)
+}
+
+// CanDeletePost reports whether the actor may move p to the trash, restore it
+// from there or purge it. Editors curate content but do not delete it.
+func (a Actor) CanDeletePost(p *Post) bool {
+	return p.UserID == a.UserID || a.ManagesAllTrash()
+}
+
+// CanManageCategories reports whether the actor may create, change, move,
+// merge or delete categories
+func (a Actor) CanManageCategories() bool {
+	return a.HasRole(RoleAdmin)
+}
+
+// ManagesAllTrash reports whether the actor may delete any post, and so sees
+// every trashed post rather than only their own
+func (a Actor) ManagesAllTrash() bool {
+	return a.HasRole(RoleAdmin)
+}
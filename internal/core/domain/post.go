@@ -2,7 +2,9 @@ package domain
 
 import (
 	"blogg/utils/errs"
+	"encoding/base64"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -16,22 +18,153 @@ type Post struct {
 	Excerpt     string     `json:"excerpt" db:"excerpt"`
 	IsPublished bool       `json:"is_published" db:"is_published"`
 	PublishedAt *time.Time `json:"published_at,omitempty" db:"published_at"`
+	PublishAt   *time.Time `json:"publish_at,omitempty" db:"publish_at"` // Scheduled go-live time of an unpublished post
 	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
 	DeletedAt   *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
 	Author      *User      `json:"author,omitempty" db:"-"`
 	Categories  []Category `json:"categories,omitempty" db:"-"`
+	Tags        []Tag      `json:"tags,omitempty" db:"-"`
+	Status      PostStatus `json:"status" db:"-"`
+}
+
+// SlugAvailability answers whether a slug can be used and, if not, offers one that can
+type SlugAvailability struct {
+	Slug       string `json:"slug"`
+	Available  bool   `json:"available"`
+	Suggestion string `json:"suggestion"`
+}
+
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusScheduled PostStatus = "scheduled"
+	PostStatusPublished PostStatus = "published"
+)
+
+// UpdateStatus derives Status from IsPublished and PublishAt
+func (p *Post) UpdateStatus() {
+	switch {
+	case p.IsPublished:
+		p.Status = PostStatusPublished
+	case p.PublishAt != nil:
+		p.Status = PostStatusScheduled
+	default:
+		p.Status = PostStatusDraft
+	}
+}
+
+// PostListOptions describes a page of a post listing and the filters applied
+// to it. Listings are offset-paginated by Page unless UseCursor is set, in which
+// case posts after Cursor are returned (a nil Cursor means the first page).
+type PostListOptions struct {
+	Page                 int
+	Limit                int
+	UseCursor            bool
+	Cursor               *PostCursor
+	CategorySlug         string
+	IncludeSubcategories bool // Also match posts in descendants of CategorySlug
+	TagSlug              string
+	Author               string // Username of the post author
+	PublishedFrom        *time.Time
+	PublishedTo          *time.Time
+}
+
+const (
+	DefaultPostListLimit = 10
+	MaxPostListLimit     = 100
+)
+
+// Normalize clamps paging values to their allowed range
+func (o *PostListOptions) Normalize() {
+	if o.Page < 1 {
+		o.Page = 1
+	}
+	if o.Limit < 1 {
+		o.Limit = DefaultPostListLimit
+	}
+	if o.Limit > MaxPostListLimit {
+		o.Limit = MaxPostListLimit
+	}
+}
+
+// Offset returns the number of rows to skip for the requested page
+func (o PostListOptions) Offset() int {
+	return (o.Page - 1) * o.Limit
+}
+
+// PostPage is one page of a post listing
+type PostPage struct {
+	Posts      []*Post
+	Total      int    // Only set for offset pagination
+	NextCursor string // Only set for cursor pagination when more posts follow
+}
+
+// PostCursor is the keyset position of the last post on a page: its sort
+// timestamp (published_at or created_at, depending on the listing) and its ID.
+type PostCursor struct {
+	Time time.Time
+	ID   string
+}
+
+// Encode returns the opaque string handed to clients
+func (c PostCursor) Encode() string {
+	raw := c.Time.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodePostCursor parses a cursor produced by PostCursor.Encode
+func DecodePostCursor(s string) (*PostCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &PostCursor{Time: t, ID: id}, nil
 }
 
 type Category struct {
-	ID   string `json:"id" db:"id"`
-	Name string `json:"name" db:"name"`
-	Slug string `json:"slug" db:"slug"`
+	ID         string          `json:"id" db:"id"`
+	Name       string          `json:"name" db:"name"`
+	Slug       string          `json:"slug" db:"slug"`
+	ParentID   *string         `json:"parent_id" db:"parent_id"`
+	Breadcrumb []CategoryCrumb `json:"breadcrumb,omitempty" db:"-"` // Root first, ending with the category itself
+}
+
+// CategoryCrumb is one step of a category breadcrumb
+type CategoryCrumb struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// CategorySummary is a category together with its number of published posts
+type CategorySummary struct {
+	Category
+	PostCount int        `json:"post_count" db:"post_count"`
+	Children  []Category `json:"children,omitempty" db:"-"`
 }
 
 var (
-	ErrPostNotFound     = errs.New(errs.Params{Code: "POST_NOT_FOUND", Message: "Post not found", StatusCode: http.StatusNotFound})
-	ErrSlugExists       = errs.New(errs.Params{Code: "SLUG_EXISTS", Message: "Slug already exists", StatusCode: http.StatusConflict})
-	ErrUnauthorized     = errs.New(errs.Params{Code: "UNAUTHORIZED", Message: "You are not authorized to perform this action", StatusCode: http.StatusForbidden})
-	ErrCategoryNotFound = errs.New(errs.Params{Code: "CATEGORY_NOT_FOUND", Message: "Category not found", StatusCode: http.StatusNotFound})
+	ErrPostNotFound       = errs.New(errs.Params{Code: "POST_NOT_FOUND", Message: "Post not found", StatusCode: http.StatusNotFound})
+	ErrSlugExists         = errs.New(errs.Params{Code: "SLUG_EXISTS", Message: "Slug already exists", StatusCode: http.StatusConflict})
+	ErrUnauthorized       = errs.New(errs.Params{Code: "UNAUTHORIZED", Message: "You are not authorized to perform this action", StatusCode: http.StatusForbidden})
+	ErrCategoryNotFound   = errs.New(errs.Params{Code: "CATEGORY_NOT_FOUND", Message: "Category not found", StatusCode: http.StatusNotFound})
+	ErrCategorySlugExists = errs.New(errs.Params{Code: "CATEGORY_SLUG_EXISTS", Message: "Category slug already exists", StatusCode: http.StatusConflict})
+	ErrCategoryMergeSelf  = errs.New(errs.Params{Code: "CATEGORY_MERGE_SELF", Message: "Cannot merge a category into itself", StatusCode: http.StatusBadRequest})
+	ErrCategoryCycle      = errs.New(errs.Params{Code: "CATEGORY_CYCLE", Message: "Category cannot be placed under itself or one of its descendants", StatusCode: http.StatusBadRequest})
+	ErrInvalidCursor      = errs.New(errs.Params{Code: "INVALID_CURSOR", Message: "Invalid pagination cursor", StatusCode: http.StatusBadRequest})
+	ErrPublishAtInPast    = errs.New(errs.Params{Code: "PUBLISH_AT_IN_PAST", Message: "Scheduled publish time must be in the future", StatusCode: http.StatusBadRequest})
+	ErrPublishAtConflict  = errs.New(errs.Params{Code: "PUBLISH_AT_CONFLICT", Message: "A post cannot be published now and scheduled at the same time", StatusCode: http.StatusBadRequest})
 )
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"blogg/utils/errs"
+	"math"
+	"net/http"
+	"time"
+)
+
+// What requests are counted together under a rate limit policy. Policies
+// keyed by user or token fall back to the client IP for anonymous requests.
+const (
+	RateLimitKeyIP    = "ip"
+	RateLimitKeyUser  = "user"
+	RateLimitKeyToken = "token"
+)
+
+// RateLimitPolicy is a token bucket that holds Limit requests and refills
+// completely over Period
+type RateLimitPolicy struct {
+	Limit  int
+	Period time.Duration
+	KeyBy  string
+}
+
+// RateLimitBucket is the state of one bucket
+type RateLimitBucket struct {
+	Tokens    float64   `db:"tokens"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int
+	FullAt     time.Time     // When the bucket will have refilled completely
+	RetryAfter time.Duration // When a refused request can be retried
+}
+
+// Take refills bucket for the time since it was last updated and takes a
+// token for one request. A nil bucket has never been used and is full. It
+// returns the bucket to store and the outcome.
+func (p RateLimitPolicy) Take(bucket *RateLimitBucket, now time.Time) (RateLimitBucket, RateLimitResult) {
+	limit := float64(p.Limit)
+	perToken := p.Period / time.Duration(p.Limit)
+
+	tokens := limit
+	if bucket != nil {
+		// Clocks of several instances may disagree; never refill backwards
+		elapsed := max(now.Sub(bucket.UpdatedAt), 0)
+		tokens = min(bucket.Tokens+float64(elapsed)/float64(perToken), limit)
+	}
+
+	result := RateLimitResult{}
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - tokens) * float64(perToken))
+	}
+	result.Remaining = int(math.Floor(tokens))
+	result.FullAt = now.Add(time.Duration((limit - tokens) * float64(perToken)))
+
+	return RateLimitBucket{Tokens: tokens, UpdatedAt: now}, result
+}
+
+var ErrRateLimited = errs.New(errs.Params{Code: "RATE_LIMITED", Message: "Too many requests; please slow down", StatusCode: http.StatusTooManyRequests})
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"blogg/utils/diff"
+	"blogg/utils/errs"
+	"net/http"
+	"time"
+)
+
+// PostRevision is an immutable snapshot of a post's text taken on every save
+type PostRevision struct {
+	ID        string    `json:"id" db:"id"`
+	PostID    string    `json:"post_id" db:"post_id"`
+	AuthorID  string    `json:"author_id" db:"author_id"`
+	Title     string    `json:"title" db:"title"`
+	Content   string    `json:"content,omitempty" db:"content"` // Left out of revision listings
+	Excerpt   string    `json:"excerpt" db:"excerpt"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// PostRevisionDiff holds line diffs of each text field between two revisions
+type PostRevisionDiff struct {
+	FromID  string      `json:"from_id"`
+	ToID    string      `json:"to_id"`
+	Title   []diff.Line `json:"title"`
+	Excerpt []diff.Line `json:"excerpt"`
+	Content []diff.Line `json:"content"`
+}
+
+var (
+	ErrRevisionNotFound = errs.New(errs.Params{Code: "REVISION_NOT_FOUND", Message: "Revision not found", StatusCode: http.StatusNotFound})
+)
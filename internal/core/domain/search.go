@@ -0,0 +1,31 @@
+package domain
+
+type PostSearchQuery struct {
+	Query string
+	Page  int
+	Limit int
+}
+
+// Normalize clamps paging values to their allowed range
+func (q *PostSearchQuery) Normalize() {
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Limit < 1 {
+		q.Limit = DefaultPostListLimit
+	}
+	if q.Limit > MaxPostListLimit {
+		q.Limit = MaxPostListLimit
+	}
+}
+
+// Offset returns the number of hits to skip for the requested page
+func (q PostSearchQuery) Offset() int {
+	return (q.Page - 1) * q.Limit
+}
+
+type PostSearchHit struct {
+	Post    *Post   `json:"post"`
+	Score   float64 `json:"score"`
+	Snippet string  `json:"snippet"` // HTML-escaped, matches wrapped in <mark>
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"blogg/utils/errs"
+	"net/http"
+)
+
+type Tag struct {
+	ID   string `json:"id" db:"id"`
+	Name string `json:"name" db:"name"`
+	Slug string `json:"slug" db:"slug"`
+}
+
+// TagSummary is a tag together with its number of published posts
+type TagSummary struct {
+	Tag
+	PostCount int `json:"post_count" db:"post_count"`
+}
+
+var (
+	ErrTagNotFound = errs.New(errs.Params{Code: "TAG_NOT_FOUND", Message: "Tag not found", StatusCode: http.StatusNotFound})
+)
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"blogg/utils/errs"
+	"net/http"
+)
+
+// UserListOptions pages through users for the admin API. Query matches a
+// substring of the username or email.
+type UserListOptions struct {
+	Query string
+	Page  int
+	Limit int
+}
+
+const (
+	DefaultUserListLimit = 20
+	MaxUserListLimit     = 100
+)
+
+// Normalize clamps paging values to their allowed range
+func (o *UserListOptions) Normalize() {
+	if o.Page < 1 {
+		o.Page = 1
+	}
+	if o.Limit < 1 {
+		o.Limit = DefaultUserListLimit
+	}
+	if o.Limit > MaxUserListLimit {
+		o.Limit = MaxUserListLimit
+	}
+}
+
+type UserPage struct {
+	Users []User
+	Total int
+}
+
+// What happens to a deleted user's posts
+const (
+	PostsReassign = "reassign" // Hand the posts over to another user
+	PostsTrash    = "trash"    // Move the posts to the deleting admin's trash
+)
+
+type DeleteUserReq struct {
+	Posts      string // PostsReassign or PostsTrash
+	ReassignTo string // Required with PostsReassign
+}
+
+var (
+	ErrInvalidRole        = errs.New(errs.Params{Code: "INVALID_ROLE", Message: "Role must be one of admin, editor or user", StatusCode: http.StatusBadRequest})
+	ErrCannotModifySelf   = errs.New(errs.Params{Code: "CANNOT_MODIFY_SELF", Message: "Admins cannot change the role of, suspend or delete their own account", StatusCode: http.StatusConflict})
+	ErrInvalidReassignee  = errs.New(errs.Params{Code: "INVALID_REASSIGNEE", Message: "Posts must be reassigned to another existing user", StatusCode: http.StatusBadRequest})
+	ErrAlreadySuspended   = errs.New(errs.Params{Code: "ALREADY_SUSPENDED", Message: "User is already suspended", StatusCode: http.StatusConflict})
+	ErrNotSuspended       = errs.New(errs.Params{Code: "NOT_SUSPENDED", Message: "User is not suspended", StatusCode: http.StatusConflict})
+	ErrInvalidDisposition = errs.New(errs.Params{Code: "INVALID_POSTS_OPTION", Message: "Posts option must be reassign or trash", StatusCode: http.StatusBadRequest})
+)
+
+// IsValidRole reports whether role is one of the roles in users.role
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleAdmin, RoleEditor, RoleUser:
+		return true
+	}
+	return false
+}
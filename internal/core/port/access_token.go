@@ -0,0 +1,29 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"time"
+)
+
+type AccessTokenServicePort interface {
+	// CreateToken issues a token for the actor. mfa records whether the
+	// creating session passed 2FA, which admin rights depend on.
+	CreateToken(ctx context.Context, actor domain.Actor, mfa bool, req *domain.CreateAccessTokenReq) (*domain.CreateAccessTokenRes, error)
+	ListTokens(ctx context.Context, userID string) ([]domain.PersonalAccessToken, error)
+	RevokeToken(ctx context.Context, userID string, tokenID string) error
+	// RevokeAllTokens deletes every token of the user
+	RevokeAllTokens(ctx context.Context, userID string) error
+	// Authenticate resolves a raw token to its owner and records its use
+	Authenticate(ctx context.Context, rawToken string) (*domain.PersonalAccessToken, *domain.User, error)
+}
+
+type AccessTokenRepositoryPort interface {
+	CreateAccessToken(ctx context.Context, t *domain.PersonalAccessToken) error
+	FindAccessTokenByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error)
+	FindAccessTokensByUserID(ctx context.Context, userID string) ([]domain.PersonalAccessToken, error)
+	// DeleteAccessToken removes one of the user's tokens and reports false if there was none
+	DeleteAccessToken(ctx context.Context, userID string, tokenID string) (bool, error)
+	DeleteUserAccessTokens(ctx context.Context, userID string) error
+	TouchAccessToken(ctx context.Context, tokenID string, usedAt time.Time) error
+}
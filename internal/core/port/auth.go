@@ -3,11 +3,30 @@ package port
 import (
 	"blogg/internal/core/domain"
 	"context"
+	"time"
 )
 
 type AuthServicePort interface {
 	Register(ctx context.Context, u *domain.UserRegisterReq) (*domain.UserRegisterRes, error)
+	// Login checks the password. Users with 2FA get an MFA token instead of a
+	// session and finish with LoginMFA.
 	Login(ctx context.Context, u *domain.UserLoginReq) (*domain.UserLoginRes, error)
+	LoginMFA(ctx context.Context, req *domain.LoginMFAReq) (*domain.UserLoginRes, error)
+	// LoginAs starts a session for a user authenticated by other means, such
+	// as an identity provider. Suspension and 2FA apply as in Login.
+	LoginAs(ctx context.Context, user *domain.User, userAgent string, ip string) (*domain.UserLoginRes, error)
+	Refresh(ctx context.Context, refreshToken string) (*domain.UserLoginRes, error)
+	Logout(ctx context.Context, req *domain.LogoutReq) error
+	LogoutEverywhere(ctx context.Context, userID string) error
+	// ExpireAccessTokens revokes the user's access tokens but keeps their
+	// sessions, so clients pick up changed claims on their next refresh
+	ExpireAccessTokens(ctx context.Context, userID string) error
+	ListSessions(ctx context.Context, userID string, currentSessionID string) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, userID string, sessionID string) error
+	CleanupRevokedTokens(ctx context.Context) (int, error)
+	CleanupRefreshTokens(ctx context.Context) (int, error)
+	CleanupLoginAttempts(ctx context.Context) (int, error)
+	CleanupSessions(ctx context.Context) (int, error)
 }
 
 type AuthRepositoryPort interface {
@@ -15,4 +34,70 @@ type AuthRepositoryPort interface {
 	FindUserByID(ctx context.Context, userID string) (*domain.User, error)
 	FindUserByUsername(ctx context.Context, username string) (*domain.User, error)
 	FindUserByEmail(ctx context.Context, email string) (*domain.User, error)
+	ListUsers(ctx context.Context, opts domain.UserListOptions) ([]domain.User, int, error)
+	UpdateUserRole(ctx context.Context, userID string, role string) error
+	// SetUserSuspended suspends the user, or lifts the suspension when suspendedAt is nil
+	SetUserSuspended(ctx context.Context, userID string, suspendedAt *time.Time) error
+	DeleteUser(ctx context.Context, userID string) error
+	UpdateUserPassword(ctx context.Context, userID string, passwordHash string) error
+	MarkEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error
+}
+
+type RefreshTokenRepositoryPort interface {
+	CreateRefreshToken(ctx context.Context, t *domain.RefreshToken) error
+	FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	// RotateRefreshToken revokes oldID and stores next in one step. It reports
+	// false without storing next when oldID was already revoked.
+	RotateRefreshToken(ctx context.Context, oldID string, next *domain.RefreshToken) (bool, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+	// DeleteExpiredRefreshTokenFamilies drops every token of the families whose
+	// newest token expired before before, and returns how many were removed
+	DeleteExpiredRefreshTokenFamilies(ctx context.Context, before time.Time) (int, error)
+}
+
+type SessionRepositoryPort interface {
+	CreateSession(ctx context.Context, s *domain.Session) error
+	FindSessionByID(ctx context.Context, sessionID string) (*domain.Session, error)
+	// FindActiveSessionsByUserID lists sessions that were not revoked and were
+	// seen after seenAfter, most recently seen first
+	FindActiveSessionsByUserID(ctx context.Context, userID string, seenAfter time.Time) ([]domain.Session, error)
+	TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error
+	MarkSessionMFA(ctx context.Context, sessionID string) error
+	RevokeSession(ctx context.Context, sessionID string) error
+	RevokeUserSessions(ctx context.Context, userID string) error
+	// DeleteSessionsSeenBefore drops sessions last seen before before, revoked
+	// or not, and returns how many were removed
+	DeleteSessionsSeenBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+type PasswordResetServicePort interface {
+	// ForgotPassword mails a reset link if email belongs to a user. It succeeds
+	// either way so that callers cannot probe for registered addresses.
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, req *domain.ResetPasswordReq) error
+}
+
+type PasswordResetRepositoryPort interface {
+	CreatePasswordResetToken(ctx context.Context, t *domain.PasswordResetToken) error
+	FindPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error)
+	// ConsumePasswordResetToken marks the token used and reports false if it already was
+	ConsumePasswordResetToken(ctx context.Context, tokenID string) (bool, error)
+	DeleteUserPasswordResetTokens(ctx context.Context, userID string) error
+}
+
+type EmailVerificationServicePort interface {
+	// SendVerification mails a new verification link to the user, at most once
+	// per domain.VerificationResendCooldown
+	SendVerification(ctx context.Context, userID string) error
+	VerifyEmail(ctx context.Context, rawToken string) error
+}
+
+type EmailVerificationRepositoryPort interface {
+	CreateEmailVerificationToken(ctx context.Context, t *domain.EmailVerificationToken) error
+	FindEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error)
+	// FindLatestEmailVerificationToken returns the user's newest token, or nil
+	FindLatestEmailVerificationToken(ctx context.Context, userID string) (*domain.EmailVerificationToken, error)
+	// ConsumeEmailVerificationToken marks the token used and reports false if it already was
+	ConsumeEmailVerificationToken(ctx context.Context, tokenID string) (bool, error)
 }
@@ -0,0 +1,21 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"time"
+)
+
+// LoginAttemptStorePort counts failed logins per key, such as a username or
+// a client IP
+type LoginAttemptStorePort interface {
+	// GetLoginAttempts returns the failures recorded for key, or nil
+	GetLoginAttempts(ctx context.Context, key string) (*domain.LoginAttempts, error)
+	// RecordLoginFailure counts a failure at the given time. Failures recorded
+	// before resetBefore are forgotten first.
+	RecordLoginFailure(ctx context.Context, key string, at time.Time, resetBefore time.Time) error
+	ResetLoginAttempts(ctx context.Context, key string) error
+	// DeleteLoginAttemptsBefore drops keys whose last failure is older than
+	// before and returns how many were removed
+	DeleteLoginAttemptsBefore(ctx context.Context, before time.Time) (int, error)
+}
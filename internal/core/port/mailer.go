@@ -0,0 +1,10 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+)
+
+type MailerPort interface {
+	Send(ctx context.Context, m domain.Mail) error
+}
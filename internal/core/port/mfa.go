@@ -0,0 +1,35 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"time"
+)
+
+type MFAServicePort interface {
+	// Enroll starts (or restarts) enrollment with a new secret
+	Enroll(ctx context.Context, userID string) (*domain.MFAEnrollment, error)
+	// Enable confirms enrollment with a first code and returns the recovery
+	// codes. The session the user confirmed from counts as 2FA-verified.
+	Enable(ctx context.Context, userID string, sessionID string, code string) (*domain.EnableMFARes, error)
+	Disable(ctx context.Context, userID string, req *domain.DisableMFAReq) error
+	IsEnabled(ctx context.Context, userID string) (bool, error)
+	// VerifyCode accepts a TOTP code or spends a recovery code
+	VerifyCode(ctx context.Context, userID string, code string) error
+}
+
+type MFARepositoryPort interface {
+	// FindMFAByUserID returns the user's enrollment, or nil
+	FindMFAByUserID(ctx context.Context, userID string) (*domain.MFA, error)
+	// SaveMFASecret replaces any pending enrollment of the user
+	SaveMFASecret(ctx context.Context, m *domain.MFA) error
+	// EnableMFA turns the enrollment on and stores the recovery code hashes in one step
+	EnableMFA(ctx context.Context, userID string, enabledAt time.Time, recoveryCodeHashes []string) error
+	// UseMFAStep records a matched time step and reports false if it, or a
+	// later one, was already used
+	UseMFAStep(ctx context.Context, userID string, step int64) (bool, error)
+	// ConsumeRecoveryCode spends the code and reports false if there was no unused one
+	ConsumeRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error)
+	// DeleteMFA removes the enrollment and its recovery codes
+	DeleteMFA(ctx context.Context, userID string) error
+}
@@ -0,0 +1,32 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"time"
+)
+
+// OIDCProviderPort talks to one OpenID Connect provider
+type OIDCProviderPort interface {
+	// AuthCodeURL returns the provider's authorization URL for an
+	// authorization code flow with PKCE (S256)
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange redeems code and returns the claims of the verified ID token.
+	// Checking the nonce is left to the caller.
+	Exchange(ctx context.Context, code, codeVerifier string) (*domain.OIDCClaims, error)
+}
+
+type OIDCServicePort interface {
+	StartLogin(ctx context.Context, provider string) (*domain.OIDCStart, error)
+	// Callback finishes a login at the provider, creating the user on their
+	// first visit. Users with 2FA get an MFA token as with a password login.
+	Callback(ctx context.Context, req *domain.OIDCCallbackReq) (*domain.UserLoginRes, error)
+}
+
+type IdentityRepositoryPort interface {
+	// FindIdentity returns the identity of subject at provider, or nil
+	FindIdentity(ctx context.Context, provider, subject string) (*domain.Identity, error)
+	// CreateUserWithIdentity stores a new user and their first identity in one step
+	CreateUserWithIdentity(ctx context.Context, u *domain.User, identity *domain.Identity) error
+	TouchIdentity(ctx context.Context, identityID string, loginAt time.Time) error
+}
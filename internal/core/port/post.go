@@ -3,33 +3,88 @@ package port
 import (
 	"blogg/internal/core/domain"
 	"context"
+	"time"
 )
 
 type PostServicePort interface {
-	CreatePost(ctx context.Context, req *domain.Post, categoryIDs []string) (*domain.Post, error)
+	CreatePost(ctx context.Context, req *domain.Post, categoryIDs []string, tagNames []string) (*domain.Post, error)
 	GetPostByID(ctx context.Context, id string) (*domain.Post, error)
-	GetPostBySlug(ctx context.Context, slug string) (*domain.Post, error)
-	UpdatePost(ctx context.Context, id string, userID string, req *domain.Post, categoryIDs *[]string) (*domain.Post, error)
-	DeletePost(ctx context.Context, id string, userID string) error
-	ListPosts(ctx context.Context) ([]*domain.Post, error)
-	ListPostsByUser(ctx context.Context, userID string) ([]*domain.Post, error)
+	GetPublishedPostBySlug(ctx context.Context, slug string) (*domain.Post, error)
+	UpdatePost(ctx context.Context, id string, actor domain.Actor, req *domain.Post, publish *bool, categoryIDs *[]string, tagNames *[]string) (*domain.Post, error)
+	CheckSlug(ctx context.Context, slug string, postID string) (*domain.SlugAvailability, error)
+	DeletePost(ctx context.Context, id string, actor domain.Actor) error
+	ListPosts(ctx context.Context, opts domain.PostListOptions) (*domain.PostPage, error)
+	ListPostsByUser(ctx context.Context, userID string, opts domain.PostListOptions) (*domain.PostPage, error)
+	SearchPosts(ctx context.Context, q domain.PostSearchQuery) ([]domain.PostSearchHit, int, error)
+	ListRevisions(ctx context.Context, postID string, actor domain.Actor) ([]domain.PostRevision, error)
+	GetRevision(ctx context.Context, postID string, revisionID string, actor domain.Actor) (*domain.PostRevision, error)
+	DiffRevisions(ctx context.Context, postID string, fromID string, toID string, actor domain.Actor) (*domain.PostRevisionDiff, error)
+	RestoreRevision(ctx context.Context, postID string, revisionID string, actor domain.Actor) (*domain.Post, error)
+	PublishDuePosts(ctx context.Context) (int, error)
+	ListTrash(ctx context.Context, actor domain.Actor) ([]*domain.Post, error)
+	RestorePost(ctx context.Context, id string, actor domain.Actor) (*domain.Post, error)
+	PurgePost(ctx context.Context, id string, actor domain.Actor) error
+	PurgeTrash(ctx context.Context, retention time.Duration) (int, error)
+	// TransferPosts hands every post of fromUserID to toUserID, moving the
+	// live ones to the trash first when trash is set
+	TransferPosts(ctx context.Context, fromUserID string, toUserID string, trash bool) error
 }
 
 type PostRepositoryPort interface {
 	CreatePost(ctx context.Context, p *domain.Post) error
 	FindPostByID(ctx context.Context, postID string) (*domain.Post, error)
 	FindPostBySlug(ctx context.Context, slug string) (*domain.Post, error)
+	FindPublishedPostBySlug(ctx context.Context, slug string) (*domain.Post, error)
+	FindPostBySlugHistory(ctx context.Context, slug string) (*domain.Post, error)
+	FindPublishedPostBySlugHistory(ctx context.Context, slug string) (*domain.Post, error)
+	RecordSlugChange(ctx context.Context, postID string, oldSlug string, newSlug string) error
 	UpdatePost(ctx context.Context, p *domain.Post) error
 	DeletePost(ctx context.Context, postID string) error
-	ListPosts(ctx context.Context) ([]*domain.Post, error)
-	FindPostsByUserID(ctx context.Context, userID string) ([]*domain.Post, error)
+	FindTrashedPostByID(ctx context.Context, postID string) (*domain.Post, error)
+	FindTrashedPostsByUserID(ctx context.Context, userID string) ([]*domain.Post, error)
+	FindTrashedPosts(ctx context.Context) ([]*domain.Post, error)
+	RestorePost(ctx context.Context, postID string) error
+	PurgePost(ctx context.Context, postID string) error
+	PurgeTrashedPosts(ctx context.Context, before time.Time) (int, error)
+	// TrashPostsByUserID moves the user's live posts to the trash and returns their IDs
+	TrashPostsByUserID(ctx context.Context, userID string) ([]string, error)
+	// ReassignPosts changes the owner of every post of fromUserID, trashed ones included
+	ReassignPosts(ctx context.Context, fromUserID string, toUserID string) error
+	ListPosts(ctx context.Context, opts domain.PostListOptions) ([]*domain.Post, error)
+	CountPosts(ctx context.Context, opts domain.PostListOptions) (int, error)
+	// PublishDuePosts publishes up to limit scheduled posts due at now and returns
+	// them. Rows claimed by a concurrent caller are skipped, not waited on.
+	PublishDuePosts(ctx context.Context, now time.Time, limit int) ([]*domain.Post, error)
+	FindPostsByUserID(ctx context.Context, userID string, opts domain.PostListOptions) ([]*domain.Post, error)
 	AddCategoriesToPost(ctx context.Context, postID string, categoryIDs []string) error
 	RemoveCategoriesFromPost(ctx context.Context, postID string) error
 	GetPostCategories(ctx context.Context, postID string) ([]domain.Category, error)
+	AddTagsToPost(ctx context.Context, postID string, tagIDs []string) error
+	RemoveTagsFromPost(ctx context.Context, postID string) error
+	GetPostTags(ctx context.Context, postID string) ([]domain.Tag, error)
+}
+
+type CategoryServicePort interface {
+	ListCategories(ctx context.Context) ([]domain.CategorySummary, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*domain.CategorySummary, error)
+	CreateCategory(ctx context.Context, actor domain.Actor, c *domain.Category) (*domain.Category, error)
+	RenameCategory(ctx context.Context, actor domain.Actor, id string, c *domain.Category) (*domain.Category, error)
+	MoveCategory(ctx context.Context, actor domain.Actor, id string, parentID *string) (*domain.Category, error)
+	DeleteCategory(ctx context.Context, actor domain.Actor, id string) error
+	MergeCategories(ctx context.Context, actor domain.Actor, sourceID string, targetID string) error
 }
 
 type CategoryRepositoryPort interface {
 	CreateCategory(ctx context.Context, c *domain.Category) error
 	FindCategoryByID(ctx context.Context, categoryID string) (*domain.Category, error)
+	FindCategoryBySlug(ctx context.Context, slug string) (*domain.Category, error)
+	FindCategoriesByIDs(ctx context.Context, categoryIDs []string) ([]domain.Category, error)
 	ListCategories(ctx context.Context) ([]domain.Category, error)
+	ListCategorySummaries(ctx context.Context) ([]domain.CategorySummary, error)
+	FindCategorySummaryBySlug(ctx context.Context, slug string) (*domain.CategorySummary, error)
+	FindCategoryAncestors(ctx context.Context, categoryID string) ([]domain.Category, error)
+	FindCategoryChildren(ctx context.Context, parentID string) ([]domain.Category, error)
+	UpdateCategory(ctx context.Context, c *domain.Category) error
+	DeleteCategory(ctx context.Context, categoryID string) error
+	MergeCategories(ctx context.Context, sourceID string, targetID string) error
 }
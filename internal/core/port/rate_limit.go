@@ -0,0 +1,17 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+	"time"
+)
+
+// RateLimitStorePort keeps token buckets. A shared store lets several
+// instances count requests together.
+type RateLimitStorePort interface {
+	// TakeToken applies policy.Take to the bucket at key, atomically
+	TakeToken(ctx context.Context, key string, policy domain.RateLimitPolicy, now time.Time) (*domain.RateLimitResult, error)
+	// DeleteFullBuckets drops buckets that have refilled by now, which are
+	// the same as no bucket, and returns how many were removed
+	DeleteFullBuckets(ctx context.Context, now time.Time) (int, error)
+}
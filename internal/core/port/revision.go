@@ -0,0 +1,13 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+)
+
+type RevisionRepositoryPort interface {
+	CreateRevision(ctx context.Context, r *domain.PostRevision) error
+	// FindRevisionsByPostID lists revisions newest first, without their content
+	FindRevisionsByPostID(ctx context.Context, postID string) ([]domain.PostRevision, error)
+	FindRevisionByID(ctx context.Context, postID string, revisionID string) (*domain.PostRevision, error)
+}
@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// TokenRevocationStorePort remembers access tokens that must be rejected before
+// they expire. Entries are only needed until the tokens they cover expire.
+type TokenRevocationStorePort interface {
+	// RevokeToken rejects tokens whose jti or sid (session) claim equals id
+	RevokeToken(ctx context.Context, id string, expiresAt time.Time) error
+	// RevokeUserTokens rejects every token of userID issued at or before the given time
+	RevokeUserTokens(ctx context.Context, userID string, issuedBefore time.Time, expiresAt time.Time) error
+	IsTokenRevoked(ctx context.Context, tokenID string, sessionID string, userID string, issuedAt time.Time) (bool, error)
+	// DeleteExpired drops entries whose tokens have all expired and returns how many were removed
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
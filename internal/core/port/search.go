@@ -0,0 +1,15 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+)
+
+// SearchPort ranks published posts for a text query. Implementations backed by
+// the posts table itself (MySQL FULLTEXT) can treat IndexPost and RemovePost as
+// no-ops; standalone indexes (e.g. bleve) use them to stay in sync.
+type SearchPort interface {
+	IndexPost(ctx context.Context, p *domain.Post) error
+	RemovePost(ctx context.Context, postID string) error
+	SearchPosts(ctx context.Context, q domain.PostSearchQuery) ([]domain.PostSearchHit, int, error)
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+)
+
+type TagServicePort interface {
+	ListTags(ctx context.Context) ([]domain.TagSummary, error)
+}
+
+type TagRepositoryPort interface {
+	// UpsertTags inserts tags whose slug is new and returns the stored tag for every slug
+	UpsertTags(ctx context.Context, tags []domain.Tag) ([]domain.Tag, error)
+	FindTagBySlug(ctx context.Context, slug string) (*domain.Tag, error)
+	ListTagSummaries(ctx context.Context) ([]domain.TagSummary, error)
+}
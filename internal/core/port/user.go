@@ -0,0 +1,17 @@
+package port
+
+import (
+	"blogg/internal/core/domain"
+	"context"
+)
+
+// UserServicePort is the admin user management API. The acting admin is
+// passed so that admins cannot lock themselves out.
+type UserServicePort interface {
+	ListUsers(ctx context.Context, opts domain.UserListOptions) (*domain.UserPage, error)
+	GetUser(ctx context.Context, userID string) (*domain.User, error)
+	ChangeRole(ctx context.Context, actor domain.Actor, userID string, role string) (*domain.User, error)
+	SuspendUser(ctx context.Context, actor domain.Actor, userID string) (*domain.User, error)
+	UnsuspendUser(ctx context.Context, userID string) (*domain.User, error)
+	DeleteUser(ctx context.Context, actor domain.Actor, userID string, req domain.DeleteUserReq) error
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/utils/token"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccessTokenService struct {
+	userRepo  port.AuthRepositoryPort
+	tokenRepo port.AccessTokenRepositoryPort
+}
+
+func NewAccessTokenService(userRepo port.AuthRepositoryPort, tokenRepo port.AccessTokenRepositoryPort) *AccessTokenService {
+	return &AccessTokenService{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// CreateToken issues a personal access token. The raw token is returned here
+// and never again.
+func (s *AccessTokenService) CreateToken(ctx context.Context, actor domain.Actor, mfa bool, req *domain.CreateAccessTokenReq) (*domain.CreateAccessTokenRes, error) {
+	now := time.Now()
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return nil, domain.ErrInvalidTokenExpiry
+	}
+
+	scopes := uniqueScopes(req.Scopes)
+	for _, scope := range scopes {
+		if scope == domain.ScopeCategoriesAdmin && !actor.HasRole(domain.RoleAdmin) {
+			return nil, domain.ErrScopeNotAllowed
+		}
+	}
+
+	// The prefix is part of the token, so the hash is taken over all of it
+	suffix, _, err := token.Generate()
+	if err != nil {
+		return nil, err
+	}
+	raw := domain.AccessTokenPrefix + suffix
+
+	t := &domain.PersonalAccessToken{
+		ID:        uuid.NewString(),
+		UserID:    actor.UserID,
+		Name:      strings.TrimSpace(req.Name),
+		TokenHash: token.Hash(raw),
+		Scopes:    scopes,
+		MFA:       mfa,
+		ExpiresAt: req.ExpiresAt,
+		CreatedAt: now,
+	}
+	if err := s.tokenRepo.CreateAccessToken(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return &domain.CreateAccessTokenRes{Token: raw, AccessToken: t}, nil
+}
+
+func (s *AccessTokenService) ListTokens(ctx context.Context, userID string) ([]domain.PersonalAccessToken, error) {
+	return s.tokenRepo.FindAccessTokensByUserID(ctx, userID)
+}
+
+func (s *AccessTokenService) RevokeToken(ctx context.Context, userID string, tokenID string) error {
+	deleted, err := s.tokenRepo.DeleteAccessToken(ctx, userID, tokenID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrAccessTokenNotFound
+	}
+	return nil
+}
+
+func (s *AccessTokenService) RevokeAllTokens(ctx context.Context, userID string) error {
+	return s.tokenRepo.DeleteUserAccessTokens(ctx, userID)
+}
+
+// Authenticate looks the token up by hash and loads its owner, whose current
+// role and suspension apply. Last use is recorded at most once per
+// domain.AccessTokenTouchInterval.
+func (s *AccessTokenService) Authenticate(ctx context.Context, rawToken string) (*domain.PersonalAccessToken, *domain.User, error) {
+	t, err := s.tokenRepo.FindAccessTokenByHash(ctx, token.Hash(rawToken))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if t == nil || t.Expired(now) {
+		return nil, nil, domain.ErrInvalidAccessToken
+	}
+
+	user, err := s.userRepo.FindUserByID(ctx, t.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, domain.ErrInvalidAccessToken
+		}
+		return nil, nil, err
+	}
+	if user.SuspendedAt != nil {
+		return nil, nil, domain.ErrAccountSuspended
+	}
+
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= domain.AccessTokenTouchInterval {
+		// Tracking is informational; a failed write must not fail the request
+		if err := s.tokenRepo.TouchAccessToken(ctx, t.ID, now); err != nil {
+			log.Printf("access token: failed to record use of %s: %v", t.ID, err)
+		}
+	}
+
+	return t, user, nil
+}
+
+// uniqueScopes drops repeated scopes, keeping the first occurrence's order
+func uniqueScopes(scopes []string) []string {
+	seen := make(map[string]bool, len(scopes))
+	unique := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		if !seen[s] {
+			seen[s] = true
+			unique = append(unique, s)
+		}
+	}
+	return unique
+}
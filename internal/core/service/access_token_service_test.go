@@ -0,0 +1,116 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"blogg/utils/token"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenService_CreateToken(t *testing.T) {
+	author := domain.Actor{UserID: "user-1", Role: domain.RoleUser}
+
+	t.Run("store only the hash of a prefixed token", func(t *testing.T) {
+		mockTokens := mocks.NewMockAccessTokenRepositoryPort(t)
+		var stored *domain.PersonalAccessToken
+		mockTokens.On("CreateAccessToken", mock.Anything, mock.MatchedBy(func(pat *domain.PersonalAccessToken) bool {
+			stored = pat
+			return pat.UserID == "user-1" && pat.Name == "ci"
+		})).Return(nil).Once()
+
+		svc := service.NewAccessTokenService(mocks.NewMockAuthRepositoryPort(t), mockTokens)
+		result, err := svc.CreateToken(context.Background(), author, false, &domain.CreateAccessTokenReq{
+			Name:   " ci ",
+			Scopes: []string{domain.ScopePostsRead, domain.ScopePostsWrite, domain.ScopePostsRead},
+		})
+		require.NoError(t, err)
+
+		assert.True(t, strings.HasPrefix(result.Token, domain.AccessTokenPrefix))
+		assert.Equal(t, token.Hash(result.Token), stored.TokenHash)
+		assert.NotContains(t, stored.TokenHash, result.Token)
+		assert.Equal(t, []string{domain.ScopePostsRead, domain.ScopePostsWrite}, stored.Scopes)
+	})
+
+	t.Run("refuse the admin scope to non-admins", func(t *testing.T) {
+		svc := service.NewAccessTokenService(mocks.NewMockAuthRepositoryPort(t), mocks.NewMockAccessTokenRepositoryPort(t))
+		_, err := svc.CreateToken(context.Background(), author, false, &domain.CreateAccessTokenReq{
+			Name:   "ci",
+			Scopes: []string{domain.ScopeCategoriesAdmin},
+		})
+		assert.ErrorIs(t, err, domain.ErrScopeNotAllowed)
+	})
+
+	t.Run("refuse an expiry in the past", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		svc := service.NewAccessTokenService(mocks.NewMockAuthRepositoryPort(t), mocks.NewMockAccessTokenRepositoryPort(t))
+		_, err := svc.CreateToken(context.Background(), author, false, &domain.CreateAccessTokenReq{
+			Name:      "ci",
+			Scopes:    []string{domain.ScopePostsRead},
+			ExpiresAt: &past,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidTokenExpiry)
+	})
+}
+
+func TestAccessTokenService_Authenticate(t *testing.T) {
+	const raw = domain.AccessTokenPrefix + "secret"
+	owner := &domain.User{ID: "user-1", Role: domain.RoleEditor}
+
+	t.Run("resolve the owner and record the use", func(t *testing.T) {
+		mockUsers := mocks.NewMockAuthRepositoryPort(t)
+		mockTokens := mocks.NewMockAccessTokenRepositoryPort(t)
+		mockTokens.On("FindAccessTokenByHash", mock.Anything, token.Hash(raw)).
+			Return(&domain.PersonalAccessToken{ID: "pat-1", UserID: "user-1"}, nil).Once()
+		mockUsers.On("FindUserByID", mock.Anything, "user-1").Return(owner, nil).Once()
+		mockTokens.On("TouchAccessToken", mock.Anything, "pat-1", mock.Anything).Return(nil).Once()
+
+		pat, user, err := service.NewAccessTokenService(mockUsers, mockTokens).Authenticate(context.Background(), raw)
+		require.NoError(t, err)
+		assert.Equal(t, "pat-1", pat.ID)
+		assert.Equal(t, domain.RoleEditor, user.Role)
+	})
+
+	t.Run("skip the write for a token used moments ago", func(t *testing.T) {
+		lastUsed := time.Now().Add(-time.Second)
+		mockUsers := mocks.NewMockAuthRepositoryPort(t)
+		mockTokens := mocks.NewMockAccessTokenRepositoryPort(t)
+		mockTokens.On("FindAccessTokenByHash", mock.Anything, token.Hash(raw)).
+			Return(&domain.PersonalAccessToken{ID: "pat-1", UserID: "user-1", LastUsedAt: &lastUsed}, nil).Once()
+		mockUsers.On("FindUserByID", mock.Anything, "user-1").Return(owner, nil).Once()
+
+		_, _, err := service.NewAccessTokenService(mockUsers, mockTokens).Authenticate(context.Background(), raw)
+		require.NoError(t, err)
+	})
+
+	t.Run("reject an expired token", func(t *testing.T) {
+		expired := time.Now().Add(-time.Minute)
+		mockTokens := mocks.NewMockAccessTokenRepositoryPort(t)
+		mockTokens.On("FindAccessTokenByHash", mock.Anything, token.Hash(raw)).
+			Return(&domain.PersonalAccessToken{ID: "pat-1", UserID: "user-1", ExpiresAt: &expired}, nil).Once()
+
+		_, _, err := service.NewAccessTokenService(mocks.NewMockAuthRepositoryPort(t), mockTokens).Authenticate(context.Background(), raw)
+		assert.ErrorIs(t, err, domain.ErrInvalidAccessToken)
+	})
+
+	t.Run("reject tokens of suspended users", func(t *testing.T) {
+		suspendedAt := time.Now()
+		mockUsers := mocks.NewMockAuthRepositoryPort(t)
+		mockTokens := mocks.NewMockAccessTokenRepositoryPort(t)
+		mockTokens.On("FindAccessTokenByHash", mock.Anything, token.Hash(raw)).
+			Return(&domain.PersonalAccessToken{ID: "pat-1", UserID: "user-1"}, nil).Once()
+		mockUsers.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1", SuspendedAt: &suspendedAt}, nil).Once()
+
+		_, _, err := service.NewAccessTokenService(mockUsers, mockTokens).Authenticate(context.Background(), raw)
+		assert.ErrorIs(t, err, domain.ErrAccountSuspended)
+	})
+}
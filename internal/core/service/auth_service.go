@@ -5,20 +5,39 @@ import (
 	"blogg/internal/core/port"
 	"blogg/utils/hasher"
 	jwthelper "blogg/utils/jwt"
+	"blogg/utils/token"
 	"context"
 	"database/sql"
 	"errors"
+	"log"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
 
+// maxUserAgentLength matches the sessions.user_agent column
+const maxUserAgentLength = 512
+
 type authService struct {
-	repo port.AuthRepositoryPort
+	repo        port.AuthRepositoryPort
+	refreshRepo port.RefreshTokenRepositoryPort
+	sessionRepo port.SessionRepositoryPort
+	revocations port.TokenRevocationStorePort
+	verifier    port.EmailVerificationServicePort
+	mfa         port.MFAServicePort
+	attempts    port.LoginAttemptStorePort
 }
 
-func NewAuthService(repo port.AuthRepositoryPort) port.AuthServicePort {
+func NewAuthService(repo port.AuthRepositoryPort, refreshRepo port.RefreshTokenRepositoryPort, sessionRepo port.SessionRepositoryPort, revocations port.TokenRevocationStorePort, verifier port.EmailVerificationServicePort, mfa port.MFAServicePort, attempts port.LoginAttemptStorePort) port.AuthServicePort {
 	return &authService{
-		repo: repo,
+		repo:        repo,
+		refreshRepo: refreshRepo,
+		sessionRepo: sessionRepo,
+		revocations: revocations,
+		verifier:    verifier,
+		mfa:         mfa,
+		attempts:    attempts,
 	}
 }
 
@@ -53,7 +72,7 @@ func (as *authService) Register(ctx context.Context, u *domain.UserRegisterReq)
 		Username: u.Username,
 		Password: u.Password,
 		Email:    u.Email,
-		Role:     "user", // Default role
+		Role:     domain.RoleUser, // Default role
 	}
 
 	hashed, err := hasher.NewArgonHash().Hash(u.Password)
@@ -69,6 +88,11 @@ func (as *authService) Register(ctx context.Context, u *domain.UserRegisterReq)
 		return nil, err
 	}
 
+	// The account exists either way; a lost mail can be resent by the user
+	if err := as.verifier.SendVerification(ctx, newUser.ID); err != nil {
+		log.Printf("mail: failed to send verification to user %s: %v", newUser.ID, err)
+	}
+
 	return &domain.UserRegisterRes{
 		ID:       newUser.ID,
 		Username: newUser.Username,
@@ -76,12 +100,17 @@ func (as *authService) Register(ctx context.Context, u *domain.UserRegisterReq)
 }
 
 func (as *authService) Login(ctx context.Context, u *domain.UserLoginReq) (*domain.UserLoginRes, error) {
+	// Checked first so that guesses during a lockout cost no Argon2 verify
+	if err := checkLoginLock(ctx, as.attempts, u.Username, u.IP); err != nil {
+		return nil, err
+	}
+
 	// Find user by username
 	founded, err := as.repo.FindUserByUsername(ctx, u.Username)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// User not found - return invalid credentials (don't reveal if user exists)
-			return nil, domain.ErrInvalidCredentials
+			return nil, as.loginFailed(ctx, u.Username, u.IP)
 		}
 		// Other database error
 		return nil, err
@@ -93,17 +122,388 @@ func (as *authService) Login(ctx context.Context, u *domain.UserLoginReq) (*doma
 		return nil, err
 	}
 	if !matched {
-		return nil, domain.ErrInvalidCredentials
+		return nil, as.loginFailed(ctx, u.Username, u.IP)
+	}
+
+	// Checked after the password so that suspension is only revealed to the owner
+	res, err := as.LoginAs(ctx, founded, u.UserAgent, u.IP)
+	if err != nil {
+		return nil, err
+	}
+	// With 2FA the count is cleared by LoginMFA, so that knowing the password
+	// does not buy more guesses at the code
+	if !res.MFARequired {
+		if err := as.attempts.ResetLoginAttempts(ctx, domain.LoginUserKey(u.Username)); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// LoginAs starts a session for a user who proved who they are, by password or
+// at an identity provider. Suspended users are refused, and users with 2FA
+// only get a token for the second step.
+func (as *authService) LoginAs(ctx context.Context, user *domain.User, userAgent string, ip string) (*domain.UserLoginRes, error) {
+	if user.SuspendedAt != nil {
+		return nil, domain.ErrAccountSuspended
+	}
+
+	mfaEnabled, err := as.mfa.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaEnabled {
+		mfaToken, err := jwthelper.NewDefaultJWTManager().GenerateMFAToken(user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.UserLoginRes{
+			Username:    user.Username,
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		}, nil
+	}
+
+	return as.startSession(ctx, user, userAgent, ip, false)
+}
+
+// LoginMFA finishes a login with the MFA token from Login and a TOTP or
+// recovery code
+func (as *authService) LoginMFA(ctx context.Context, req *domain.LoginMFAReq) (*domain.UserLoginRes, error) {
+	claims, err := jwthelper.NewDefaultJWTManager().ValidateMFAToken(req.MFAToken)
+	if err != nil {
+		return nil, domain.ErrInvalidMFAToken
+	}
+
+	user, err := as.repo.FindUserByID(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidMFAToken
+		}
+		return nil, err
+	}
+	if user.SuspendedAt != nil {
+		return nil, domain.ErrAccountSuspended
+	}
+	if err := checkLoginLock(ctx, as.attempts, user.Username, req.IP); err != nil {
+		return nil, err
+	}
+
+	if err := as.mfa.VerifyCode(ctx, user.ID, req.Code); err != nil {
+		// 2FA was turned off since the password step
+		if errors.Is(err, domain.ErrMFANotEnabled) {
+			return nil, domain.ErrInvalidMFAToken
+		}
+		if errors.Is(err, domain.ErrInvalidMFACode) {
+			if err := recordLoginFailure(ctx, as.attempts, user.Username, req.IP); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
+	if err := as.attempts.ResetLoginAttempts(ctx, domain.LoginUserKey(user.Username)); err != nil {
+		return nil, err
+	}
+	return as.startSession(ctx, user, req.UserAgent, req.IP, true)
+}
+
+// checkLoginLock refuses a login, or anything else that checks the password,
+// while the username or the client IP is backing off after failed attempts
+func checkLoginLock(ctx context.Context, store port.LoginAttemptStorePort, username string, ip string) error {
+	now := time.Now()
+
+	attempts, err := store.GetLoginAttempts(ctx, domain.LoginUserKey(username))
+	if err != nil {
+		return err
+	}
+	if attempts != nil {
+		if until := attempts.LockedUntil(domain.LoginUserFreeFailures); until.After(now) {
+			return domain.ErrAccountLocked.WithRetryAfter(until.Sub(now))
+		}
+	}
+
+	if ip == "" {
+		return nil
+	}
+	attempts, err = store.GetLoginAttempts(ctx, domain.LoginIPKey(ip))
+	if err != nil {
+		return err
+	}
+	if attempts != nil {
+		if until := attempts.LockedUntil(domain.LoginIPFreeFailures); until.After(now) {
+			return domain.ErrTooManyLoginAttempts.WithRetryAfter(until.Sub(now))
+		}
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed attempt against the username and the
+// client IP. Unknown usernames are counted too, so a lockout does not reveal
+// whether an account exists.
+func recordLoginFailure(ctx context.Context, store port.LoginAttemptStorePort, username string, ip string) error {
+	now := time.Now()
+	resetBefore := now.Add(-domain.LoginFailureWindow)
+
+	if err := store.RecordLoginFailure(ctx, domain.LoginUserKey(username), now, resetBefore); err != nil {
+		return err
+	}
+	if ip == "" {
+		return nil
+	}
+	return store.RecordLoginFailure(ctx, domain.LoginIPKey(ip), now, resetBefore)
+}
+
+// loginFailed records the failure and returns the error for the caller
+func (as *authService) loginFailed(ctx context.Context, username string, ip string) error {
+	if err := recordLoginFailure(ctx, as.attempts, username, ip); err != nil {
+		return err
+	}
+	return domain.ErrInvalidCredentials
+}
+
+// Refresh exchanges a refresh token for a new access token and a new refresh
+// token. Presenting a token that was already rotated is treated as theft and
+// revokes its whole family.
+func (as *authService) Refresh(ctx context.Context, refreshToken string) (*domain.UserLoginRes, error) {
+	stored, err := as.refreshRepo.FindRefreshTokenByHash(ctx, token.Hash(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	if stored.RevokedAt != nil {
+		if err := as.refreshRepo.RevokeRefreshTokenFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrRefreshTokenReused
+	}
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	user, err := as.repo.FindUserByID(ctx, stored.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	if user.SuspendedAt != nil {
+		return nil, domain.ErrAccountSuspended
 	}
 
+	// Tokens keep the 2FA status of the login that started the session
+	session, err := as.sessionRepo.FindSessionByID(ctx, stored.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	mfa := session != nil && session.MFA
+
+	res, err := as.issueTokens(ctx, user, stored.FamilyID, stored.ID, mfa)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refreshes are the only requests that reach the database for a session,
+	// so last seen is accurate to the access token lifetime
+	if err := as.sessionRepo.TouchSession(ctx, stored.FamilyID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Logout ends the current session, or failing that revokes the access token
+// and the refresh token family it is given. Unknown refresh tokens are ignored
+// so that logging out always succeeds.
+func (as *authService) Logout(ctx context.Context, req *domain.LogoutReq) error {
+	if req.TokenID != "" {
+		if err := as.revocations.RevokeToken(ctx, req.TokenID, req.TokenExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	sessionID := req.SessionID
+	if sessionID == "" && req.RefreshToken != "" {
+		stored, err := as.refreshRepo.FindRefreshTokenByHash(ctx, token.Hash(req.RefreshToken))
+		if err != nil || stored == nil {
+			return err
+		}
+		sessionID = stored.FamilyID
+	}
+	if sessionID == "" {
+		return nil
+	}
+
+	return as.endSession(ctx, sessionID)
+}
+
+// LogoutEverywhere revokes every access and refresh token the user holds
+func (as *authService) LogoutEverywhere(ctx context.Context, userID string) error {
+	if err := as.ExpireAccessTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := as.refreshRepo.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	return as.sessionRepo.RevokeUserSessions(ctx, userID)
+}
+
+// ExpireAccessTokens rejects every access token issued to the user so far
+func (as *authService) ExpireAccessTokens(ctx context.Context, userID string) error {
+	now := time.Now()
+	expiresAt := now.Add(jwthelper.NewDefaultJWTManager().Expiration())
+	return as.revocations.RevokeUserTokens(ctx, userID, now, expiresAt)
+}
+
+// ListSessions returns the user's active sessions, flagging currentSessionID.
+// A session not refreshed for longer than a refresh token lives has ended.
+func (as *authService) ListSessions(ctx context.Context, userID string, currentSessionID string) ([]domain.Session, error) {
+	sessions, err := as.sessionRepo.FindActiveSessionsByUserID(ctx, userID, time.Now().Add(-domain.RefreshTokenTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == currentSessionID
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession ends one of the user's sessions
+func (as *authService) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	session, err := as.sessionRepo.FindSessionByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	// Other users' sessions are reported as missing
+	if session == nil || session.UserID != userID || session.RevokedAt != nil {
+		return domain.ErrSessionNotFound
+	}
+
+	return as.endSession(ctx, sessionID)
+}
+
+// CleanupRevokedTokens drops revocations whose tokens have expired anyway
+func (as *authService) CleanupRevokedTokens(ctx context.Context) (int, error) {
+	return as.revocations.DeleteExpired(ctx, time.Now())
+}
+
+// CleanupRefreshTokens drops refresh token families that can no longer be used
+func (as *authService) CleanupRefreshTokens(ctx context.Context) (int, error) {
+	return as.refreshRepo.DeleteExpiredRefreshTokenFamilies(ctx, time.Now())
+}
+
+// CleanupLoginAttempts drops failed login counts that are no longer remembered
+func (as *authService) CleanupLoginAttempts(ctx context.Context) (int, error) {
+	return as.attempts.DeleteLoginAttemptsBefore(ctx, time.Now().Add(-domain.LoginFailureWindow))
+}
+
+// CleanupSessions drops sessions whose refresh tokens have all expired, which
+// also outlasts every access token issued to them
+func (as *authService) CleanupSessions(ctx context.Context) (int, error) {
+	return as.sessionRepo.DeleteSessionsSeenBefore(ctx, time.Now().Add(-domain.RefreshTokenTTL))
+}
+
+// endSession revokes the session row, its refresh token family and every
+// access token issued to it. Access tokens outlive the session by at most
+// their own lifetime, which bounds how long the revocation is kept.
+func (as *authService) endSession(ctx context.Context, sessionID string) error {
+	expiresAt := time.Now().Add(jwthelper.NewDefaultJWTManager().Expiration())
+	if err := as.revocations.RevokeToken(ctx, sessionID, expiresAt); err != nil {
+		return err
+	}
+	if err := as.refreshRepo.RevokeRefreshTokenFamily(ctx, sessionID); err != nil {
+		return err
+	}
+
+	return as.sessionRepo.RevokeSession(ctx, sessionID)
+}
+
+// startSession records a new login, whose session doubles as the refresh
+// token family, and issues its first tokens
+func (as *authService) startSession(ctx context.Context, user *domain.User, userAgent string, ip string, mfa bool) (*domain.UserLoginRes, error) {
+	now := time.Now()
+	session := &domain.Session{
+		ID:         uuid.NewString(),
+		UserID:     user.ID,
+		UserAgent:  truncate(userAgent, maxUserAgentLength),
+		IP:         ip,
+		MFA:        mfa,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	if err := as.sessionRepo.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return as.issueTokens(ctx, user, session.ID, "", mfa)
+}
+
+// issueTokens signs an access token for the session familyID and stores a new
+// refresh token in that family. A non-empty previousID is rotated out in the same step.
+func (as *authService) issueTokens(ctx context.Context, user *domain.User, familyID string, previousID string, mfa bool) (*domain.UserLoginRes, error) {
+	jwtManager := jwthelper.NewDefaultJWTManager()
+
 	// Generate JWT token
-	accessToken, err := jwthelper.NewDefaultJWTManager().GenerateToken(founded.ID, founded.Username)
+	accessToken, err := jwtManager.GenerateToken(user.ID, user.Username, user.Role, familyID, mfa)
+	if err != nil {
+		return nil, err
+	}
+
+	rawRefresh, refreshHash, err := token.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	refresh := &domain.RefreshToken{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: refreshHash,
+		ExpiresAt: now.Add(domain.RefreshTokenTTL),
+		CreatedAt: now,
+	}
+
+	if previousID == "" {
+		err = as.refreshRepo.CreateRefreshToken(ctx, refresh)
+	} else {
+		var rotated bool
+		rotated, err = as.refreshRepo.RotateRefreshToken(ctx, previousID, refresh)
+		if err == nil && !rotated {
+			// Lost a race with another use of the same token
+			if err := as.refreshRepo.RevokeRefreshTokenFamily(ctx, familyID); err != nil {
+				return nil, err
+			}
+			return nil, domain.ErrRefreshTokenReused
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &domain.UserLoginRes{
-		AccessToken: accessToken,
-		Username:    founded.Username,
+		AccessToken:  accessToken,
+		RefreshToken: rawRefresh,
+		ExpiresIn:    int(jwtManager.Expiration().Seconds()),
+		Username:     user.Username,
 	}, nil
 }
+
+// truncate cuts s to at most n bytes without splitting a UTF-8 sequence
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -4,12 +4,18 @@ package service_test
 
 import (
 	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
 	"blogg/internal/core/service"
 	"blogg/mocks"
+	"blogg/utils/errs"
+	"blogg/utils/hasher"
+	jwthelper "blogg/utils/jwt"
+	"blogg/utils/token"
 	"context"
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -98,8 +104,12 @@ func TestAuthService_Register(t *testing.T) {
 			t.Run(tc.name, func(t *testing.T) {
 				mockRepo := mocks.NewMockAuthRepositoryPort(t)
 				tc.setupMock(mockRepo)
+				mockVerifier := mocks.NewMockEmailVerificationServicePort(t)
+				if !tc.expectError {
+					mockVerifier.On("SendVerification", mock.Anything, mock.Anything).Return(nil).Once()
+				}
 
-				svc := service.NewAuthService(mockRepo)
+				svc := service.NewAuthService(mockRepo, mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mockVerifier, mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
 
 				result, err := svc.Register(context.Background(), tc.input)
 
@@ -120,3 +130,422 @@ func TestAuthService_Register(t *testing.T) {
 		}
 	})
 }
+
+func TestAuthService_Refresh(t *testing.T) {
+	const raw = "refresh-token"
+	user := &domain.User{ID: "user-1", Username: "user-1"}
+
+	t.Run("rotate a valid token within its family", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockRefresh.On("FindRefreshTokenByHash", mock.Anything, token.Hash(raw)).
+			Return(&domain.RefreshToken{ID: "rt-1", UserID: "user-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(time.Hour)}, nil).Once()
+		mockRepo.On("FindUserByID", mock.Anything, "user-1").Return(user, nil).Once()
+		mockRefresh.On("RotateRefreshToken", mock.Anything, "rt-1", mock.MatchedBy(func(next *domain.RefreshToken) bool {
+			return next.FamilyID == "fam-1" && next.UserID == "user-1" && next.TokenHash != token.Hash(raw)
+		})).Return(true, nil).Once()
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockSessions.On("FindSessionByID", mock.Anything, "fam-1").Return(&domain.Session{ID: "fam-1", MFA: true}, nil).Once()
+		mockSessions.On("TouchSession", mock.Anything, "fam-1", mock.Anything).Return(nil).Once()
+
+		result, err := service.NewAuthService(mockRepo, mockRefresh, mockSessions, mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t)).Refresh(context.Background(), raw)
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.AccessToken)
+		assert.NotEmpty(t, result.RefreshToken)
+		assert.NotEqual(t, raw, result.RefreshToken)
+
+		claims, err := jwthelper.NewDefaultJWTManager().Validate(result.AccessToken)
+		require.NoError(t, err)
+		assert.True(t, claims.MFA, "the session's 2FA status carries over")
+	})
+
+	t.Run("revoke the family when a rotated token is reused", func(t *testing.T) {
+		revokedAt := time.Now().Add(-time.Minute)
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockRefresh.On("FindRefreshTokenByHash", mock.Anything, token.Hash(raw)).
+			Return(&domain.RefreshToken{ID: "rt-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}, nil).Once()
+		mockRefresh.On("RevokeRefreshTokenFamily", mock.Anything, "fam-1").Return(nil).Once()
+
+		_, err := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mockRefresh, mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t)).Refresh(context.Background(), raw)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("revoke the family when a concurrent refresh won the rotation", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockRefresh.On("FindRefreshTokenByHash", mock.Anything, token.Hash(raw)).
+			Return(&domain.RefreshToken{ID: "rt-1", UserID: "user-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(time.Hour)}, nil).Once()
+		mockRepo.On("FindUserByID", mock.Anything, "user-1").Return(user, nil).Once()
+		mockRefresh.On("RotateRefreshToken", mock.Anything, "rt-1", mock.Anything).Return(false, nil).Once()
+		mockRefresh.On("RevokeRefreshTokenFamily", mock.Anything, "fam-1").Return(nil).Once()
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockSessions.On("FindSessionByID", mock.Anything, "fam-1").Return(&domain.Session{ID: "fam-1"}, nil).Once()
+
+		_, err := service.NewAuthService(mockRepo, mockRefresh, mockSessions, mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t)).Refresh(context.Background(), raw)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("reject an expired token", func(t *testing.T) {
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockRefresh.On("FindRefreshTokenByHash", mock.Anything, token.Hash(raw)).
+			Return(&domain.RefreshToken{ID: "rt-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(-time.Hour)}, nil).Once()
+
+		_, err := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mockRefresh, mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t)).Refresh(context.Background(), raw)
+		assert.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+	})
+
+	t.Run("reject an unknown token", func(t *testing.T) {
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockRefresh.On("FindRefreshTokenByHash", mock.Anything, token.Hash(raw)).Return((*domain.RefreshToken)(nil), nil).Once()
+
+		_, err := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mockRefresh, mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t)).Refresh(context.Background(), raw)
+		assert.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+	})
+
+	t.Run("clean up families that have expired", func(t *testing.T) {
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockRefresh.On("DeleteExpiredRefreshTokenFamilies", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) < time.Minute
+		})).Return(4, nil).Once()
+
+		removed, err := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mockRefresh, mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t)).CleanupRefreshTokens(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 4, removed)
+	})
+}
+
+func TestAuthService_Login(t *testing.T) {
+	hashed, err := hasher.NewArgonHash().Hash("password123")
+	require.NoError(t, err)
+	user := &domain.User{ID: "user-1", Username: "user-1", Password: hashed}
+
+	t.Run("record a session and link the refresh token to it", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockRepo.On("FindUserByUsername", mock.Anything, "user-1").Return(user, nil).Once()
+
+		var sessionID string
+		mockSessions.On("CreateSession", mock.Anything, mock.MatchedBy(func(s *domain.Session) bool {
+			sessionID = s.ID
+			return s.UserID == "user-1" && s.UserAgent == "curl/8.0" && s.IP == "203.0.113.7" && !s.LastSeenAt.IsZero()
+		})).Return(nil).Once()
+		mockRefresh.On("CreateRefreshToken", mock.Anything, mock.MatchedBy(func(rt *domain.RefreshToken) bool {
+			return rt.FamilyID == sessionID
+		})).Return(nil).Once()
+		mockMFA := mocks.NewMockMFAServicePort(t)
+		mockMFA.On("IsEnabled", mock.Anything, "user-1").Return(false, nil).Once()
+
+		svc := service.NewAuthService(mockRepo, mockRefresh, mockSessions, mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mockMFA, noLoginAttempts(t))
+		result, err := svc.Login(context.Background(), &domain.UserLoginReq{
+			Username: "user-1", Password: "password123", UserAgent: "curl/8.0", IP: "203.0.113.7",
+		})
+		require.NoError(t, err)
+
+		claims, err := jwthelper.NewDefaultJWTManager().Validate(result.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, sessionID, claims.SessionID)
+		assert.False(t, claims.MFA)
+	})
+
+	t.Run("hand out an MFA token instead of a session when 2FA is on", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindUserByUsername", mock.Anything, "user-1").Return(user, nil).Once()
+		mockMFA := mocks.NewMockMFAServicePort(t)
+		mockMFA.On("IsEnabled", mock.Anything, "user-1").Return(true, nil).Once()
+
+		svc := service.NewAuthService(mockRepo, mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mockMFA, noLoginAttempts(t))
+		result, err := svc.Login(context.Background(), &domain.UserLoginReq{Username: "user-1", Password: "password123"})
+		require.NoError(t, err)
+
+		assert.True(t, result.MFARequired)
+		assert.Empty(t, result.AccessToken)
+		assert.Empty(t, result.RefreshToken)
+		_, err = jwthelper.NewDefaultJWTManager().Validate(result.MFAToken)
+		assert.Error(t, err, "the MFA token must not work as an access token")
+	})
+
+	t.Run("reject a suspended user", func(t *testing.T) {
+		suspendedAt := time.Now()
+		suspended := *user
+		suspended.SuspendedAt = &suspendedAt
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindUserByUsername", mock.Anything, "user-1").Return(&suspended, nil).Once()
+
+		svc := service.NewAuthService(mockRepo, mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), noLoginAttempts(t))
+		_, err := svc.Login(context.Background(), &domain.UserLoginReq{Username: "user-1", Password: "password123"})
+		assert.ErrorIs(t, err, domain.ErrAccountSuspended)
+	})
+
+	t.Run("reject a wrong password without a session", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindUserByUsername", mock.Anything, "user-1").Return(user, nil).Once()
+
+		svc := service.NewAuthService(mockRepo, mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), noLoginAttempts(t))
+		_, err := svc.Login(context.Background(), &domain.UserLoginReq{Username: "user-1", Password: "wrong"})
+		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+}
+
+// noLoginAttempts is an attempt store with no failures that accepts any update
+func noLoginAttempts(t *testing.T) *mocks.MockLoginAttemptStorePort {
+	m := mocks.NewMockLoginAttemptStorePort(t)
+	m.On("GetLoginAttempts", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	m.On("RecordLoginFailure", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.On("ResetLoginAttempts", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return m
+}
+
+func TestAuthService_LoginThrottle(t *testing.T) {
+	hashed, err := hasher.NewArgonHash().Hash("password123")
+	require.NoError(t, err)
+	user := &domain.User{ID: "user-1", Username: "User-1", Password: hashed}
+	newService := func(t *testing.T, repo *mocks.MockAuthRepositoryPort, mfa *mocks.MockMFAServicePort, attempts *mocks.MockLoginAttemptStorePort) port.AuthServicePort {
+		return service.NewAuthService(repo, mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mfa, attempts)
+	}
+
+	t.Run("refuse a locked username without checking the password", func(t *testing.T) {
+		mockAttempts := mocks.NewMockLoginAttemptStorePort(t)
+		mockAttempts.On("GetLoginAttempts", mock.Anything, "user:user-1").Return(&domain.LoginAttempts{
+			Failures: domain.LoginUserFreeFailures + 3, LastFailureAt: time.Now(),
+		}, nil).Once()
+
+		_, err := newService(t, mocks.NewMockAuthRepositoryPort(t), mocks.NewMockMFAServicePort(t), mockAttempts).
+			Login(context.Background(), &domain.UserLoginReq{Username: "User-1", Password: "password123", IP: "203.0.113.7"})
+		require.ErrorIs(t, err, domain.ErrAccountLocked)
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.InDelta(t, 4*domain.LoginBackoffBase, appErr.RetryAfter, float64(time.Second))
+	})
+
+	t.Run("refuse an IP with too many failures", func(t *testing.T) {
+		mockAttempts := mocks.NewMockLoginAttemptStorePort(t)
+		mockAttempts.On("GetLoginAttempts", mock.Anything, "user:user-1").Return(nil, nil).Once()
+		mockAttempts.On("GetLoginAttempts", mock.Anything, "ip:203.0.113.7").Return(&domain.LoginAttempts{
+			Failures: 100, LastFailureAt: time.Now(),
+		}, nil).Once()
+
+		_, err := newService(t, mocks.NewMockAuthRepositoryPort(t), mocks.NewMockMFAServicePort(t), mockAttempts).
+			Login(context.Background(), &domain.UserLoginReq{Username: "User-1", Password: "password123", IP: "203.0.113.7"})
+		require.ErrorIs(t, err, domain.ErrTooManyLoginAttempts)
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.InDelta(t, domain.LoginLockoutMax, appErr.RetryAfter, float64(time.Second))
+	})
+
+	t.Run("allow a username again once the backoff has passed", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindUserByUsername", mock.Anything, "User-1").Return(user, nil).Once()
+		mockAttempts := mocks.NewMockLoginAttemptStorePort(t)
+		mockAttempts.On("GetLoginAttempts", mock.Anything, "user:user-1").Return(&domain.LoginAttempts{
+			Failures: domain.LoginUserFreeFailures + 1, LastFailureAt: time.Now().Add(-time.Minute),
+		}, nil).Once()
+		mockAttempts.On("RecordLoginFailure", mock.Anything, "user:user-1", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := newService(t, mockRepo, mocks.NewMockMFAServicePort(t), mockAttempts).
+			Login(context.Background(), &domain.UserLoginReq{Username: "User-1", Password: "wrong"})
+		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("count failures for unknown usernames against the name and the IP", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindUserByUsername", mock.Anything, "ghost").Return(nil, sql.ErrNoRows).Once()
+		mockAttempts := mocks.NewMockLoginAttemptStorePort(t)
+		mockAttempts.On("GetLoginAttempts", mock.Anything, mock.Anything).Return(nil, nil).Twice()
+		mockAttempts.On("RecordLoginFailure", mock.Anything, "user:ghost", mock.Anything, mock.MatchedBy(func(resetBefore time.Time) bool {
+			return time.Since(resetBefore) >= domain.LoginFailureWindow
+		})).Return(nil).Once()
+		mockAttempts.On("RecordLoginFailure", mock.Anything, "ip:203.0.113.7", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := newService(t, mockRepo, mocks.NewMockMFAServicePort(t), mockAttempts).
+			Login(context.Background(), &domain.UserLoginReq{Username: "ghost", Password: "password123", IP: "203.0.113.7"})
+		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("keep the count until the second factor succeeds", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindUserByUsername", mock.Anything, "User-1").Return(user, nil).Once()
+		mockMFA := mocks.NewMockMFAServicePort(t)
+		mockMFA.On("IsEnabled", mock.Anything, "user-1").Return(true, nil).Once()
+		mockAttempts := mocks.NewMockLoginAttemptStorePort(t)
+		mockAttempts.On("GetLoginAttempts", mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		result, err := newService(t, mockRepo, mockMFA, mockAttempts).
+			Login(context.Background(), &domain.UserLoginReq{Username: "User-1", Password: "password123"})
+		require.NoError(t, err)
+		assert.True(t, result.MFARequired)
+	})
+
+	t.Run("count a wrong 2FA code as a failed login", func(t *testing.T) {
+		mfaToken, err := jwthelper.NewDefaultJWTManager().GenerateMFAToken("user-1")
+		require.NoError(t, err)
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindUserByID", mock.Anything, "user-1").Return(user, nil).Once()
+		mockMFA := mocks.NewMockMFAServicePort(t)
+		mockMFA.On("VerifyCode", mock.Anything, "user-1", "000000").Return(domain.ErrInvalidMFACode).Once()
+		mockAttempts := mocks.NewMockLoginAttemptStorePort(t)
+		mockAttempts.On("GetLoginAttempts", mock.Anything, "user:user-1").Return(nil, nil).Once()
+		mockAttempts.On("RecordLoginFailure", mock.Anything, "user:user-1", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err = newService(t, mockRepo, mockMFA, mockAttempts).
+			LoginMFA(context.Background(), &domain.LoginMFAReq{MFAToken: mfaToken, Code: "000000"})
+		assert.ErrorIs(t, err, domain.ErrInvalidMFACode)
+	})
+}
+
+func TestAuthService_LoginMFA(t *testing.T) {
+	user := &domain.User{ID: "user-1", Username: "user-1", Role: domain.RoleAdmin}
+	mfaToken, err := jwthelper.NewDefaultJWTManager().GenerateMFAToken("user-1")
+	require.NoError(t, err)
+
+	t.Run("start a 2FA session with a valid code", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockMFA := mocks.NewMockMFAServicePort(t)
+		mockRepo.On("FindUserByID", mock.Anything, "user-1").Return(user, nil).Once()
+		mockMFA.On("VerifyCode", mock.Anything, "user-1", "123456").Return(nil).Once()
+		mockSessions.On("CreateSession", mock.Anything, mock.MatchedBy(func(s *domain.Session) bool {
+			return s.UserID == "user-1" && s.MFA
+		})).Return(nil).Once()
+		mockRefresh.On("CreateRefreshToken", mock.Anything, mock.Anything).Return(nil).Once()
+
+		svc := service.NewAuthService(mockRepo, mockRefresh, mockSessions, mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mockMFA, noLoginAttempts(t))
+		result, err := svc.LoginMFA(context.Background(), &domain.LoginMFAReq{MFAToken: mfaToken, Code: "123456"})
+		require.NoError(t, err)
+
+		claims, err := jwthelper.NewDefaultJWTManager().Validate(result.AccessToken)
+		require.NoError(t, err)
+		assert.True(t, claims.MFA)
+		assert.Equal(t, domain.RoleAdmin, claims.Role)
+	})
+
+	t.Run("reject a wrong code", func(t *testing.T) {
+		mockRepo := mocks.NewMockAuthRepositoryPort(t)
+		mockMFA := mocks.NewMockMFAServicePort(t)
+		mockRepo.On("FindUserByID", mock.Anything, "user-1").Return(user, nil).Once()
+		mockMFA.On("VerifyCode", mock.Anything, "user-1", "000000").Return(domain.ErrInvalidMFACode).Once()
+
+		svc := service.NewAuthService(mockRepo, mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mockMFA, noLoginAttempts(t))
+		_, err := svc.LoginMFA(context.Background(), &domain.LoginMFAReq{MFAToken: mfaToken, Code: "000000"})
+		assert.ErrorIs(t, err, domain.ErrInvalidMFACode)
+	})
+
+	t.Run("reject an access token in place of the MFA token", func(t *testing.T) {
+		accessToken, err := jwthelper.NewDefaultJWTManager().GenerateToken("user-1", "user-1", domain.RoleAdmin, "session-1", false)
+		require.NoError(t, err)
+
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), noLoginAttempts(t))
+		_, err = svc.LoginMFA(context.Background(), &domain.LoginMFAReq{MFAToken: accessToken, Code: "123456"})
+		assert.ErrorIs(t, err, domain.ErrInvalidMFAToken)
+	})
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	t.Run("end the session of the access token", func(t *testing.T) {
+		expiresAt := time.Now().Add(10 * time.Minute)
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockRevocations := mocks.NewMockTokenRevocationStorePort(t)
+		mockRevocations.On("RevokeToken", mock.Anything, "jti-1", expiresAt).Return(nil).Once()
+		mockRevocations.On("RevokeToken", mock.Anything, "session-1", mock.Anything).Return(nil).Once()
+		mockRefresh.On("RevokeRefreshTokenFamily", mock.Anything, "session-1").Return(nil).Once()
+		mockSessions.On("RevokeSession", mock.Anything, "session-1").Return(nil).Once()
+
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mockRefresh, mockSessions, mockRevocations, mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
+		err := svc.Logout(context.Background(), &domain.LogoutReq{
+			TokenID: "jti-1", SessionID: "session-1", TokenExpiresAt: expiresAt, RefreshToken: "refresh",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("find the session from the refresh token", func(t *testing.T) {
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockRevocations := mocks.NewMockTokenRevocationStorePort(t)
+		mockRefresh.On("FindRefreshTokenByHash", mock.Anything, token.Hash("refresh")).
+			Return(&domain.RefreshToken{ID: "rt-1", FamilyID: "session-1"}, nil).Once()
+		mockRevocations.On("RevokeToken", mock.Anything, "session-1", mock.Anything).Return(nil).Once()
+		mockRefresh.On("RevokeRefreshTokenFamily", mock.Anything, "session-1").Return(nil).Once()
+		mockSessions.On("RevokeSession", mock.Anything, "session-1").Return(nil).Once()
+
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mockRefresh, mockSessions, mockRevocations, mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
+		err := svc.Logout(context.Background(), &domain.LogoutReq{RefreshToken: "refresh"})
+		require.NoError(t, err)
+	})
+
+	t.Run("succeed without any tokens", func(t *testing.T) {
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mocks.NewMockRefreshTokenRepositoryPort(t), mocks.NewMockSessionRepositoryPort(t), mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
+		err := svc.Logout(context.Background(), &domain.LogoutReq{})
+		require.NoError(t, err)
+	})
+
+	t.Run("log out everywhere revokes all tokens of the user", func(t *testing.T) {
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockRevocations := mocks.NewMockTokenRevocationStorePort(t)
+		mockRevocations.On("RevokeUserTokens", mock.Anything, "user-1", mock.Anything, mock.MatchedBy(func(expiresAt time.Time) bool {
+			return expiresAt.After(time.Now())
+		})).Return(nil).Once()
+		mockRefresh.On("RevokeUserRefreshTokens", mock.Anything, "user-1").Return(nil).Once()
+		mockSessions.On("RevokeUserSessions", mock.Anything, "user-1").Return(nil).Once()
+
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mockRefresh, mockSessions, mockRevocations, mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
+		err := svc.LogoutEverywhere(context.Background(), "user-1")
+		require.NoError(t, err)
+	})
+}
+
+func TestAuthService_Sessions(t *testing.T) {
+	t.Run("flag the current session", func(t *testing.T) {
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockSessions.On("FindActiveSessionsByUserID", mock.Anything, "user-1", mock.MatchedBy(func(seenAfter time.Time) bool {
+			return time.Since(seenAfter) >= domain.RefreshTokenTTL
+		})).
+			Return([]domain.Session{{ID: "session-1"}, {ID: "session-2"}}, nil).Once()
+
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mocks.NewMockRefreshTokenRepositoryPort(t), mockSessions, mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
+		sessions, err := svc.ListSessions(context.Background(), "user-1", "session-2")
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+		assert.False(t, sessions[0].Current)
+		assert.True(t, sessions[1].Current)
+	})
+
+	t.Run("revoke an own session", func(t *testing.T) {
+		mockRefresh := mocks.NewMockRefreshTokenRepositoryPort(t)
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockRevocations := mocks.NewMockTokenRevocationStorePort(t)
+		mockSessions.On("FindSessionByID", mock.Anything, "session-1").
+			Return(&domain.Session{ID: "session-1", UserID: "user-1"}, nil).Once()
+		mockRevocations.On("RevokeToken", mock.Anything, "session-1", mock.Anything).Return(nil).Once()
+		mockRefresh.On("RevokeRefreshTokenFamily", mock.Anything, "session-1").Return(nil).Once()
+		mockSessions.On("RevokeSession", mock.Anything, "session-1").Return(nil).Once()
+
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mockRefresh, mockSessions, mockRevocations, mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
+		require.NoError(t, svc.RevokeSession(context.Background(), "user-1", "session-1"))
+	})
+
+	t.Run("hide sessions of other users", func(t *testing.T) {
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockSessions.On("FindSessionByID", mock.Anything, "session-1").
+			Return(&domain.Session{ID: "session-1", UserID: "user-2"}, nil).Once()
+
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mocks.NewMockRefreshTokenRepositoryPort(t), mockSessions, mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
+		err := svc.RevokeSession(context.Background(), "user-1", "session-1")
+		assert.ErrorIs(t, err, domain.ErrSessionNotFound)
+	})
+	t.Run("clean up sessions past the refresh token lifetime", func(t *testing.T) {
+		mockSessions := mocks.NewMockSessionRepositoryPort(t)
+		mockSessions.On("DeleteSessionsSeenBefore", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) >= domain.RefreshTokenTTL && time.Since(before) < domain.RefreshTokenTTL+time.Minute
+		})).Return(3, nil).Once()
+
+		svc := service.NewAuthService(mocks.NewMockAuthRepositoryPort(t), mocks.NewMockRefreshTokenRepositoryPort(t), mockSessions, mocks.NewMockTokenRevocationStorePort(t), mocks.NewMockEmailVerificationServicePort(t), mocks.NewMockMFAServicePort(t), mocks.NewMockLoginAttemptStorePort(t))
+		removed, err := svc.CleanupSessions(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 3, removed)
+	})
+}
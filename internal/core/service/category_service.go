@@ -0,0 +1,247 @@
+package service
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type CategoryService struct {
+	categoryRepo port.CategoryRepositoryPort
+}
+
+func NewCategoryService(categoryRepo port.CategoryRepositoryPort) *CategoryService {
+	return &CategoryService{
+		categoryRepo: categoryRepo,
+	}
+}
+
+func (s *CategoryService) ListCategories(ctx context.Context) ([]domain.CategorySummary, error) {
+	categories, err := s.categoryRepo.ListCategorySummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build breadcrumbs from the full list instead of querying each chain
+	byID := make(map[string]domain.Category, len(categories))
+	for _, c := range categories {
+		byID[c.ID] = c.Category
+	}
+	for i := range categories {
+		categories[i].Breadcrumb = buildBreadcrumb(ancestorChain(byID, categories[i].Category))
+	}
+
+	return categories, nil
+}
+
+func (s *CategoryService) GetCategoryBySlug(ctx context.Context, slug string) (*domain.CategorySummary, error) {
+	category, err := s.categoryRepo.FindCategorySummaryBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, domain.ErrCategoryNotFound
+	}
+
+	ancestors, err := s.categoryRepo.FindCategoryAncestors(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	category.Breadcrumb = buildBreadcrumb(ancestors)
+
+	children, err := s.categoryRepo.FindCategoryChildren(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	category.Children = children
+
+	return category, nil
+}
+
+func (s *CategoryService) CreateCategory(ctx context.Context, actor domain.Actor, c *domain.Category) (*domain.Category, error) {
+	if !actor.CanManageCategories() {
+		return nil, domain.ErrUnauthorized
+	}
+
+	// Check if slug already exists
+	existing, err := s.categoryRepo.FindCategoryBySlug(ctx, c.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, domain.ErrCategorySlugExists
+	}
+
+	if c.ParentID != nil {
+		parent, err := s.categoryRepo.FindCategoryByID(ctx, *c.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, domain.ErrCategoryNotFound
+		}
+	}
+
+	c.ID = uuid.NewString()
+	err = s.categoryRepo.CreateCategory(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (s *CategoryService) RenameCategory(ctx context.Context, actor domain.Actor, id string, c *domain.Category) (*domain.Category, error) {
+	if !actor.CanManageCategories() {
+		return nil, domain.ErrUnauthorized
+	}
+
+	category, err := s.categoryRepo.FindCategoryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, domain.ErrCategoryNotFound
+	}
+
+	// Check slug uniqueness if slug is being updated
+	if c.Slug != "" && c.Slug != category.Slug {
+		existing, err := s.categoryRepo.FindCategoryBySlug(ctx, c.Slug)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, domain.ErrCategorySlugExists
+		}
+		category.Slug = c.Slug
+	}
+	if c.Name != "" {
+		category.Name = c.Name
+	}
+
+	err = s.categoryRepo.UpdateCategory(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// MoveCategory places a category under parentID, or at the top level when
+// parentID is nil. A category cannot be moved into its own subtree.
+func (s *CategoryService) MoveCategory(ctx context.Context, actor domain.Actor, id string, parentID *string) (*domain.Category, error) {
+	if !actor.CanManageCategories() {
+		return nil, domain.ErrUnauthorized
+	}
+
+	category, err := s.categoryRepo.FindCategoryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, domain.ErrCategoryNotFound
+	}
+
+	if parentID != nil {
+		// The new parent's chain must not pass through the moved category
+		ancestors, err := s.categoryRepo.FindCategoryAncestors(ctx, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if len(ancestors) == 0 {
+			return nil, domain.ErrCategoryNotFound
+		}
+		for _, ancestor := range ancestors {
+			if ancestor.ID == id {
+				return nil, domain.ErrCategoryCycle
+			}
+		}
+	}
+
+	category.ParentID = parentID
+	err = s.categoryRepo.UpdateCategory(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) DeleteCategory(ctx context.Context, actor domain.Actor, id string) error {
+	if !actor.CanManageCategories() {
+		return domain.ErrUnauthorized
+	}
+
+	category, err := s.categoryRepo.FindCategoryByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		return domain.ErrCategoryNotFound
+	}
+
+	return s.categoryRepo.DeleteCategory(ctx, id)
+}
+
+func (s *CategoryService) MergeCategories(ctx context.Context, actor domain.Actor, sourceID string, targetID string) error {
+	if !actor.CanManageCategories() {
+		return domain.ErrUnauthorized
+	}
+
+	if sourceID == targetID {
+		return domain.ErrCategoryMergeSelf
+	}
+
+	source, err := s.categoryRepo.FindCategoryByID(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+	if source == nil {
+		return domain.ErrCategoryNotFound
+	}
+
+	// Source children move to the target, so the target must not sit below the source
+	ancestors, err := s.categoryRepo.FindCategoryAncestors(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	if len(ancestors) == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ID == sourceID {
+			return domain.ErrCategoryCycle
+		}
+	}
+
+	return s.categoryRepo.MergeCategories(ctx, sourceID, targetID)
+}
+
+// ancestorChain walks parent links from c up to the root and returns the chain
+// root first. The walk is bounded by the number of categories as a cycle guard.
+func ancestorChain(byID map[string]domain.Category, c domain.Category) []domain.Category {
+	chain := []domain.Category{c}
+	for c.ParentID != nil && len(chain) <= len(byID) {
+		parent, ok := byID[*c.ParentID]
+		if !ok {
+			break
+		}
+		chain = append(chain, parent)
+		c = parent
+	}
+	slices.Reverse(chain)
+
+	return chain
+}
+
+// buildBreadcrumb turns a root-first category chain into breadcrumb steps
+func buildBreadcrumb(chain []domain.Category) []domain.CategoryCrumb {
+	crumbs := make([]domain.CategoryCrumb, 0, len(chain))
+	for _, c := range chain {
+		crumbs = append(crumbs, domain.CategoryCrumb{ID: c.ID, Name: c.Name, Slug: c.Slug})
+	}
+	return crumbs
+}
@@ -0,0 +1,185 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryService_CreateCategory(t *testing.T) {
+	admin := domain.Actor{UserID: "admin-1", Role: domain.RoleAdmin}
+
+	type createTest struct {
+		name        string
+		actor       domain.Actor
+		setupMock   func(c *mocks.MockCategoryRepositoryPort)
+		expectedErr error
+	}
+
+	tests := []createTest{
+		{
+			name:  "successfully create category as admin",
+			actor: admin,
+			setupMock: func(c *mocks.MockCategoryRepositoryPort) {
+				c.On("FindCategoryBySlug", mock.Anything, "go").Return((*domain.Category)(nil), nil).Once()
+				c.On("CreateCategory", mock.Anything, mock.MatchedBy(func(cat *domain.Category) bool {
+					return cat.ID != "" && cat.Slug == "go"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:        "reject non-admin user",
+			actor:       domain.Actor{UserID: "user-1", Role: domain.RoleUser},
+			setupMock:   func(c *mocks.MockCategoryRepositoryPort) {},
+			expectedErr: domain.ErrUnauthorized,
+		},
+		{
+			name:        "reject editor",
+			actor:       domain.Actor{UserID: "editor-1", Role: domain.RoleEditor},
+			setupMock:   func(c *mocks.MockCategoryRepositoryPort) {},
+			expectedErr: domain.ErrUnauthorized,
+		},
+		{
+			name:  "reject duplicate slug",
+			actor: admin,
+			setupMock: func(c *mocks.MockCategoryRepositoryPort) {
+				c.On("FindCategoryBySlug", mock.Anything, "go").Return(&domain.Category{ID: "cat-1", Slug: "go"}, nil).Once()
+			},
+			expectedErr: domain.ErrCategorySlugExists,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCategoryRepo := mocks.NewMockCategoryRepositoryPort(t)
+			tc.setupMock(mockCategoryRepo)
+
+			svc := service.NewCategoryService(mockCategoryRepo)
+
+			result, err := svc.CreateCategory(context.Background(), tc.actor, &domain.Category{Name: "Go", Slug: "go"})
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, result.ID)
+		})
+	}
+}
+
+func TestCategoryService_MergeCategories(t *testing.T) {
+	admin := domain.Actor{UserID: "admin-1", Role: domain.RoleAdmin}
+
+	t.Run("reject merging a category into itself", func(t *testing.T) {
+		svc := service.NewCategoryService(mocks.NewMockCategoryRepositoryPort(t))
+
+		err := svc.MergeCategories(context.Background(), admin, "cat-1", "cat-1")
+		assert.ErrorIs(t, err, domain.ErrCategoryMergeSelf)
+	})
+
+	t.Run("merge existing categories", func(t *testing.T) {
+		mockCategoryRepo := mocks.NewMockCategoryRepositoryPort(t)
+		mockCategoryRepo.On("FindCategoryByID", mock.Anything, "cat-1").Return(&domain.Category{ID: "cat-1"}, nil).Once()
+		mockCategoryRepo.On("FindCategoryAncestors", mock.Anything, "cat-2").Return([]domain.Category{{ID: "cat-2"}}, nil).Once()
+		mockCategoryRepo.On("MergeCategories", mock.Anything, "cat-1", "cat-2").Return(nil).Once()
+
+		svc := service.NewCategoryService(mockCategoryRepo)
+
+		err := svc.MergeCategories(context.Background(), admin, "cat-1", "cat-2")
+		require.NoError(t, err)
+	})
+
+	t.Run("reject non-admin user", func(t *testing.T) {
+		svc := service.NewCategoryService(mocks.NewMockCategoryRepositoryPort(t))
+
+		err := svc.MergeCategories(context.Background(), domain.Actor{UserID: "user-1", Role: domain.RoleUser}, "cat-1", "cat-2")
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+}
+
+func TestCategoryService_MoveCategory(t *testing.T) {
+	parentID := "cat-3"
+
+	type moveTest struct {
+		name        string
+		setupMock   func(c *mocks.MockCategoryRepositoryPort)
+		expectedErr error
+	}
+
+	tests := []moveTest{
+		{
+			name: "move category under another branch",
+			setupMock: func(c *mocks.MockCategoryRepositoryPort) {
+				c.On("FindCategoryByID", mock.Anything, "cat-1").Return(&domain.Category{ID: "cat-1"}, nil).Once()
+				c.On("FindCategoryAncestors", mock.Anything, "cat-3").Return([]domain.Category{{ID: "root"}, {ID: "cat-3"}}, nil).Once()
+				c.On("UpdateCategory", mock.Anything, mock.MatchedBy(func(cat *domain.Category) bool {
+					return cat.ParentID != nil && *cat.ParentID == "cat-3"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "reject moving category under its own descendant",
+			setupMock: func(c *mocks.MockCategoryRepositoryPort) {
+				c.On("FindCategoryByID", mock.Anything, "cat-1").Return(&domain.Category{ID: "cat-1"}, nil).Once()
+				c.On("FindCategoryAncestors", mock.Anything, "cat-3").Return([]domain.Category{{ID: "cat-1"}, {ID: "cat-2"}, {ID: "cat-3"}}, nil).Once()
+			},
+			expectedErr: domain.ErrCategoryCycle,
+		},
+		{
+			name: "reject unknown parent",
+			setupMock: func(c *mocks.MockCategoryRepositoryPort) {
+				c.On("FindCategoryByID", mock.Anything, "cat-1").Return(&domain.Category{ID: "cat-1"}, nil).Once()
+				c.On("FindCategoryAncestors", mock.Anything, "cat-3").Return([]domain.Category{}, nil).Once()
+			},
+			expectedErr: domain.ErrCategoryNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCategoryRepo := mocks.NewMockCategoryRepositoryPort(t)
+			tc.setupMock(mockCategoryRepo)
+
+			svc := service.NewCategoryService(mockCategoryRepo)
+
+			_, err := svc.MoveCategory(context.Background(), domain.Actor{UserID: "admin-1", Role: domain.RoleAdmin}, "cat-1", &parentID)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCategoryService_ListCategories_Breadcrumb(t *testing.T) {
+	rootID, goID := "root", "go"
+	mockCategoryRepo := mocks.NewMockCategoryRepositoryPort(t)
+	mockCategoryRepo.On("ListCategorySummaries", mock.Anything).Return([]domain.CategorySummary{
+		{Category: domain.Category{ID: "concurrency", Name: "Concurrency", ParentID: &goID}},
+		{Category: domain.Category{ID: goID, Name: "Go", ParentID: &rootID}},
+		{Category: domain.Category{ID: rootID, Name: "Programming"}},
+	}, nil).Once()
+
+	svc := service.NewCategoryService(mockCategoryRepo)
+
+	categories, err := svc.ListCategories(context.Background())
+	require.NoError(t, err)
+
+	var names []string
+	for _, crumb := range categories[0].Breadcrumb {
+		names = append(names, crumb.Name)
+	}
+	assert.Equal(t, []string{"Programming", "Go", "Concurrency"}, names)
+	assert.Len(t, categories[2].Breadcrumb, 1)
+}
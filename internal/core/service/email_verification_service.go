@@ -0,0 +1,100 @@
+package service
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/utils/token"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailVerificationService struct {
+	userRepo   port.AuthRepositoryPort
+	verifyRepo port.EmailVerificationRepositoryPort
+	mailer     port.MailerPort
+	verifyURL  string // Frontend page that receives the token as ?token=
+}
+
+func NewEmailVerificationService(userRepo port.AuthRepositoryPort, verifyRepo port.EmailVerificationRepositoryPort, mailer port.MailerPort, verifyURL string) *EmailVerificationService {
+	return &EmailVerificationService{
+		userRepo:   userRepo,
+		verifyRepo: verifyRepo,
+		mailer:     mailer,
+		verifyURL:  verifyURL,
+	}
+}
+
+// SendVerification mails a verification link. Earlier links stay valid until
+// they expire, so a slow mail does not invalidate the one the user opens.
+func (s *EmailVerificationService) SendVerification(ctx context.Context, userID string) error {
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrUserNotFound
+		}
+		return err
+	}
+	if user.EmailVerifiedAt != nil {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	latest, err := s.verifyRepo.FindLatestEmailVerificationToken(ctx, userID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if latest != nil && now.Sub(latest.CreatedAt) < domain.VerificationResendCooldown {
+		return domain.ErrVerificationThrottled
+	}
+
+	raw, hash, err := token.Generate()
+	if err != nil {
+		return err
+	}
+
+	err = s.verifyRepo.CreateEmailVerificationToken(ctx, &domain.EmailVerificationToken{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: now.Add(domain.EmailVerificationTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	link := s.verifyURL + "?token=" + url.QueryEscape(raw)
+	return s.mailer.Send(ctx, domain.Mail{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm that this is your email address by opening the link below "+
+			"within %d hours:\n\n%s\n",
+			user.Username, int(domain.EmailVerificationTTL.Hours()), link),
+	})
+}
+
+func (s *EmailVerificationService) VerifyEmail(ctx context.Context, rawToken string) error {
+	stored, err := s.verifyRepo.FindEmailVerificationTokenByHash(ctx, token.Hash(rawToken))
+	if err != nil {
+		return err
+	}
+	if stored == nil || stored.UsedAt != nil || time.Now().After(stored.ExpiresAt) {
+		return domain.ErrInvalidVerificationToken
+	}
+
+	consumed, err := s.verifyRepo.ConsumeEmailVerificationToken(ctx, stored.ID)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return domain.ErrInvalidVerificationToken
+	}
+
+	return s.userRepo.MarkEmailVerified(ctx, stored.UserID, time.Now())
+}
@@ -0,0 +1,88 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"blogg/utils/token"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerificationService(t *testing.T) {
+	type deps struct {
+		users    *mocks.MockAuthRepositoryPort
+		verifies *mocks.MockEmailVerificationRepositoryPort
+		mailer   *mocks.MockMailerPort
+	}
+	setup := func(t *testing.T) (*service.EmailVerificationService, deps) {
+		d := deps{
+			users:    mocks.NewMockAuthRepositoryPort(t),
+			verifies: mocks.NewMockEmailVerificationRepositoryPort(t),
+			mailer:   mocks.NewMockMailerPort(t),
+		}
+		return service.NewEmailVerificationService(d.users, d.verifies, d.mailer, "http://localhost:3000/verify-email"), d
+	}
+	unverified := &domain.User{ID: "user-1", Username: "jon", Email: "jon@example.com"}
+
+	t.Run("mail a verification link", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(unverified, nil).Once()
+		d.verifies.On("FindLatestEmailVerificationToken", mock.Anything, "user-1").
+			Return(&domain.EmailVerificationToken{CreatedAt: time.Now().Add(-time.Hour)}, nil).Once()
+		d.verifies.On("CreateEmailVerificationToken", mock.Anything, mock.MatchedBy(func(t *domain.EmailVerificationToken) bool {
+			return t.UserID == "user-1" && t.TokenHash != ""
+		})).Return(nil).Once()
+		d.mailer.On("Send", mock.Anything, mock.MatchedBy(func(m domain.Mail) bool {
+			return m.To == "jon@example.com"
+		})).Return(nil).Once()
+
+		require.NoError(t, svc.SendVerification(context.Background(), "user-1"))
+	})
+
+	t.Run("throttle resends", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(unverified, nil).Once()
+		d.verifies.On("FindLatestEmailVerificationToken", mock.Anything, "user-1").
+			Return(&domain.EmailVerificationToken{CreatedAt: time.Now().Add(-10 * time.Second)}, nil).Once()
+
+		err := svc.SendVerification(context.Background(), "user-1")
+		assert.ErrorIs(t, err, domain.ErrVerificationThrottled)
+	})
+
+	t.Run("refuse to resend for a verified email", func(t *testing.T) {
+		svc, d := setup(t)
+		verifiedAt := time.Now()
+		d.users.On("FindUserByID", mock.Anything, "user-1").
+			Return(&domain.User{ID: "user-1", EmailVerifiedAt: &verifiedAt}, nil).Once()
+
+		err := svc.SendVerification(context.Background(), "user-1")
+		assert.ErrorIs(t, err, domain.ErrEmailAlreadyVerified)
+	})
+
+	t.Run("verify with a valid token", func(t *testing.T) {
+		svc, d := setup(t)
+		d.verifies.On("FindEmailVerificationTokenByHash", mock.Anything, token.Hash("raw")).
+			Return(&domain.EmailVerificationToken{ID: "verify-1", UserID: "user-1", ExpiresAt: time.Now().Add(time.Hour)}, nil).Once()
+		d.verifies.On("ConsumeEmailVerificationToken", mock.Anything, "verify-1").Return(true, nil).Once()
+		d.users.On("MarkEmailVerified", mock.Anything, "user-1", mock.Anything).Return(nil).Once()
+
+		require.NoError(t, svc.VerifyEmail(context.Background(), "raw"))
+	})
+
+	t.Run("reject an expired token", func(t *testing.T) {
+		svc, d := setup(t)
+		d.verifies.On("FindEmailVerificationTokenByHash", mock.Anything, token.Hash("raw")).
+			Return(&domain.EmailVerificationToken{ID: "verify-1", UserID: "user-1", ExpiresAt: time.Now().Add(-time.Hour)}, nil).Once()
+
+		err := svc.VerifyEmail(context.Background(), "raw")
+		assert.ErrorIs(t, err, domain.ErrInvalidVerificationToken)
+	})
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/utils/hasher"
+	"blogg/utils/token"
+	"blogg/utils/totp"
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+)
+
+type MFAService struct {
+	userRepo    port.AuthRepositoryPort
+	mfaRepo     port.MFARepositoryPort
+	sessionRepo port.SessionRepositoryPort
+	attempts    port.LoginAttemptStorePort // Shared with logins, see Disable
+	issuer      string                     // Shown as the account's label in authenticator apps
+}
+
+func NewMFAService(userRepo port.AuthRepositoryPort, mfaRepo port.MFARepositoryPort, sessionRepo port.SessionRepositoryPort, attempts port.LoginAttemptStorePort, issuer string) *MFAService {
+	return &MFAService{
+		userRepo:    userRepo,
+		mfaRepo:     mfaRepo,
+		sessionRepo: sessionRepo,
+		attempts:    attempts,
+		issuer:      issuer,
+	}
+}
+
+// Enroll creates a new secret for the user. It does nothing for logins until
+// confirmed with Enable.
+func (s *MFAService) Enroll(ctx context.Context, userID string) (*domain.MFAEnrollment, error) {
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.mfaRepo.FindMFAByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Enabled() {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	err = s.mfaRepo.SaveMFASecret(ctx, &domain.MFA{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.MFAEnrollment{
+		Secret: secret,
+		URI:    totp.URI(s.issuer, user.Username, secret),
+	}, nil
+}
+
+// Enable turns on 2FA once the user proves their app produces valid codes
+func (s *MFAService) Enable(ctx context.Context, userID string, sessionID string, code string) (*domain.EnableMFARes, error) {
+	m, err := s.mfaRepo.FindMFAByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, domain.ErrMFANotEnrolled
+	}
+	if m.Enabled() {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	if err := s.verifyTOTP(ctx, m, code); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, domain.RecoveryCodeCount)
+	hashes := make([]string, domain.RecoveryCodeCount)
+	for i := range codes {
+		codes[i], err = generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		hashes[i] = token.Hash(normalizeRecoveryCode(codes[i]))
+	}
+	if err := s.mfaRepo.EnableMFA(ctx, userID, time.Now(), hashes); err != nil {
+		return nil, err
+	}
+
+	// The user just produced a code, so this session has passed 2FA
+	if sessionID != "" {
+		if err := s.sessionRepo.MarkSessionMFA(ctx, sessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.EnableMFARes{RecoveryCodes: codes}, nil
+}
+
+// Disable turns off 2FA after checking both factors. Admins cannot disable it.
+// Wrong guesses count as failed logins and the same lockout applies, so a
+// stolen session cannot be used to guess the password.
+func (s *MFAService) Disable(ctx context.Context, userID string, req *domain.DisableMFAReq) error {
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role == domain.RoleAdmin {
+		return domain.ErrMFAMandatory
+	}
+	if err := checkLoginLock(ctx, s.attempts, user.Username, req.IP); err != nil {
+		return err
+	}
+
+	matched, err := hasher.NewArgonHash().Verify(req.Password, user.Password)
+	if err != nil {
+		return err
+	}
+	if !matched {
+		if err := recordLoginFailure(ctx, s.attempts, user.Username, req.IP); err != nil {
+			return err
+		}
+		return domain.ErrInvalidCredentials
+	}
+
+	if err := s.VerifyCode(ctx, userID, req.Code); err != nil {
+		if errors.Is(err, domain.ErrInvalidMFACode) {
+			if err := recordLoginFailure(ctx, s.attempts, user.Username, req.IP); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+
+	if err := s.attempts.ResetLoginAttempts(ctx, domain.LoginUserKey(user.Username)); err != nil {
+		return err
+	}
+	return s.mfaRepo.DeleteMFA(ctx, userID)
+}
+
+func (s *MFAService) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	m, err := s.mfaRepo.FindMFAByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return m.Enabled(), nil
+}
+
+// VerifyCode checks a code from the user's app, falling back to spending a
+// recovery code
+func (s *MFAService) VerifyCode(ctx context.Context, userID string, code string) error {
+	m, err := s.mfaRepo.FindMFAByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !m.Enabled() {
+		return domain.ErrMFANotEnabled
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		return s.verifyTOTP(ctx, m, code)
+	}
+
+	consumed, err := s.mfaRepo.ConsumeRecoveryCode(ctx, userID, token.Hash(normalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return domain.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// verifyTOTP accepts each code once, so one seen over a shoulder is useless
+func (s *MFAService) verifyTOTP(ctx context.Context, m *domain.MFA, code string) error {
+	step, ok := totp.Validate(m.Secret, strings.TrimSpace(code), time.Now())
+	if !ok || step <= m.LastUsedStep {
+		return domain.ErrInvalidMFACode
+	}
+
+	used, err := s.mfaRepo.UseMFAStep(ctx, m.UserID, step)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return domain.ErrInvalidMFACode
+	}
+	return nil
+}
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateRecoveryCode returns 80 random bits as four groups of four
+// characters, e.g. ABCD-EFGH-IJKL-MNOP
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	raw := recoveryEncoding.EncodeToString(b)
+	return raw[0:4] + "-" + raw[4:8] + "-" + raw[8:12] + "-" + raw[12:16], nil
+}
+
+// normalizeRecoveryCode ignores case, dashes and spaces in what the user typed
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
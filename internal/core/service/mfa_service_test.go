@@ -0,0 +1,173 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"blogg/utils/hasher"
+	"blogg/utils/token"
+	"blogg/utils/totp"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMFAService(t *testing.T) {
+	type deps struct {
+		users    *mocks.MockAuthRepositoryPort
+		mfa      *mocks.MockMFARepositoryPort
+		sessions *mocks.MockSessionRepositoryPort
+		attempts *mocks.MockLoginAttemptStorePort
+	}
+	setup := func(t *testing.T) (*service.MFAService, deps) {
+		d := deps{
+			users:    mocks.NewMockAuthRepositoryPort(t),
+			mfa:      mocks.NewMockMFARepositoryPort(t),
+			sessions: mocks.NewMockSessionRepositoryPort(t),
+			attempts: mocks.NewMockLoginAttemptStorePort(t),
+		}
+		return service.NewMFAService(d.users, d.mfa, d.sessions, d.attempts, "Blogg"), d
+	}
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	currentCode := func(t *testing.T) string {
+		code, err := totp.Code(secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+		return code
+	}
+	enabledAt := time.Now().Add(-time.Hour)
+	hashed, err := hasher.NewArgonHash().Hash("password123")
+	require.NoError(t, err)
+
+	t.Run("enroll with an otpauth URI", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1", Username: "jon"}, nil).Once()
+		d.mfa.On("FindMFAByUserID", mock.Anything, "user-1").Return((*domain.MFA)(nil), nil).Once()
+		d.mfa.On("SaveMFASecret", mock.Anything, mock.MatchedBy(func(m *domain.MFA) bool {
+			return m.UserID == "user-1" && m.Secret != "" && m.EnabledAt == nil
+		})).Return(nil).Once()
+
+		enrollment, err := svc.Enroll(context.Background(), "user-1")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/Blogg:jon?"))
+		assert.Contains(t, enrollment.URI, "secret="+enrollment.Secret)
+	})
+
+	t.Run("refuse to enroll twice", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1"}, nil).Once()
+		d.mfa.On("FindMFAByUserID", mock.Anything, "user-1").Return(&domain.MFA{UserID: "user-1", EnabledAt: &enabledAt}, nil).Once()
+
+		_, err := svc.Enroll(context.Background(), "user-1")
+		assert.ErrorIs(t, err, domain.ErrMFAAlreadyEnabled)
+	})
+
+	t.Run("enable with a first code and hand out hashed recovery codes", func(t *testing.T) {
+		svc, d := setup(t)
+		d.mfa.On("FindMFAByUserID", mock.Anything, "user-1").Return(&domain.MFA{UserID: "user-1", Secret: secret}, nil).Once()
+		d.mfa.On("UseMFAStep", mock.Anything, "user-1", mock.Anything).Return(true, nil).Once()
+		var stored []string
+		d.mfa.On("EnableMFA", mock.Anything, "user-1", mock.Anything, mock.MatchedBy(func(hashes []string) bool {
+			stored = hashes
+			return len(hashes) == domain.RecoveryCodeCount
+		})).Return(nil).Once()
+		d.sessions.On("MarkSessionMFA", mock.Anything, "session-1").Return(nil).Once()
+
+		result, err := svc.Enable(context.Background(), "user-1", "session-1", currentCode(t))
+		require.NoError(t, err)
+		require.Len(t, result.RecoveryCodes, domain.RecoveryCodeCount)
+		for i, code := range result.RecoveryCodes {
+			assert.NotEqual(t, code, stored[i], "only hashes are stored")
+		}
+	})
+
+	t.Run("refuse to enable with a wrong code", func(t *testing.T) {
+		svc, d := setup(t)
+		d.mfa.On("FindMFAByUserID", mock.Anything, "user-1").Return(&domain.MFA{UserID: "user-1", Secret: secret}, nil).Once()
+
+		_, err := svc.Enable(context.Background(), "user-1", "session-1", "abcdef")
+		assert.ErrorIs(t, err, domain.ErrInvalidMFACode)
+	})
+
+	t.Run("refuse a code that was already used", func(t *testing.T) {
+		svc, d := setup(t)
+		d.mfa.On("FindMFAByUserID", mock.Anything, "user-1").
+			Return(&domain.MFA{UserID: "user-1", Secret: secret, EnabledAt: &enabledAt, LastUsedStep: totp.Step(time.Now()) + totp.Skew}, nil).Once()
+
+		err := svc.VerifyCode(context.Background(), "user-1", currentCode(t))
+		assert.ErrorIs(t, err, domain.ErrInvalidMFACode)
+	})
+
+	t.Run("accept a recovery code however it is typed", func(t *testing.T) {
+		svc, d := setup(t)
+		d.mfa.On("FindMFAByUserID", mock.Anything, "user-1").Return(&domain.MFA{UserID: "user-1", Secret: secret, EnabledAt: &enabledAt}, nil).Once()
+		d.mfa.On("ConsumeRecoveryCode", mock.Anything, "user-1", token.Hash("ABCDEFGHIJKLMNOP")).Return(true, nil).Once()
+
+		require.NoError(t, svc.VerifyCode(context.Background(), "user-1", "abcd-efgh ijkl-mnop"))
+	})
+
+	t.Run("refuse to disable for admins", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "admin-1").
+			Return(&domain.User{ID: "admin-1", Role: domain.RoleAdmin, Password: hashed}, nil).Once()
+
+		err := svc.Disable(context.Background(), "admin-1", &domain.DisableMFAReq{Password: "password123", Code: "123456"})
+		assert.ErrorIs(t, err, domain.ErrMFAMandatory)
+	})
+
+	t.Run("refuse to disable with a wrong password", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").
+			Return(&domain.User{ID: "user-1", Username: "jon", Role: domain.RoleUser, Password: hashed}, nil).Once()
+		d.attempts.On("GetLoginAttempts", mock.Anything, mock.Anything).Return((*domain.LoginAttempts)(nil), nil).Twice()
+		d.attempts.On("RecordLoginFailure", mock.Anything, domain.LoginUserKey("jon"), mock.Anything, mock.Anything).Return(nil).Once()
+		d.attempts.On("RecordLoginFailure", mock.Anything, domain.LoginIPKey("203.0.113.7"), mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := svc.Disable(context.Background(), "user-1", &domain.DisableMFAReq{Password: "wrong", Code: "123456", IP: "203.0.113.7"})
+		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("count a wrong code as a failed login", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").
+			Return(&domain.User{ID: "user-1", Username: "jon", Role: domain.RoleUser, Password: hashed}, nil).Once()
+		d.attempts.On("GetLoginAttempts", mock.Anything, domain.LoginUserKey("jon")).Return((*domain.LoginAttempts)(nil), nil).Once()
+		d.mfa.On("FindMFAByUserID", mock.Anything, "user-1").Return(&domain.MFA{UserID: "user-1", Secret: secret, EnabledAt: &enabledAt}, nil).Once()
+		d.attempts.On("RecordLoginFailure", mock.Anything, domain.LoginUserKey("jon"), mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := svc.Disable(context.Background(), "user-1", &domain.DisableMFAReq{Password: "password123", Code: "abcdef"})
+		assert.ErrorIs(t, err, domain.ErrInvalidMFACode)
+	})
+
+	t.Run("refuse a locked account without checking the password", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").
+			Return(&domain.User{ID: "user-1", Username: "jon", Role: domain.RoleUser, Password: hashed}, nil).Once()
+		d.attempts.On("GetLoginAttempts", mock.Anything, domain.LoginUserKey("jon")).
+			Return(&domain.LoginAttempts{Failures: domain.LoginUserFreeFailures + 1, LastFailureAt: time.Now()}, nil).Once()
+
+		err := svc.Disable(context.Background(), "user-1", &domain.DisableMFAReq{Password: "password123", Code: currentCode(t)})
+		assert.ErrorIs(t, err, domain.ErrAccountLocked)
+	})
+
+	t.Run("disable with the password and a code", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").
+			Return(&domain.User{ID: "user-1", Username: "jon", Role: domain.RoleUser, Password: hashed}, nil).Once()
+		d.attempts.On("GetLoginAttempts", mock.Anything, domain.LoginUserKey("jon")).Return((*domain.LoginAttempts)(nil), nil).Once()
+		d.mfa.On("FindMFAByUserID", mock.Anything, "user-1").Return(&domain.MFA{UserID: "user-1", Secret: secret, EnabledAt: &enabledAt}, nil).Once()
+		d.mfa.On("UseMFAStep", mock.Anything, "user-1", mock.Anything).Return(true, nil).Once()
+		d.attempts.On("ResetLoginAttempts", mock.Anything, domain.LoginUserKey("jon")).Return(nil).Once()
+		d.mfa.On("DeleteMFA", mock.Anything, "user-1").Return(nil).Once()
+
+		require.NoError(t, svc.Disable(context.Background(), "user-1", &domain.DisableMFAReq{Password: "password123", Code: currentCode(t)}))
+	})
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/utils/hasher"
+	jwthelper "blogg/utils/jwt"
+	"blogg/utils/token"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	minUsernameLength = 4 // Matches UserRegisterReq
+	maxUsernameLength = 32
+)
+
+type OIDCService struct {
+	providers    map[string]port.OIDCProviderPort
+	identityRepo port.IdentityRepositoryPort
+	userRepo     port.AuthRepositoryPort
+	authService  port.AuthServicePort
+	verifier     port.EmailVerificationServicePort
+}
+
+func NewOIDCService(providers map[string]port.OIDCProviderPort, identityRepo port.IdentityRepositoryPort, userRepo port.AuthRepositoryPort, authService port.AuthServicePort, verifier port.EmailVerificationServicePort) *OIDCService {
+	return &OIDCService{
+		providers:    providers,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		authService:  authService,
+		verifier:     verifier,
+	}
+}
+
+// StartLogin creates the state, nonce and PKCE verifier of a login at
+// provider. They travel in the signed flow token, which the caller keeps in
+// a cookie so that only the browser that started the login can finish it.
+func (s *OIDCService) StartLogin(ctx context.Context, provider string) (*domain.OIDCStart, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, domain.ErrUnknownProvider
+	}
+
+	state, nonce, verifier := rand.Text(), rand.Text(), rand.Text()+rand.Text()
+	challenge := sha256.Sum256([]byte(verifier))
+
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	flowToken, err := jwthelper.NewDefaultJWTManager().GenerateOIDCFlowToken(provider, state, nonce, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OIDCStart{AuthURL: authURL, FlowToken: flowToken}, nil
+}
+
+func (s *OIDCService) Callback(ctx context.Context, req *domain.OIDCCallbackReq) (*domain.UserLoginRes, error) {
+	p, ok := s.providers[req.Provider]
+	if !ok {
+		return nil, domain.ErrUnknownProvider
+	}
+
+	flow, err := jwthelper.NewDefaultJWTManager().ValidateOIDCFlowToken(req.FlowToken)
+	if err != nil || flow.Provider != req.Provider || !equalSecrets(flow.State, req.State) {
+		return nil, domain.ErrInvalidOIDCState
+	}
+
+	claims, err := p.Exchange(ctx, req.Code, flow.CodeVerifier)
+	if err != nil {
+		log.Printf("oidc: login at %s failed: %v", req.Provider, err)
+		return nil, domain.ErrOIDCLoginFailed
+	}
+	// A replayed ID token would carry the nonce of another login
+	if !equalSecrets(flow.Nonce, claims.Nonce) {
+		return nil, domain.ErrOIDCLoginFailed
+	}
+
+	user, err := s.findOrCreateUser(ctx, req.Provider, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authService.LoginAs(ctx, user, req.UserAgent, req.IP)
+}
+
+// findOrCreateUser returns the user linked to the identity, creating both on
+// the first login. An email that already belongs to a local account is not
+// linked automatically, since the provider cannot prove the account owner
+// agreed to it.
+func (s *OIDCService) findOrCreateUser(ctx context.Context, provider string, claims *domain.OIDCClaims) (*domain.User, error) {
+	now := time.Now()
+
+	identity, err := s.identityRepo.FindIdentity(ctx, provider, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if identity != nil {
+		user, err := s.userRepo.FindUserByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.identityRepo.TouchIdentity(ctx, identity.ID, now); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+
+	if claims.Email == "" {
+		return nil, domain.ErrIdentityEmailMissing
+	}
+	existing, err := s.userRepo.FindUserByEmail(ctx, claims.Email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, domain.ErrIdentityEmailTaken
+	}
+
+	username, err := s.availableUsername(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// The account has no usable password until the user sets one through a reset
+	rawPassword, _, err := token.Generate()
+	if err != nil {
+		return nil, err
+	}
+	hashed, err := hasher.NewArgonHash().Hash(rawPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &domain.User{
+		ID:       uuid.NewString(),
+		Username: username,
+		Password: hashed,
+		Email:    claims.Email,
+		Role:     domain.RoleUser,
+	}
+	if claims.EmailVerified {
+		user.EmailVerifiedAt = &now
+	}
+	identity = &domain.Identity{
+		ID:          uuid.NewString(),
+		UserID:      user.ID,
+		Provider:    provider,
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		CreatedAt:   now,
+		LastLoginAt: now,
+	}
+	if err := s.identityRepo.CreateUserWithIdentity(ctx, user, identity); err != nil {
+		return nil, err
+	}
+
+	if !claims.EmailVerified {
+		if err := s.verifier.SendVerification(ctx, user.ID); err != nil {
+			log.Printf("mail: failed to send verification to user %s: %v", user.ID, err)
+		}
+	}
+
+	return user, nil
+}
+
+// availableUsername derives a username from the ID token, adding a number
+// when it is taken
+func (s *OIDCService) availableUsername(ctx context.Context, claims *domain.OIDCClaims) (string, error) {
+	base := sanitizeUsername(claims.PreferredUsername)
+	if base == "" {
+		base = sanitizeUsername(strings.SplitN(claims.Email, "@", 2)[0])
+	}
+	for utf8.RuneCountInString(base) < minUsernameLength {
+		base += "_"
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		candidate := base
+		if attempt > 0 {
+			suffix := fmt.Sprintf("%d", attempt+1)
+			if attempt > 3 {
+				suffix = strings.ToLower(rand.Text()[:6])
+			}
+			candidate = truncate(base, maxUsernameLength-len(suffix)-1) + "_" + suffix
+		}
+
+		found, err := s.userRepo.FindUserByUsername(ctx, candidate)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+		if found == nil {
+			return candidate, nil
+		}
+	}
+	return "", domain.ErrUsernameExists
+}
+
+// sanitizeUsername keeps letters, digits, dots, dashes and underscores
+func sanitizeUsername(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' || r == '-' || r == '_' {
+			b.WriteRune(r)
+		}
+	}
+	return truncate(b.String(), maxUsernameLength)
+}
+
+func equalSecrets(a, b string) bool {
+	return a != "" && subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,138 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCService(t *testing.T) {
+	type deps struct {
+		provider   *mocks.MockOIDCProviderPort
+		identities *mocks.MockIdentityRepositoryPort
+		users      *mocks.MockAuthRepositoryPort
+		auth       *mocks.MockAuthServicePort
+		verifier   *mocks.MockEmailVerificationServicePort
+	}
+	setup := func(t *testing.T) (deps, *service.OIDCService) {
+		d := deps{
+			provider:   mocks.NewMockOIDCProviderPort(t),
+			identities: mocks.NewMockIdentityRepositoryPort(t),
+			users:      mocks.NewMockAuthRepositoryPort(t),
+			auth:       mocks.NewMockAuthServicePort(t),
+			verifier:   mocks.NewMockEmailVerificationServicePort(t),
+		}
+		providers := map[string]port.OIDCProviderPort{"test": d.provider}
+		return d, service.NewOIDCService(providers, d.identities, d.users, d.auth, d.verifier)
+	}
+	// start begins a login and returns the state and nonce sent to the provider
+	start := func(t *testing.T, d deps, svc *service.OIDCService) (flow *domain.OIDCStart, state, nonce string) {
+		d.provider.On("AuthCodeURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				state, nonce = args.String(1), args.String(2)
+			}).Return("https://idp.example.com/authorize", nil).Once()
+		flow, err := svc.StartLogin(context.Background(), "test")
+		require.NoError(t, err)
+		return flow, state, nonce
+	}
+	loggedIn := &domain.UserLoginRes{AccessToken: "access"}
+
+	t.Run("refuse an unknown provider", func(t *testing.T) {
+		_, svc := setup(t)
+		_, err := svc.StartLogin(context.Background(), "other")
+		assert.ErrorIs(t, err, domain.ErrUnknownProvider)
+	})
+
+	t.Run("refuse a state that does not match the flow", func(t *testing.T) {
+		d, svc := setup(t)
+		flow, _, _ := start(t, d, svc)
+
+		_, err := svc.Callback(context.Background(), &domain.OIDCCallbackReq{
+			Provider: "test", Code: "code", State: "forged", FlowToken: flow.FlowToken,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidOIDCState)
+	})
+
+	t.Run("refuse an ID token issued for another nonce", func(t *testing.T) {
+		d, svc := setup(t)
+		flow, state, _ := start(t, d, svc)
+		d.provider.On("Exchange", mock.Anything, "code", mock.Anything).
+			Return(&domain.OIDCClaims{Subject: "sub-1", Nonce: "replayed"}, nil).Once()
+
+		_, err := svc.Callback(context.Background(), &domain.OIDCCallbackReq{
+			Provider: "test", Code: "code", State: state, FlowToken: flow.FlowToken,
+		})
+		assert.ErrorIs(t, err, domain.ErrOIDCLoginFailed)
+	})
+
+	t.Run("log in the user linked to the identity", func(t *testing.T) {
+		d, svc := setup(t)
+		flow, state, nonce := start(t, d, svc)
+		user := &domain.User{ID: "user-1", Role: domain.RoleUser}
+		d.provider.On("Exchange", mock.Anything, "code", mock.Anything).
+			Return(&domain.OIDCClaims{Subject: "sub-1", Nonce: nonce}, nil).Once()
+		d.identities.On("FindIdentity", mock.Anything, "test", "sub-1").
+			Return(&domain.Identity{ID: "identity-1", UserID: "user-1"}, nil).Once()
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(user, nil).Once()
+		d.identities.On("TouchIdentity", mock.Anything, "identity-1", mock.Anything).Return(nil).Once()
+		d.auth.On("LoginAs", mock.Anything, user, "agent", "10.0.0.1").Return(loggedIn, nil).Once()
+
+		result, err := svc.Callback(context.Background(), &domain.OIDCCallbackReq{
+			Provider: "test", Code: "code", State: state, FlowToken: flow.FlowToken, UserAgent: "agent", IP: "10.0.0.1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, loggedIn, result)
+	})
+
+	t.Run("create a verified user on the first login", func(t *testing.T) {
+		d, svc := setup(t)
+		flow, state, nonce := start(t, d, svc)
+		d.provider.On("Exchange", mock.Anything, "code", mock.Anything).Return(&domain.OIDCClaims{
+			Subject: "sub-1", Email: "reader@example.com", EmailVerified: true, PreferredUsername: "Reader One", Nonce: nonce,
+		}, nil).Once()
+		d.identities.On("FindIdentity", mock.Anything, "test", "sub-1").Return(nil, nil).Once()
+		d.users.On("FindUserByEmail", mock.Anything, "reader@example.com").Return(nil, sql.ErrNoRows).Once()
+		d.users.On("FindUserByUsername", mock.Anything, "ReaderOne").Return(&domain.User{ID: "someone"}, nil).Once()
+		d.users.On("FindUserByUsername", mock.Anything, "ReaderOne_2").Return(nil, sql.ErrNoRows).Once()
+		d.identities.On("CreateUserWithIdentity", mock.Anything,
+			mock.MatchedBy(func(u *domain.User) bool {
+				return u.Username == "ReaderOne_2" && u.Email == "reader@example.com" &&
+					u.Role == domain.RoleUser && u.EmailVerifiedAt != nil && u.Password != ""
+			}),
+			mock.MatchedBy(func(i *domain.Identity) bool {
+				return i.Provider == "test" && i.Subject == "sub-1"
+			}),
+		).Return(nil).Once()
+		d.auth.On("LoginAs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(loggedIn, nil).Once()
+
+		_, err := svc.Callback(context.Background(), &domain.OIDCCallbackReq{
+			Provider: "test", Code: "code", State: state, FlowToken: flow.FlowToken,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("not link an email that belongs to a local account", func(t *testing.T) {
+		d, svc := setup(t)
+		flow, state, nonce := start(t, d, svc)
+		d.provider.On("Exchange", mock.Anything, "code", mock.Anything).Return(&domain.OIDCClaims{
+			Subject: "sub-1", Email: "reader@example.com", EmailVerified: true, Nonce: nonce,
+		}, nil).Once()
+		d.identities.On("FindIdentity", mock.Anything, "test", "sub-1").Return(nil, nil).Once()
+		d.users.On("FindUserByEmail", mock.Anything, "reader@example.com").Return(&domain.User{ID: "user-1"}, nil).Once()
+
+		_, err := svc.Callback(context.Background(), &domain.OIDCCallbackReq{
+			Provider: "test", Code: "code", State: state, FlowToken: flow.FlowToken,
+		})
+		assert.ErrorIs(t, err, domain.ErrIdentityEmailTaken)
+	})
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/utils/hasher"
+	"blogg/utils/token"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// resetMailTimeout bounds the background work of one ForgotPassword call
+const resetMailTimeout = 30 * time.Second
+
+type PasswordResetService struct {
+	userRepo    port.AuthRepositoryPort
+	resetRepo   port.PasswordResetRepositoryPort
+	authService port.AuthServicePort
+	tokens      port.AccessTokenServicePort
+	mailer      port.MailerPort
+	resetURL    string // Frontend page that receives the token as ?token=
+
+	pending sync.WaitGroup // Reset links still being issued
+}
+
+func NewPasswordResetService(userRepo port.AuthRepositoryPort, resetRepo port.PasswordResetRepositoryPort, authService port.AuthServicePort, tokens port.AccessTokenServicePort, mailer port.MailerPort, resetURL string) *PasswordResetService {
+	return &PasswordResetService{
+		userRepo:    userRepo,
+		resetRepo:   resetRepo,
+		authService: authService,
+		tokens:      tokens,
+		mailer:      mailer,
+		resetURL:    resetURL,
+	}
+}
+
+// ForgotPassword mails a reset link to the owner of email. Unknown addresses
+// and mail failures are not reported back, so the response is the same
+// whether or not the address is registered. The link is issued and mailed in
+// the background, since doing so before answering would make the response
+// slower for registered addresses.
+func (s *PasswordResetService) ForgotPassword(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if user.SuspendedAt != nil {
+		return nil
+	}
+
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), resetMailTimeout)
+		defer cancel()
+
+		if err := s.sendResetLink(ctx, user); err != nil {
+			log.Printf("mail: failed to send password reset to user %s: %v", user.ID, err)
+		}
+	}()
+
+	return nil
+}
+
+// Wait blocks until the reset links requested so far have been mailed or
+// have failed
+func (s *PasswordResetService) Wait() {
+	s.pending.Wait()
+}
+
+func (s *PasswordResetService) sendResetLink(ctx context.Context, user *domain.User) error {
+	// Only the most recent link works
+	if err := s.resetRepo.DeleteUserPasswordResetTokens(ctx, user.ID); err != nil {
+		return err
+	}
+
+	raw, hash, err := token.Generate()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	err = s.resetRepo.CreatePasswordResetToken(ctx, &domain.PasswordResetToken{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: now.Add(domain.PasswordResetTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	link := s.resetURL + "?token=" + url.QueryEscape(raw)
+	return s.mailer.Send(ctx, domain.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nSomeone asked to reset the password of your account. "+
+			"If it was you, open the link below within %d minutes:\n\n%s\n\n"+
+			"If it was not, you can ignore this mail.\n",
+			user.Username, int(domain.PasswordResetTTL.Minutes()), link),
+	})
+}
+
+// ResetPassword sets a new password with a token from ForgotPassword. The
+// token is spent, every session of the user is logged out and their personal
+// access tokens are deleted, since a reset is how an account is taken back
+// from whoever may have created them.
+func (s *PasswordResetService) ResetPassword(ctx context.Context, req *domain.ResetPasswordReq) error {
+	stored, err := s.resetRepo.FindPasswordResetTokenByHash(ctx, token.Hash(req.Token))
+	if err != nil {
+		return err
+	}
+	if stored == nil || stored.UsedAt != nil || time.Now().After(stored.ExpiresAt) {
+		return domain.ErrInvalidResetToken
+	}
+
+	consumed, err := s.resetRepo.ConsumePasswordResetToken(ctx, stored.ID)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return domain.ErrInvalidResetToken
+	}
+
+	hashed, err := hasher.NewArgonHash().Hash(req.Password)
+	if err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdateUserPassword(ctx, stored.UserID, hashed); err != nil {
+		return err
+	}
+	if err := s.resetRepo.DeleteUserPasswordResetTokens(ctx, stored.UserID); err != nil {
+		return err
+	}
+	if err := s.tokens.RevokeAllTokens(ctx, stored.UserID); err != nil {
+		return err
+	}
+
+	return s.authService.LogoutEverywhere(ctx, stored.UserID)
+}
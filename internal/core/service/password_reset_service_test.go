@@ -0,0 +1,168 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"blogg/utils/hasher"
+	"blogg/utils/token"
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordResetService(t *testing.T) {
+	const resetURL = "http://localhost:3000/reset-password"
+
+	type deps struct {
+		users  *mocks.MockAuthRepositoryPort
+		resets *mocks.MockPasswordResetRepositoryPort
+		auth   *mocks.MockAuthServicePort
+		tokens *mocks.MockAccessTokenServicePort
+		mailer *mocks.MockMailerPort
+	}
+	setup := func(t *testing.T) (*service.PasswordResetService, deps) {
+		d := deps{
+			users:  mocks.NewMockAuthRepositoryPort(t),
+			resets: mocks.NewMockPasswordResetRepositoryPort(t),
+			auth:   mocks.NewMockAuthServicePort(t),
+			tokens: mocks.NewMockAccessTokenServicePort(t),
+			mailer: mocks.NewMockMailerPort(t),
+		}
+		return service.NewPasswordResetService(d.users, d.resets, d.auth, d.tokens, d.mailer, resetURL), d
+	}
+
+	t.Run("mail a link whose token matches the stored hash", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByEmail", mock.Anything, "jon@example.com").
+			Return(&domain.User{ID: "user-1", Username: "jon", Email: "jon@example.com"}, nil).Once()
+		d.resets.On("DeleteUserPasswordResetTokens", mock.Anything, "user-1").Return(nil).Once()
+
+		var stored *domain.PasswordResetToken
+		d.resets.On("CreatePasswordResetToken", mock.Anything, mock.MatchedBy(func(t *domain.PasswordResetToken) bool {
+			stored = t
+			return t.UserID == "user-1" && t.ExpiresAt.After(time.Now())
+		})).Return(nil).Once()
+
+		var sent domain.Mail
+		d.mailer.On("Send", mock.Anything, mock.MatchedBy(func(m domain.Mail) bool {
+			sent = m
+			return m.To == "jon@example.com"
+		})).Return(nil).Once()
+
+		require.NoError(t, svc.ForgotPassword(context.Background(), "jon@example.com"))
+		svc.Wait()
+
+		i := strings.Index(sent.Body, resetURL+"?token=")
+		require.GreaterOrEqual(t, i, 0)
+		link, err := url.Parse(strings.Fields(sent.Body[i:])[0])
+		require.NoError(t, err)
+		assert.Equal(t, stored.TokenHash, token.Hash(link.Query().Get("token")))
+	})
+
+	t.Run("succeed silently for an unknown email", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByEmail", mock.Anything, "nobody@example.com").Return((*domain.User)(nil), sql.ErrNoRows).Once()
+
+		require.NoError(t, svc.ForgotPassword(context.Background(), "nobody@example.com"))
+	})
+
+	t.Run("hide mail delivery failures", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByEmail", mock.Anything, "jon@example.com").
+			Return(&domain.User{ID: "user-1", Email: "jon@example.com"}, nil).Once()
+		d.resets.On("DeleteUserPasswordResetTokens", mock.Anything, "user-1").Return(nil).Once()
+		d.resets.On("CreatePasswordResetToken", mock.Anything, mock.Anything).Return(nil).Once()
+		d.mailer.On("Send", mock.Anything, mock.Anything).Return(errors.New("connection refused")).Once()
+
+		require.NoError(t, svc.ForgotPassword(context.Background(), "jon@example.com"))
+		svc.Wait()
+	})
+
+	t.Run("answer before the link is issued", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByEmail", mock.Anything, "jon@example.com").
+			Return(&domain.User{ID: "user-1", Email: "jon@example.com"}, nil).Once()
+		release := make(chan struct{})
+		d.resets.On("DeleteUserPasswordResetTokens", mock.Anything, "user-1").
+			Run(func(mock.Arguments) { <-release }).Return(nil).Once()
+		d.resets.On("CreatePasswordResetToken", mock.Anything, mock.Anything).Return(nil).Once()
+		d.mailer.On("Send", mock.MatchedBy(func(ctx context.Context) bool {
+			return ctx.Err() == nil
+		}), mock.Anything).Return(nil).Once()
+
+		// A request context is canceled once the response is written
+		ctx, cancel := context.WithCancel(context.Background())
+		require.NoError(t, svc.ForgotPassword(ctx, "jon@example.com"))
+		cancel()
+
+		close(release)
+		svc.Wait()
+	})
+
+	t.Run("set the password, spend the token, delete access tokens and log out everywhere", func(t *testing.T) {
+		svc, d := setup(t)
+		d.resets.On("FindPasswordResetTokenByHash", mock.Anything, token.Hash("raw")).
+			Return(&domain.PasswordResetToken{ID: "reset-1", UserID: "user-1", ExpiresAt: time.Now().Add(time.Minute)}, nil).Once()
+		d.resets.On("ConsumePasswordResetToken", mock.Anything, "reset-1").Return(true, nil).Once()
+		d.users.On("UpdateUserPassword", mock.Anything, "user-1", mock.MatchedBy(func(hash string) bool {
+			ok, err := hasher.NewArgonHash().Verify("new-password", hash)
+			return err == nil && ok
+		})).Return(nil).Once()
+		d.resets.On("DeleteUserPasswordResetTokens", mock.Anything, "user-1").Return(nil).Once()
+		d.tokens.On("RevokeAllTokens", mock.Anything, "user-1").Return(nil).Once()
+		d.auth.On("LogoutEverywhere", mock.Anything, "user-1").Return(nil).Once()
+
+		err := svc.ResetPassword(context.Background(), &domain.ResetPasswordReq{Token: "raw", Password: "new-password"})
+		require.NoError(t, err)
+	})
+
+	t.Run("fail the reset when access tokens cannot be deleted", func(t *testing.T) {
+		svc, d := setup(t)
+		d.resets.On("FindPasswordResetTokenByHash", mock.Anything, token.Hash("raw")).
+			Return(&domain.PasswordResetToken{ID: "reset-1", UserID: "user-1", ExpiresAt: time.Now().Add(time.Minute)}, nil).Once()
+		d.resets.On("ConsumePasswordResetToken", mock.Anything, "reset-1").Return(true, nil).Once()
+		d.users.On("UpdateUserPassword", mock.Anything, "user-1", mock.Anything).Return(nil).Once()
+		d.resets.On("DeleteUserPasswordResetTokens", mock.Anything, "user-1").Return(nil).Once()
+		d.tokens.On("RevokeAllTokens", mock.Anything, "user-1").Return(errors.New("connection refused")).Once()
+
+		err := svc.ResetPassword(context.Background(), &domain.ResetPasswordReq{Token: "raw", Password: "new-password"})
+		assert.Error(t, err)
+	})
+
+	t.Run("reject used, expired and unknown tokens", func(t *testing.T) {
+		usedAt := time.Now()
+		tokens := []*domain.PasswordResetToken{
+			{ID: "reset-1", ExpiresAt: time.Now().Add(time.Minute), UsedAt: &usedAt},
+			{ID: "reset-2", ExpiresAt: time.Now().Add(-time.Minute)},
+			nil,
+		}
+		for _, stored := range tokens {
+			svc, d := setup(t)
+			d.resets.On("FindPasswordResetTokenByHash", mock.Anything, token.Hash("raw")).Return(stored, nil).Once()
+
+			err := svc.ResetPassword(context.Background(), &domain.ResetPasswordReq{Token: "raw", Password: "new-password"})
+			assert.ErrorIs(t, err, domain.ErrInvalidResetToken)
+		}
+	})
+
+	t.Run("reject a token spent by a concurrent reset", func(t *testing.T) {
+		svc, d := setup(t)
+		d.resets.On("FindPasswordResetTokenByHash", mock.Anything, token.Hash("raw")).
+			Return(&domain.PasswordResetToken{ID: "reset-1", UserID: "user-1", ExpiresAt: time.Now().Add(time.Minute)}, nil).Once()
+		d.resets.On("ConsumePasswordResetToken", mock.Anything, "reset-1").Return(false, nil).Once()
+
+		err := svc.ResetPassword(context.Background(), &domain.ResetPasswordReq{Token: "raw", Password: "new-password"})
+		assert.ErrorIs(t, err, domain.ErrInvalidResetToken)
+	})
+}
@@ -3,31 +3,81 @@ package service
 import (
 	"blogg/internal/core/domain"
 	"blogg/internal/core/port"
+	"blogg/utils/diff"
+	"blogg/utils/slug"
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const (
+	// publishBatchSize caps how many scheduled posts one transaction claims
+	publishBatchSize = 100
+	// maxSlugLength leaves room for a collision suffix in generated slugs
+	maxSlugLength = 80
+	// maxSlugSuffix bounds the -2, -3... probing before a random suffix is used
+	maxSlugSuffix = 50
+	// createAttempts bounds the inserts of a post whose derived slug keeps
+	// being taken by concurrent creates
+	createAttempts = 3
+)
+
 type PostService struct {
-	postRepo port.PostRepositoryPort
+	postRepo     port.PostRepositoryPort
+	categoryRepo port.CategoryRepositoryPort
+	tagRepo      port.TagRepositoryPort
+	tags         *TagService
+	revisionRepo port.RevisionRepositoryPort
+	search       port.SearchPort
+	userRepo     port.AuthRepositoryPort
+	// requireVerifiedEmail refuses publishing for users who have not verified their email
+	requireVerifiedEmail bool
 }
 
-func NewPostService(postRepo port.PostRepositoryPort) *PostService {
+func NewPostService(postRepo port.PostRepositoryPort, categoryRepo port.CategoryRepositoryPort, tagRepo port.TagRepositoryPort, revisionRepo port.RevisionRepositoryPort, search port.SearchPort, userRepo port.AuthRepositoryPort, requireVerifiedEmail bool) *PostService {
 	return &PostService{
-		postRepo: postRepo,
+		postRepo:             postRepo,
+		categoryRepo:         categoryRepo,
+		tagRepo:              tagRepo,
+		tags:                 NewTagService(tagRepo),
+		revisionRepo:         revisionRepo,
+		search:               search,
+		userRepo:             userRepo,
+		requireVerifiedEmail: requireVerifiedEmail,
 	}
 }
 
-func (s *PostService) CreatePost(ctx context.Context, p *domain.Post, categoryIDs []string) (*domain.Post, error) {
-	// Check if slug already exists
-	existing, err := s.postRepo.FindPostBySlug(ctx, p.Slug)
-	if err != nil && err != sql.ErrNoRows {
+func (s *PostService) CreatePost(ctx context.Context, p *domain.Post, categoryIDs []string, tagNames []string) (*domain.Post, error) {
+	var err error
+	derived := p.Slug == ""
+	if derived {
+		// Derive the slug from the title, stepping past any that are taken
+		p.Slug, err = s.availableSlug(ctx, slug.MakeASCII(p.Title), "")
+	} else {
+		// Check if slug already exists
+		err = s.checkSlugAvailable(ctx, p.Slug, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIDs, err = s.validateCategoryIDs(ctx, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSchedule(p.PublishAt, p.IsPublished); err != nil {
 		return nil, err
 	}
-	if existing != nil {
-		return nil, domain.ErrSlugExists
+	if p.IsPublished || p.PublishAt != nil {
+		if err := s.checkEmailVerified(ctx, p.UserID); err != nil {
+			return nil, err
+		}
 	}
 
 	// Set server-managed fields
@@ -41,8 +91,21 @@ func (s *PostService) CreatePost(ctx context.Context, p *domain.Post, categoryID
 		p.PublishedAt = &now
 	}
 
-	// Create post
+	// Create post. Another create may take the slug between the check and the
+	// insert; a derived slug then moves on to the next free one.
 	err = s.postRepo.CreatePost(ctx, p)
+	for attempt := 1; err == domain.ErrSlugExists && derived && attempt < createAttempts; attempt++ {
+		p.Slug, err = s.availableSlug(ctx, slug.MakeASCII(p.Title), "")
+		if err == nil {
+			err = s.postRepo.CreatePost(ctx, p)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The first revision is the baseline later saves are compared against
+	err = s.recordRevision(ctx, p, p.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -53,11 +116,21 @@ func (s *PostService) CreatePost(ctx context.Context, p *domain.Post, categoryID
 		if err != nil {
 			return nil, err
 		}
-		// Load categories for response
-		categories, _ := s.postRepo.GetPostCategories(ctx, p.ID)
-		p.Categories = categories
 	}
 
+	// Add tags if provided, creating the ones that don't exist yet
+	if len(tagNames) > 0 {
+		err = s.setPostTags(ctx, p.ID, tagNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Load categories and tags for response
+	s.loadRelations(ctx, p)
+
+	s.indexPost(ctx, p)
+
 	return p, nil
 }
 
@@ -73,34 +146,44 @@ func (s *PostService) GetPostByID(ctx context.Context, id string) (*domain.Post,
 		return nil, domain.ErrPostNotFound
 	}
 
-	// Load categories
-	categories, _ := s.postRepo.GetPostCategories(ctx, post.ID)
-	post.Categories = categories
+	// Load categories and tags
+	s.loadRelations(ctx, post)
 
 	return post, nil
 }
 
-func (s *PostService) GetPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
-	post, err := s.postRepo.FindPostBySlug(ctx, slug)
+// GetPublishedPostBySlug returns the post readers see under slug, so drafts
+// and scheduled posts are not found. It also resolves slugs the post had
+// before a rename. The returned post then carries its current slug, which
+// callers can compare to redirect.
+func (s *PostService) GetPublishedPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	post, err := s.postRepo.FindPublishedPostBySlug(ctx, slug)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrPostNotFound
 		}
 		return nil, err
 	}
+	if post == nil {
+		post, err = s.postRepo.FindPublishedPostBySlugHistory(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if post == nil {
 		return nil, domain.ErrPostNotFound
 	}
 
-	// Load categories
-	categories, _ := s.postRepo.GetPostCategories(ctx, post.ID)
-	post.Categories = categories
+	// Load categories and tags
+	s.loadRelations(ctx, post)
 
 	return post, nil
 }
 
-func (s *PostService) UpdatePost(ctx context.Context, id string, userID string, p *domain.Post, categoryIDs *[]string) (*domain.Post, error) {
-	// Get existing post to check ownership
+// UpdatePost applies the set fields of p to a post. A nil publish keeps the
+// post published or unpublished as it is.
+func (s *PostService) UpdatePost(ctx context.Context, id string, actor domain.Actor, p *domain.Post, publish *bool, categoryIDs *[]string, tagNames *[]string) (*domain.Post, error) {
+	// Get existing post to check permissions
 	existingPost, err := s.postRepo.FindPostByID(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -112,20 +195,41 @@ func (s *PostService) UpdatePost(ctx context.Context, id string, userID string,
 		return nil, domain.ErrPostNotFound
 	}
 
-	// Check ownership
-	if existingPost.UserID != userID {
+	if !actor.CanEditPost(existingPost) {
 		return nil, domain.ErrUnauthorized
 	}
 
+	published := existingPost.IsPublished
+	if publish != nil {
+		published = *publish
+	}
+	if (published != existingPost.IsPublished || p.PublishAt != nil) && !actor.CanPublishPost(existingPost) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if err := checkSchedule(p.PublishAt, published); err != nil {
+		return nil, err
+	}
+	if (published && !existingPost.IsPublished) || p.PublishAt != nil {
+		if err := s.checkEmailVerified(ctx, actor.UserID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check slug uniqueness if slug is being updated
-	if p.Slug != "" && p.Slug != existingPost.Slug {
-		existing, err := s.postRepo.FindPostBySlug(ctx, p.Slug)
-		if err != nil && err != sql.ErrNoRows {
+	oldSlug := existingPost.Slug
+	if p.Slug != "" && p.Slug != oldSlug {
+		if err := s.checkSlugAvailable(ctx, p.Slug, id); err != nil {
 			return nil, err
 		}
-		if existing != nil {
-			return nil, domain.ErrSlugExists
+	}
+
+	if categoryIDs != nil {
+		validIDs, err := s.validateCategoryIDs(ctx, *categoryIDs)
+		if err != nil {
+			return nil, err
 		}
+		categoryIDs = &validIDs
 	}
 
 	// Merge updates
@@ -144,9 +248,17 @@ func (s *PostService) UpdatePost(ctx context.Context, id string, userID string,
 	if p.Excerpt != "" {
 		existingPost.Excerpt = p.Excerpt
 	}
-	existingPost.IsPublished = p.IsPublished
+	if p.PublishAt != nil {
+		existingPost.PublishAt = p.PublishAt
+	}
+	existingPost.IsPublished = published
 	existingPost.UpdatedAt = time.Now()
 
+	// Publishing by hand supersedes any schedule
+	if existingPost.IsPublished {
+		existingPost.PublishAt = nil
+	}
+
 	if existingPost.IsPublished && (existingPost.PublishedAt == nil || existingPost.PublishedAt.IsZero()) {
 		now := time.Now()
 		existingPost.PublishedAt = &now
@@ -157,6 +269,19 @@ func (s *PostService) UpdatePost(ctx context.Context, id string, userID string,
 		return nil, err
 	}
 
+	// Keep the old slug around so existing links can be redirected
+	if existingPost.Slug != oldSlug {
+		err = s.postRepo.RecordSlugChange(ctx, id, oldSlug, existingPost.Slug)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.recordRevision(ctx, existingPost, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update categories if provided
 	if categoryIDs != nil {
 		// Remove old categories
@@ -173,15 +298,56 @@ func (s *PostService) UpdatePost(ctx context.Context, id string, userID string,
 		}
 	}
 
-	// Load categories for response
-	categories, _ := s.postRepo.GetPostCategories(ctx, id)
-	existingPost.Categories = categories
+	// Replace tags if provided
+	if tagNames != nil {
+		err = s.postRepo.RemoveTagsFromPost(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(*tagNames) > 0 {
+			err = s.setPostTags(ctx, id, *tagNames)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Load categories and tags for response
+	s.loadRelations(ctx, existingPost)
+
+	s.indexPost(ctx, existingPost)
 
 	return existingPost, nil
 }
 
-func (s *PostService) DeletePost(ctx context.Context, id string, userID string) error {
-	// Get post to check ownership
+// CheckSlug reports whether a slug is free for postID (empty for a new post)
+// and suggests a free, normalized alternative
+func (s *PostService) CheckSlug(ctx context.Context, requested string, postID string) (*domain.SlugAvailability, error) {
+	normalized := slug.MakeASCII(requested)
+
+	available := false
+	if normalized == requested {
+		err := s.checkSlugAvailable(ctx, requested, postID)
+		if err != nil && err != domain.ErrSlugExists {
+			return nil, err
+		}
+		available = err == nil
+	}
+
+	suggestion := requested
+	if !available {
+		var err error
+		suggestion, err = s.availableSlug(ctx, normalized, postID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.SlugAvailability{Slug: requested, Available: available, Suggestion: suggestion}, nil
+}
+
+func (s *PostService) DeletePost(ctx context.Context, id string, actor domain.Actor) error {
+	// Get post to check permissions
 	post, err := s.postRepo.FindPostByID(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -193,40 +359,508 @@ func (s *PostService) DeletePost(ctx context.Context, id string, userID string)
 		return domain.ErrPostNotFound
 	}
 
-	// Check ownership
-	if post.UserID != userID {
+	if !actor.CanDeletePost(post) {
 		return domain.ErrUnauthorized
 	}
 
-	return s.postRepo.DeletePost(ctx, id)
+	err = s.postRepo.DeletePost(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// The post is already gone from the database; a stale index entry is only logged
+	if err := s.search.RemovePost(ctx, id); err != nil {
+		log.Printf("search: failed to remove post %s: %v", id, err)
+	}
+
+	return nil
 }
 
-func (s *PostService) ListPosts(ctx context.Context) ([]*domain.Post, error) {
-	posts, err := s.postRepo.ListPosts(ctx)
+func (s *PostService) ListPosts(ctx context.Context, opts domain.PostListOptions) (*domain.PostPage, error) {
+	opts.Normalize()
+
+	// Unknown tags are reported instead of answering with an empty page
+	if opts.TagSlug != "" {
+		tag, err := s.tagRepo.FindTagBySlug(ctx, opts.TagSlug)
+		if err != nil {
+			return nil, err
+		}
+		if tag == nil {
+			return nil, domain.ErrTagNotFound
+		}
+	}
+
+	page := &domain.PostPage{}
+	if opts.UseCursor {
+		// Fetch one extra row to learn whether another page follows
+		fetch := opts
+		fetch.Limit++
+		posts, err := s.postRepo.ListPosts(ctx, fetch)
+		if err != nil {
+			return nil, err
+		}
+		page.Posts, page.NextCursor = trimPostPage(posts, opts.Limit, func(p *domain.Post) time.Time {
+			// Migration 000019 backfills published_at the same way
+			if p.PublishedAt == nil {
+				return p.CreatedAt
+			}
+			return *p.PublishedAt
+		})
+	} else {
+		total, err := s.postRepo.CountPosts(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		posts, err := s.postRepo.ListPosts(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		page.Posts, page.Total = posts, total
+	}
+
+	// Load categories and tags for each post
+	for _, post := range page.Posts {
+		s.loadRelations(ctx, post)
+	}
+
+	return page, nil
+}
+
+func (s *PostService) ListPostsByUser(ctx context.Context, userID string, opts domain.PostListOptions) (*domain.PostPage, error) {
+	page := &domain.PostPage{}
+	if opts.UseCursor {
+		opts.Normalize()
+		fetch := opts
+		fetch.Limit++
+		posts, err := s.postRepo.FindPostsByUserID(ctx, userID, fetch)
+		if err != nil {
+			return nil, err
+		}
+		page.Posts, page.NextCursor = trimPostPage(posts, opts.Limit, func(p *domain.Post) time.Time {
+			return p.CreatedAt
+		})
+	} else {
+		posts, err := s.postRepo.FindPostsByUserID(ctx, userID, opts)
+		if err != nil {
+			return nil, err
+		}
+		page.Posts, page.Total = posts, len(posts)
+	}
+
+	// Load categories and tags for each post
+	for _, post := range page.Posts {
+		s.loadRelations(ctx, post)
+	}
+
+	return page, nil
+}
+
+func (s *PostService) SearchPosts(ctx context.Context, q domain.PostSearchQuery) ([]domain.PostSearchHit, int, error) {
+	q.Normalize()
+
+	hits, total, err := s.search.SearchPosts(ctx, q)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Load categories and tags for each post
+	for _, hit := range hits {
+		s.loadRelations(ctx, hit.Post)
+	}
+
+	return hits, total, nil
+}
+
+// ListTrash returns the trashed posts the actor may restore or purge: their
+// own, or every one for admins
+func (s *PostService) ListTrash(ctx context.Context, actor domain.Actor) ([]*domain.Post, error) {
+	var posts []*domain.Post
+	var err error
+	if actor.ManagesAllTrash() {
+		posts, err = s.postRepo.FindTrashedPosts(ctx)
+	} else {
+		posts, err = s.postRepo.FindTrashedPostsByUserID(ctx, actor.UserID)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Load categories for each post
+	// Load categories and tags for each post
 	for _, post := range posts {
-		categories, _ := s.postRepo.GetPostCategories(ctx, post.ID)
-		post.Categories = categories
+		s.loadRelations(ctx, post)
 	}
 
 	return posts, nil
 }
 
-func (s *PostService) ListPostsByUser(ctx context.Context, userID string) ([]*domain.Post, error) {
-	posts, err := s.postRepo.FindPostsByUserID(ctx, userID)
+// RestorePost moves a trashed post back into place. It fails if another post
+// has taken its slug in the meantime.
+func (s *PostService) RestorePost(ctx context.Context, id string, actor domain.Actor) (*domain.Post, error) {
+	post, err := s.findTrashedPostForDelete(ctx, id, actor)
 	if err != nil {
 		return nil, err
 	}
 
-	// Load categories for each post
-	for _, post := range posts {
-		categories, _ := s.postRepo.GetPostCategories(ctx, post.ID)
-		post.Categories = categories
+	err = s.checkSlugAvailable(ctx, post.Slug, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return posts, nil
+	err = s.postRepo.RestorePost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	post.DeletedAt = nil
+
+	// Load categories and tags for response
+	s.loadRelations(ctx, post)
+
+	s.indexPost(ctx, post)
+
+	return post, nil
+}
+
+// PurgePost permanently deletes a post that is already in the trash
+func (s *PostService) PurgePost(ctx context.Context, id string, actor domain.Actor) error {
+	if _, err := s.findTrashedPostForDelete(ctx, id, actor); err != nil {
+		return err
+	}
+
+	return s.postRepo.PurgePost(ctx, id)
+}
+
+// PurgeTrash permanently deletes posts that have been in the trash for longer
+// than retention and returns how many were removed
+func (s *PostService) PurgeTrash(ctx context.Context, retention time.Duration) (int, error) {
+	return s.postRepo.PurgeTrashedPosts(ctx, time.Now().Add(-retention))
+}
+
+// TransferPosts hands a user's posts to another user, e.g. before the first
+// user is deleted. Trashed posts leave the search index.
+func (s *PostService) TransferPosts(ctx context.Context, fromUserID string, toUserID string, trash bool) error {
+	if trash {
+		ids, err := s.postRepo.TrashPostsByUserID(ctx, fromUserID)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := s.search.RemovePost(ctx, id); err != nil {
+				log.Printf("search: failed to remove post %s: %v", id, err)
+			}
+		}
+	}
+
+	return s.postRepo.ReassignPosts(ctx, fromUserID, toUserID)
+}
+
+// PublishDuePosts publishes every scheduled post whose publish time has passed
+// and returns how many were published
+func (s *PostService) PublishDuePosts(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		posts, err := s.postRepo.PublishDuePosts(ctx, time.Now(), publishBatchSize)
+		if err != nil {
+			return published, err
+		}
+		for _, post := range posts {
+			s.loadRelations(ctx, post)
+			s.indexPost(ctx, post)
+		}
+		published += len(posts)
+
+		if len(posts) < publishBatchSize {
+			return published, nil
+		}
+	}
+}
+
+func (s *PostService) ListRevisions(ctx context.Context, postID string, actor domain.Actor) ([]domain.PostRevision, error) {
+	if _, err := s.findEditablePost(ctx, postID, actor); err != nil {
+		return nil, err
+	}
+
+	return s.revisionRepo.FindRevisionsByPostID(ctx, postID)
+}
+
+func (s *PostService) GetRevision(ctx context.Context, postID string, revisionID string, actor domain.Actor) (*domain.PostRevision, error) {
+	if _, err := s.findEditablePost(ctx, postID, actor); err != nil {
+		return nil, err
+	}
+
+	return s.findRevision(ctx, postID, revisionID)
+}
+
+func (s *PostService) DiffRevisions(ctx context.Context, postID string, fromID string, toID string, actor domain.Actor) (*domain.PostRevisionDiff, error) {
+	if _, err := s.findEditablePost(ctx, postID, actor); err != nil {
+		return nil, err
+	}
+
+	from, err := s.findRevision(ctx, postID, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.findRevision(ctx, postID, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PostRevisionDiff{
+		FromID:  from.ID,
+		ToID:    to.ID,
+		Title:   diff.Lines(from.Title, to.Title),
+		Excerpt: diff.Lines(from.Excerpt, to.Excerpt),
+		Content: diff.Lines(from.Content, to.Content),
+	}, nil
+}
+
+// RestoreRevision copies a revision's text back onto the post. The restore is
+// recorded as a new revision so it can be undone the same way.
+func (s *PostService) RestoreRevision(ctx context.Context, postID string, revisionID string, actor domain.Actor) (*domain.Post, error) {
+	post, err := s.findEditablePost(ctx, postID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	rev, err := s.findRevision(ctx, postID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	post.Title = rev.Title
+	post.Content = rev.Content
+	post.Excerpt = rev.Excerpt
+	post.UpdatedAt = time.Now()
+
+	err = s.postRepo.UpdatePost(ctx, post)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.recordRevision(ctx, post, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load categories and tags for response
+	s.loadRelations(ctx, post)
+
+	s.indexPost(ctx, post)
+
+	return post, nil
+}
+
+// findEditablePost loads a post and checks that actor may edit it
+func (s *PostService) findEditablePost(ctx context.Context, postID string, actor domain.Actor) (*domain.Post, error) {
+	post, err := s.postRepo.FindPostByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, domain.ErrPostNotFound
+	}
+	if !actor.CanEditPost(post) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return post, nil
+}
+
+func (s *PostService) findTrashedPostForDelete(ctx context.Context, postID string, actor domain.Actor) (*domain.Post, error) {
+	post, err := s.postRepo.FindTrashedPostByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, domain.ErrPostNotFound
+	}
+	if !actor.CanDeletePost(post) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return post, nil
+}
+
+// checkEmailVerified enforces the verified email requirement for publishing,
+// when it is switched on
+func (s *PostService) checkEmailVerified(ctx context.Context, userID string) error {
+	if !s.requireVerifiedEmail {
+		return nil
+	}
+
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrUnauthorized
+		}
+		return err
+	}
+	if user.EmailVerifiedAt == nil {
+		return domain.ErrEmailNotVerified
+	}
+
+	return nil
+}
+
+func (s *PostService) findRevision(ctx context.Context, postID string, revisionID string) (*domain.PostRevision, error) {
+	rev, err := s.revisionRepo.FindRevisionByID(ctx, postID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if rev == nil {
+		return nil, domain.ErrRevisionNotFound
+	}
+
+	return rev, nil
+}
+
+// recordRevision snapshots the post's current text, attributed to authorID
+func (s *PostService) recordRevision(ctx context.Context, p *domain.Post, authorID string) error {
+	return s.revisionRepo.CreateRevision(ctx, &domain.PostRevision{
+		ID:        uuid.NewString(),
+		PostID:    p.ID,
+		AuthorID:  authorID,
+		Title:     p.Title,
+		Content:   p.Content,
+		Excerpt:   p.Excerpt,
+		CreatedAt: time.Now(),
+	})
+}
+
+// validateCategoryIDs removes duplicate IDs and rejects any ID that does not
+// belong to an existing category
+func (s *PostService) validateCategoryIDs(ctx context.Context, categoryIDs []string) ([]string, error) {
+	if len(categoryIDs) == 0 {
+		return categoryIDs, nil
+	}
+
+	seen := make(map[string]bool, len(categoryIDs))
+	unique := make([]string, 0, len(categoryIDs))
+	for _, id := range categoryIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	found, err := s.categoryRepo.FindCategoriesByIDs(ctx, unique)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) != len(unique) {
+		return nil, domain.ErrCategoryNotFound
+	}
+
+	return unique, nil
+}
+
+// setPostTags upserts tags by name and links them to the post
+func (s *PostService) setPostTags(ctx context.Context, postID string, tagNames []string) error {
+	stored, err := s.tags.UpsertTags(ctx, tagNames)
+	if err != nil {
+		return err
+	}
+	if len(stored) == 0 {
+		return nil
+	}
+
+	tagIDs := make([]string, 0, len(stored))
+	for _, t := range stored {
+		tagIDs = append(tagIDs, t.ID)
+	}
+
+	return s.postRepo.AddTagsToPost(ctx, postID, tagIDs)
+}
+
+// checkSlugAvailable rejects a slug that another post uses now or used before
+// a rename. postID is the post asking for the slug, empty for a new post.
+func (s *PostService) checkSlugAvailable(ctx context.Context, slug string, postID string) error {
+	existing, err := s.postRepo.FindPostBySlug(ctx, slug)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if existing != nil && existing.ID != postID {
+		return domain.ErrSlugExists
+	}
+
+	previous, err := s.postRepo.FindPostBySlugHistory(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if previous != nil && previous.ID != postID {
+		return domain.ErrSlugExists
+	}
+
+	return nil
+}
+
+// availableSlug returns base, or base with the first free -2, -3... suffix.
+// An empty base, as left by a title with no ASCII letters, gets a random slug.
+func (s *PostService) availableSlug(ctx context.Context, base string, postID string) (string, error) {
+	base = slug.Truncate(base, maxSlugLength)
+	if base == "" {
+		base = "post-" + uuid.NewString()[:8]
+	}
+
+	candidate := base
+	for n := 2; n <= maxSlugSuffix; n++ {
+		err := s.checkSlugAvailable(ctx, candidate, postID)
+		if err == nil {
+			return candidate, nil
+		}
+		if err != domain.ErrSlugExists {
+			return "", err
+		}
+		candidate = fmt.Sprintf("%s-%d", base, n)
+	}
+
+	return base + "-" + uuid.NewString()[:8], nil
+}
+
+// checkSchedule validates a requested publish time. Scheduling only makes sense
+// for a post that will not be published right away.
+func checkSchedule(publishAt *time.Time, published bool) error {
+	if publishAt == nil {
+		return nil
+	}
+	if published {
+		return domain.ErrPublishAtConflict
+	}
+	if !publishAt.After(time.Now()) {
+		return domain.ErrPublishAtInPast
+	}
+	return nil
+}
+
+// loadRelations attaches categories and tags to a post and derives its status.
+// Lookup failures leave the lists empty rather than failing the request.
+func (s *PostService) loadRelations(ctx context.Context, p *domain.Post) {
+	p.UpdateStatus()
+
+	categories, _ := s.postRepo.GetPostCategories(ctx, p.ID)
+	p.Categories = categories
+
+	tags, _ := s.postRepo.GetPostTags(ctx, p.ID)
+	p.Tags = tags
+}
+
+// indexPost pushes a saved post to the search index. Failures are logged rather
+// than returned because the post itself has already been written.
+func (s *PostService) indexPost(ctx context.Context, p *domain.Post) {
+	if err := s.search.IndexPost(ctx, p); err != nil {
+		log.Printf("search: failed to index post %s: %v", p.ID, err)
+	}
+}
+
+// trimPostPage cuts a keyset result fetched with limit+1 rows back to limit and
+// returns the cursor for the next page, or "" when this is the last page.
+func trimPostPage(posts []*domain.Post, limit int, sortKey func(*domain.Post) time.Time) ([]*domain.Post, string) {
+	if len(posts) <= limit {
+		return posts, ""
+	}
+
+	posts = posts[:limit]
+	last := posts[len(posts)-1]
+	cursor := domain.PostCursor{Time: sortKey(last), ID: last.ID}
+
+	return posts, cursor.Encode()
 }
@@ -0,0 +1,722 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"blogg/utils/diff"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostService_ListPosts(t *testing.T) {
+	type listTest struct {
+		name          string
+		input         domain.PostListOptions
+		setupMock     func(m *mocks.MockPostRepositoryPort)
+		expectError   bool
+		expectedTotal int
+		expectedCount int
+	}
+
+	tests := []listTest{
+		{
+			name:  "apply default paging when none is given",
+			input: domain.PostListOptions{},
+			setupMock: func(m *mocks.MockPostRepositoryPort) {
+				isDefault := mock.MatchedBy(func(o domain.PostListOptions) bool {
+					return o.Page == 1 && o.Limit == domain.DefaultPostListLimit
+				})
+				m.On("CountPosts", mock.Anything, isDefault).Return(1, nil).Once()
+				m.On("ListPosts", mock.Anything, isDefault).Return([]*domain.Post{{ID: "post-1"}}, nil).Once()
+				m.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+				m.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+			},
+			expectedTotal: 1,
+			expectedCount: 1,
+		},
+		{
+			name:  "clamp limit to maximum",
+			input: domain.PostListOptions{Page: 3, Limit: 1000, CategorySlug: "go"},
+			setupMock: func(m *mocks.MockPostRepositoryPort) {
+				isClamped := mock.MatchedBy(func(o domain.PostListOptions) bool {
+					return o.Page == 3 && o.Limit == domain.MaxPostListLimit && o.CategorySlug == "go"
+				})
+				m.On("CountPosts", mock.Anything, isClamped).Return(0, nil).Once()
+				m.On("ListPosts", mock.Anything, isClamped).Return([]*domain.Post{}, nil).Once()
+			},
+			expectedTotal: 0,
+			expectedCount: 0,
+		},
+		{
+			name:  "return error when count fails",
+			input: domain.PostListOptions{Page: 1, Limit: 10},
+			setupMock: func(m *mocks.MockPostRepositoryPort) {
+				m.On("CountPosts", mock.Anything, mock.Anything).Return(0, errors.New("db error")).Once()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockPostRepositoryPort(t)
+			tc.setupMock(mockRepo)
+
+			svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+
+			page, err := svc.ListPosts(context.Background(), tc.input)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTotal, page.Total)
+			assert.Len(t, page.Posts, tc.expectedCount)
+		})
+	}
+}
+
+func TestPostService_ListPosts_Cursor(t *testing.T) {
+	day := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	newPost := func(id string, offset time.Duration) *domain.Post {
+		published := day.Add(-offset)
+		return &domain.Post{ID: id, PublishedAt: &published}
+	}
+
+	t.Run("return next cursor when more posts follow", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("ListPosts", mock.Anything, mock.MatchedBy(func(o domain.PostListOptions) bool {
+			return o.UseCursor && o.Limit == 3
+		})).Return([]*domain.Post{newPost("c", 0), newPost("b", time.Hour), newPost("a", 2*time.Hour)}, nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, mock.Anything).Return([]domain.Category{}, nil).Twice()
+		mockRepo.On("GetPostTags", mock.Anything, mock.Anything).Return([]domain.Tag{}, nil).Twice()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+		page, err := svc.ListPosts(context.Background(), domain.PostListOptions{UseCursor: true, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, page.Posts, 2)
+
+		cursor, err := domain.DecodePostCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "b", cursor.ID)
+		assert.True(t, cursor.Time.Equal(day.Add(-time.Hour)))
+	})
+
+	t.Run("omit next cursor on the last page", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("ListPosts", mock.Anything, mock.Anything).Return([]*domain.Post{newPost("a", 0)}, nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "a").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "a").Return([]domain.Tag{}, nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+		page, err := svc.ListPosts(context.Background(), domain.PostListOptions{UseCursor: true, Limit: 2})
+		require.NoError(t, err)
+		assert.Len(t, page.Posts, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("fall back to created_at for a post without published_at", func(t *testing.T) {
+		legacy := &domain.Post{ID: "b", CreatedAt: day.Add(-time.Hour)}
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("ListPosts", mock.Anything, mock.Anything).Return([]*domain.Post{newPost("c", 0), legacy, newPost("a", 2*time.Hour)}, nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, mock.Anything).Return([]domain.Category{}, nil).Twice()
+		mockRepo.On("GetPostTags", mock.Anything, mock.Anything).Return([]domain.Tag{}, nil).Twice()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+		page, err := svc.ListPosts(context.Background(), domain.PostListOptions{UseCursor: true, Limit: 2})
+		require.NoError(t, err)
+
+		cursor, err := domain.DecodePostCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "b", cursor.ID)
+		assert.True(t, cursor.Time.Equal(legacy.CreatedAt))
+	})
+
+	t.Run("reject malformed cursor", func(t *testing.T) {
+		_, err := domain.DecodePostCursor("not-a-cursor")
+		assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+	})
+}
+
+func TestPostService_CreatePost_UnknownCategory(t *testing.T) {
+	mockRepo := mocks.NewMockPostRepositoryPort(t)
+	mockCategoryRepo := mocks.NewMockCategoryRepositoryPort(t)
+	mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+	mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+	mockCategoryRepo.On("FindCategoriesByIDs", mock.Anything, []string{"cat-1", "cat-2"}).
+		Return([]domain.Category{{ID: "cat-1"}}, nil).Once()
+
+	svc := service.NewPostService(mockRepo, mockCategoryRepo, mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+
+	_, err := svc.CreatePost(context.Background(), &domain.Post{Slug: "hello"}, []string{"cat-1", "cat-2", "cat-1"}, nil)
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+}
+
+func TestPostService_CreatePost_NormalizesTags(t *testing.T) {
+	mockRepo := mocks.NewMockPostRepositoryPort(t)
+	mockTagRepo := mocks.NewMockTagRepositoryPort(t)
+	mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+	mockSearch := mocks.NewMockSearchPort(t)
+
+	mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+	mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+	mockRepo.On("CreatePost", mock.Anything, mock.Anything).Return(nil).Once()
+	mockRevisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil).Once()
+	mockTagRepo.On("UpsertTags", mock.Anything, mock.MatchedBy(func(tags []domain.Tag) bool {
+		return len(tags) == 2 && tags[0].Slug == "go-lang" && tags[0].Name == "Go Lang" && tags[1].Slug == "web"
+	})).Return([]domain.Tag{{ID: "tag-1"}, {ID: "tag-2"}}, nil).Once()
+	mockRepo.On("AddTagsToPost", mock.Anything, mock.Anything, []string{"tag-1", "tag-2"}).Return(nil).Once()
+	mockRepo.On("GetPostCategories", mock.Anything, mock.Anything).Return([]domain.Category{}, nil).Once()
+	mockRepo.On("GetPostTags", mock.Anything, mock.Anything).Return([]domain.Tag{}, nil).Once()
+	mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+	svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mockTagRepo, mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+
+	_, err := svc.CreatePost(context.Background(), &domain.Post{Slug: "hello"}, nil, []string{"  Go   Lang ", "web", "go-lang", "Web", "!!"})
+	require.NoError(t, err)
+}
+
+func TestPostService_CreatePost_SlugRace(t *testing.T) {
+	newService := func(m *mocks.MockPostRepositoryPort) *service.PostService {
+		return service.NewPostService(m, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+	}
+
+	t.Run("move a derived slug on when a concurrent create took it", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		// Free when first checked, taken by the time of the insert
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("CreatePost", mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+			return p.Slug == "hello"
+		})).Return(domain.ErrSlugExists).Once()
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return(&domain.Post{ID: "post-2"}, nil).Once()
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello-2").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello-2").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("CreatePost", mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+			return p.Slug == "hello-2"
+		})).Return(nil).Once()
+		mockRevisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, mock.Anything).Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, mock.Anything).Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+		post, err := svc.CreatePost(context.Background(), &domain.Post{Title: "Hello"}, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "hello-2", post.Slug)
+	})
+
+	t.Run("report a chosen slug taken by a concurrent create", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("CreatePost", mock.Anything, mock.Anything).Return(domain.ErrSlugExists).Once()
+
+		_, err := newService(mockRepo).CreatePost(context.Background(), &domain.Post{Title: "Hello", Slug: "hello"}, nil, nil)
+		assert.ErrorIs(t, err, domain.ErrSlugExists)
+	})
+}
+
+func TestPostService_Revisions(t *testing.T) {
+	post := func() *domain.Post {
+		return &domain.Post{ID: "post-1", UserID: "user-1", Title: "Old title", Content: "line one\nline two", Excerpt: "old"}
+	}
+
+	t.Run("reject revisions of another user's post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(post(), nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+		_, err := svc.ListRevisions(context.Background(), "post-1", domain.Actor{UserID: "user-2", Role: domain.RoleUser})
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("diff content line by line", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(post(), nil).Once()
+		mockRevisionRepo.On("FindRevisionByID", mock.Anything, "post-1", "rev-1").
+			Return(&domain.PostRevision{ID: "rev-1", Title: "T", Content: "a\nb\nc"}, nil).Once()
+		mockRevisionRepo.On("FindRevisionByID", mock.Anything, "post-1", "rev-2").
+			Return(&domain.PostRevision{ID: "rev-2", Title: "T", Content: "a\nc\nd"}, nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+		d, err := svc.DiffRevisions(context.Background(), "post-1", "rev-1", "rev-2", domain.Actor{UserID: "user-1", Role: domain.RoleUser})
+		require.NoError(t, err)
+		assert.Equal(t, []diff.Line{
+			{Op: diff.OpEqual, Text: "a"},
+			{Op: diff.OpDelete, Text: "b"},
+			{Op: diff.OpEqual, Text: "c"},
+			{Op: diff.OpInsert, Text: "d"},
+		}, d.Content)
+		assert.Equal(t, []diff.Line{{Op: diff.OpEqual, Text: "T"}}, d.Title)
+	})
+
+	t.Run("return not found for a revision of another post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(post(), nil).Once()
+		mockRevisionRepo.On("FindRevisionByID", mock.Anything, "post-1", "rev-9").Return((*domain.PostRevision)(nil), nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+		_, err := svc.GetRevision(context.Background(), "post-1", "rev-9", domain.Actor{UserID: "user-1", Role: domain.RoleUser})
+		assert.ErrorIs(t, err, domain.ErrRevisionNotFound)
+	})
+
+	t.Run("restore copies the revision and records a new one", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(post(), nil).Once()
+		mockRevisionRepo.On("FindRevisionByID", mock.Anything, "post-1", "rev-1").
+			Return(&domain.PostRevision{ID: "rev-1", PostID: "post-1", Title: "First title", Content: "first", Excerpt: ""}, nil).Once()
+		mockRepo.On("UpdatePost", mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+			return p.Title == "First title" && p.Content == "first" && p.Excerpt == ""
+		})).Return(nil).Once()
+		mockRevisionRepo.On("CreateRevision", mock.Anything, mock.MatchedBy(func(r *domain.PostRevision) bool {
+			return r.ID != "rev-1" && r.PostID == "post-1" && r.AuthorID == "user-1" && r.Title == "First title"
+		})).Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+		restored, err := svc.RestoreRevision(context.Background(), "post-1", "rev-1", domain.Actor{UserID: "user-1", Role: domain.RoleUser})
+		require.NoError(t, err)
+		assert.Equal(t, "First title", restored.Title)
+	})
+}
+
+func TestPostService_CreatePost_Schedule(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		post        *domain.Post
+		expectedErr error
+	}{
+		{
+			name:        "reject publish time in the past",
+			post:        &domain.Post{Slug: "hello", PublishAt: &past},
+			expectedErr: domain.ErrPublishAtInPast,
+		},
+		{
+			name:        "reject scheduling a post that is published now",
+			post:        &domain.Post{Slug: "hello", IsPublished: true, PublishAt: &future},
+			expectedErr: domain.ErrPublishAtConflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockPostRepositoryPort(t)
+			mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+			mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+
+			svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+			_, err := svc.CreatePost(context.Background(), tc.post, nil, nil)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+
+	t.Run("schedule a draft with a future publish time", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("CreatePost", mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+			return !p.IsPublished && p.PublishedAt == nil && p.PublishAt.Equal(future)
+		})).Return(nil).Once()
+		mockRevisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, mock.Anything).Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, mock.Anything).Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+		created, err := svc.CreatePost(context.Background(), &domain.Post{Slug: "hello", PublishAt: &future}, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, domain.PostStatusScheduled, created.Status)
+	})
+}
+
+func TestPostService_PublishDuePosts(t *testing.T) {
+	mockRepo := mocks.NewMockPostRepositoryPort(t)
+	mockSearch := mocks.NewMockSearchPort(t)
+
+	// A full batch means more may be due, so the service asks again
+	full := make([]*domain.Post, 100)
+	for i := range full {
+		full[i] = &domain.Post{ID: "post", IsPublished: true}
+	}
+	mockRepo.On("PublishDuePosts", mock.Anything, mock.Anything, 100).Return(full, nil).Once()
+	mockRepo.On("PublishDuePosts", mock.Anything, mock.Anything, 100).Return([]*domain.Post{{ID: "last", IsPublished: true}}, nil).Once()
+	mockRepo.On("GetPostCategories", mock.Anything, mock.Anything).Return([]domain.Category{}, nil).Times(101)
+	mockRepo.On("GetPostTags", mock.Anything, mock.Anything).Return([]domain.Tag{}, nil).Times(101)
+	mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Times(101)
+
+	svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+	published, err := svc.PublishDuePosts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 101, published)
+}
+
+func TestPostService_Trash(t *testing.T) {
+	deletedAt := time.Now().Add(-time.Hour)
+	trashed := func() *domain.Post {
+		return &domain.Post{ID: "post-1", UserID: "user-1", Slug: "hello", DeletedAt: &deletedAt}
+	}
+	newService := func(m *mocks.MockPostRepositoryPort, search *mocks.MockSearchPort) *service.PostService {
+		return service.NewPostService(m, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), search, mocks.NewMockAuthRepositoryPort(t), false)
+	}
+	owner := domain.Actor{UserID: "user-1", Role: domain.RoleUser}
+	admin := domain.Actor{UserID: "admin-1", Role: domain.RoleAdmin}
+
+	t.Run("refuse to restore when the slug was reused", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindTrashedPostByID", mock.Anything, "post-1").Return(trashed(), nil).Once()
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return(&domain.Post{ID: "post-2"}, nil).Once()
+
+		_, err := newService(mockRepo, mocks.NewMockSearchPort(t)).RestorePost(context.Background(), "post-1", owner)
+		assert.ErrorIs(t, err, domain.ErrSlugExists)
+	})
+
+	t.Run("restore a trashed post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindTrashedPostByID", mock.Anything, "post-1").Return(trashed(), nil).Once()
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("RestorePost", mock.Anything, "post-1").Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		post, err := newService(mockRepo, mockSearch).RestorePost(context.Background(), "post-1", owner)
+		require.NoError(t, err)
+		assert.Nil(t, post.DeletedAt)
+	})
+
+	t.Run("only purge posts that are in the trash", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindTrashedPostByID", mock.Anything, "post-1").Return((*domain.Post)(nil), nil).Once()
+
+		err := newService(mockRepo, mocks.NewMockSearchPort(t)).PurgePost(context.Background(), "post-1", owner)
+		assert.ErrorIs(t, err, domain.ErrPostNotFound)
+	})
+
+	t.Run("reject purging another user's post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindTrashedPostByID", mock.Anything, "post-1").Return(trashed(), nil).Once()
+
+		err := newService(mockRepo, mocks.NewMockSearchPort(t)).PurgePost(context.Background(), "post-1", domain.Actor{UserID: "user-2", Role: domain.RoleUser})
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("reject restoring another user's post as an editor", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindTrashedPostByID", mock.Anything, "post-1").Return(trashed(), nil).Once()
+
+		_, err := newService(mockRepo, mocks.NewMockSearchPort(t)).RestorePost(context.Background(), "post-1", domain.Actor{UserID: "editor-1", Role: domain.RoleEditor})
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("admins restore and purge other users' posts", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindTrashedPostByID", mock.Anything, "post-1").Return(trashed(), nil).Twice()
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("RestorePost", mock.Anything, "post-1").Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.On("PurgePost", mock.Anything, "post-1").Return(nil).Once()
+
+		svc := newService(mockRepo, mockSearch)
+		_, err := svc.RestorePost(context.Background(), "post-1", admin)
+		require.NoError(t, err)
+		require.NoError(t, svc.PurgePost(context.Background(), "post-1", admin))
+	})
+
+	t.Run("list only the user's own trash", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindTrashedPostsByUserID", mock.Anything, "user-1").Return([]*domain.Post{}, nil).Once()
+
+		_, err := newService(mockRepo, mocks.NewMockSearchPort(t)).ListTrash(context.Background(), owner)
+		require.NoError(t, err)
+	})
+
+	t.Run("list every trashed post for admins", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindTrashedPosts", mock.Anything).Return([]*domain.Post{trashed()}, nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+
+		posts, err := newService(mockRepo, mocks.NewMockSearchPort(t)).ListTrash(context.Background(), admin)
+		require.NoError(t, err)
+		assert.Len(t, posts, 1)
+	})
+
+	t.Run("purge posts older than the retention period", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		cutoff := time.Now().Add(-30 * 24 * time.Hour)
+		mockRepo.On("PurgeTrashedPosts", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+			return before.Sub(cutoff).Abs() < time.Minute
+		})).Return(3, nil).Once()
+
+		purged, err := newService(mockRepo, mocks.NewMockSearchPort(t)).PurgeTrash(context.Background(), 30*24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 3, purged)
+	})
+}
+
+func TestPostService_SlugHistory(t *testing.T) {
+	newService := func(m *mocks.MockPostRepositoryPort) *service.PostService {
+		return service.NewPostService(m, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+	}
+
+	t.Run("resolve a former slug to the renamed post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindPublishedPostBySlug", mock.Anything, "old-slug").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPublishedPostBySlugHistory", mock.Anything, "old-slug").Return(&domain.Post{ID: "post-1", Slug: "new-slug"}, nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+
+		post, err := newService(mockRepo).GetPublishedPostBySlug(context.Background(), "old-slug")
+		require.NoError(t, err)
+		assert.Equal(t, "new-slug", post.Slug)
+	})
+
+	t.Run("reject a slug from another post's history", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindPostBySlug", mock.Anything, "old-slug").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "old-slug").Return(&domain.Post{ID: "post-1"}, nil).Once()
+
+		_, err := newService(mockRepo).CreatePost(context.Background(), &domain.Post{Slug: "old-slug"}, nil, nil)
+		assert.ErrorIs(t, err, domain.ErrSlugExists)
+	})
+
+	t.Run("record the old slug when a post is renamed", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(&domain.Post{ID: "post-1", UserID: "user-1", Slug: "new-slug"}, nil).Once()
+		// Taking back a slug from the post's own history is allowed
+		mockRepo.On("FindPostBySlug", mock.Anything, "old-slug").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "old-slug").Return(&domain.Post{ID: "post-1"}, nil).Once()
+		mockRepo.On("UpdatePost", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.On("RecordSlugChange", mock.Anything, "post-1", "new-slug", "old-slug").Return(nil).Once()
+		mockRevisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+		post, err := svc.UpdatePost(context.Background(), "post-1", domain.Actor{UserID: "user-1", Role: domain.RoleUser}, &domain.Post{Slug: "old-slug"}, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "old-slug", post.Slug)
+	})
+
+	t.Run("keep a published post published when publish is not set", func(t *testing.T) {
+		publishedAt := time.Now().Add(-time.Hour)
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(&domain.Post{ID: "post-1", UserID: "user-1", Slug: "hello", IsPublished: true, PublishedAt: &publishedAt}, nil).Once()
+		mockRepo.On("UpdatePost", mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+			return p.IsPublished && p.Title == "Renamed" && p.PublishedAt.Equal(publishedAt)
+		})).Return(nil).Once()
+		mockRevisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+		post, err := svc.UpdatePost(context.Background(), "post-1", domain.Actor{UserID: "user-1", Role: domain.RoleUser}, &domain.Post{Title: "Renamed"}, nil, nil, nil)
+		require.NoError(t, err)
+		assert.True(t, post.IsPublished)
+	})
+
+	t.Run("unpublish when publish is false", func(t *testing.T) {
+		publishedAt := time.Now().Add(-time.Hour)
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(&domain.Post{ID: "post-1", UserID: "user-1", Slug: "hello", IsPublished: true, PublishedAt: &publishedAt}, nil).Once()
+		mockRepo.On("UpdatePost", mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+			return !p.IsPublished
+		})).Return(nil).Once()
+		mockRevisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		publish := false
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+		post, err := svc.UpdatePost(context.Background(), "post-1", domain.Actor{UserID: "user-1", Role: domain.RoleUser}, &domain.Post{}, &publish, nil, nil)
+		require.NoError(t, err)
+		assert.False(t, post.IsPublished)
+	})
+}
+
+func TestPostService_Policy(t *testing.T) {
+	owner := domain.Actor{UserID: "user-1", Role: domain.RoleUser}
+	other := domain.Actor{UserID: "user-2", Role: domain.RoleUser}
+	editor := domain.Actor{UserID: "editor-1", Role: domain.RoleEditor}
+	admin := domain.Actor{UserID: "admin-1", Role: domain.RoleAdmin}
+	post := func() *domain.Post {
+		return &domain.Post{ID: "post-1", UserID: owner.UserID, Slug: "hello"}
+	}
+	publish := true
+
+	t.Run("let an editor publish another user's post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(post(), nil).Once()
+		mockRepo.On("UpdatePost", mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+			return p.IsPublished && p.UserID == owner.UserID
+		})).Return(nil).Once()
+		mockRevisionRepo.On("CreateRevision", mock.Anything, mock.MatchedBy(func(r *domain.PostRevision) bool {
+			return r.AuthorID == editor.UserID
+		})).Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, "post-1").Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, "post-1").Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+		updated, err := svc.UpdatePost(context.Background(), "post-1", editor, &domain.Post{}, &publish, nil, nil)
+		require.NoError(t, err)
+		assert.True(t, updated.IsPublished)
+	})
+
+	t.Run("reject a user editing another user's post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(post(), nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+		_, err := svc.UpdatePost(context.Background(), "post-1", other, &domain.Post{Title: "Mine now"}, nil, nil, nil)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("reject an editor deleting another user's post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(post(), nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+		err := svc.DeletePost(context.Background(), "post-1", editor)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("let an admin delete another user's post", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(post(), nil).Once()
+		mockRepo.On("DeletePost", mock.Anything, "post-1").Return(nil).Once()
+		mockSearch.On("RemovePost", mock.Anything, "post-1").Return(nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mockSearch, mocks.NewMockAuthRepositoryPort(t), false)
+		require.NoError(t, svc.DeletePost(context.Background(), "post-1", admin))
+	})
+}
+
+func TestPostService_RequireVerifiedEmail(t *testing.T) {
+	t.Run("refuse to publish for an unverified author", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockUsers := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockUsers.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1"}, nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mockUsers, true)
+		_, err := svc.CreatePost(context.Background(), &domain.Post{UserID: "user-1", Slug: "hello", IsPublished: true}, nil, nil)
+		assert.ErrorIs(t, err, domain.ErrEmailNotVerified)
+	})
+
+	t.Run("refuse to publish a draft for an unverified editor", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockUsers := mocks.NewMockAuthRepositoryPort(t)
+		mockRepo.On("FindPostByID", mock.Anything, "post-1").Return(&domain.Post{ID: "post-1", UserID: "user-1"}, nil).Once()
+		mockUsers.On("FindUserByID", mock.Anything, "editor-1").Return(&domain.User{ID: "editor-1"}, nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mockUsers, true)
+		editor := domain.Actor{UserID: "editor-1", Role: domain.RoleEditor}
+		publish := true
+		_, err := svc.UpdatePost(context.Background(), "post-1", editor, &domain.Post{}, &publish, nil, nil)
+		assert.ErrorIs(t, err, domain.ErrEmailNotVerified)
+	})
+
+	t.Run("allow drafts for an unverified author", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRevisionRepo := mocks.NewMockRevisionRepositoryPort(t)
+		mockSearch := mocks.NewMockSearchPort(t)
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("CreatePost", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRevisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil).Once()
+		mockRepo.On("GetPostCategories", mock.Anything, mock.Anything).Return([]domain.Category{}, nil).Once()
+		mockRepo.On("GetPostTags", mock.Anything, mock.Anything).Return([]domain.Tag{}, nil).Once()
+		mockSearch.On("IndexPost", mock.Anything, mock.Anything).Return(nil).Once()
+
+		svc := service.NewPostService(mockRepo, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mockRevisionRepo, mockSearch, mocks.NewMockAuthRepositoryPort(t), true)
+		_, err := svc.CreatePost(context.Background(), &domain.Post{UserID: "user-1", Slug: "hello"}, nil, nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestPostService_GeneratedSlugs(t *testing.T) {
+	newService := func(m *mocks.MockPostRepositoryPort) *service.PostService {
+		return service.NewPostService(m, mocks.NewMockCategoryRepositoryPort(t), mocks.NewMockTagRepositoryPort(t), mocks.NewMockRevisionRepositoryPort(t), mocks.NewMockSearchPort(t), mocks.NewMockAuthRepositoryPort(t), false)
+	}
+	free := func(m *mocks.MockPostRepositoryPort, s string) {
+		m.On("FindPostBySlug", mock.Anything, s).Return((*domain.Post)(nil), nil).Once()
+		m.On("FindPostBySlugHistory", mock.Anything, s).Return((*domain.Post)(nil), nil).Once()
+	}
+
+	t.Run("suggest the next free suffix for a taken slug", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello-world").Return(&domain.Post{ID: "post-1"}, nil).Twice()
+		mockRepo.On("FindPostBySlug", mock.Anything, "hello-world-2").Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, "hello-world-2").Return(&domain.Post{ID: "post-2"}, nil).Once()
+		free(mockRepo, "hello-world-3")
+
+		result, err := newService(mockRepo).CheckSlug(context.Background(), "hello-world", "")
+		require.NoError(t, err)
+		assert.False(t, result.Available)
+		assert.Equal(t, "hello-world-3", result.Suggestion)
+	})
+
+	t.Run("normalize a slug that is not valid as typed", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		free(mockRepo, "creme-brulee")
+
+		result, err := newService(mockRepo).CheckSlug(context.Background(), "Crème Brûlée", "")
+		require.NoError(t, err)
+		assert.False(t, result.Available)
+		assert.Equal(t, "creme-brulee", result.Suggestion)
+	})
+
+	t.Run("fall back to a random slug for a title without ASCII letters", func(t *testing.T) {
+		mockRepo := mocks.NewMockPostRepositoryPort(t)
+		mockRepo.On("FindPostBySlug", mock.Anything, mock.Anything).Return((*domain.Post)(nil), nil).Once()
+		mockRepo.On("FindPostBySlugHistory", mock.Anything, mock.Anything).Return((*domain.Post)(nil), nil).Once()
+
+		result, err := newService(mockRepo).CheckSlug(context.Background(), "สวัสดี", "")
+		require.NoError(t, err)
+		assert.Regexp(t, `^post-[0-9a-f]{8}$`, result.Suggestion)
+	})
+}
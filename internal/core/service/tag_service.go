@@ -0,0 +1,47 @@
+package service
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"blogg/utils/slug"
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type TagService struct {
+	tagRepo port.TagRepositoryPort
+}
+
+func NewTagService(tagRepo port.TagRepositoryPort) *TagService {
+	return &TagService{
+		tagRepo: tagRepo,
+	}
+}
+
+func (s *TagService) ListTags(ctx context.Context) ([]domain.TagSummary, error) {
+	return s.tagRepo.ListTagSummaries(ctx)
+}
+
+// UpsertTags stores the tags named by names and returns them. Names that
+// produce the same slug, such as "Go" and "go", are treated as one tag named
+// as first given; names without a slug are skipped.
+func (s *TagService) UpsertTags(ctx context.Context, names []string) ([]domain.Tag, error) {
+	seen := make(map[string]bool, len(names))
+	tags := make([]domain.Tag, 0, len(names))
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		tagSlug := slug.Make(name)
+		if tagSlug == "" || seen[tagSlug] {
+			continue
+		}
+		seen[tagSlug] = true
+		tags = append(tags, domain.Tag{ID: uuid.NewString(), Name: name, Slug: tagSlug})
+	}
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	return s.tagRepo.UpsertTags(ctx, tags)
+}
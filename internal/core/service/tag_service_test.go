@@ -0,0 +1,105 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagService_UpsertTags(t *testing.T) {
+	type upsertTest struct {
+		name      string
+		names     []string
+		wantNames []string
+		wantSlugs []string
+	}
+
+	tests := []upsertTest{
+		{
+			name:      "fold names that differ only in case into one tag",
+			names:     []string{"Go", "go", "GO"},
+			wantNames: []string{"Go"},
+			wantSlugs: []string{"go"},
+		},
+		{
+			name:      "keep the first of duplicate names",
+			names:     []string{"web", "Go Lang", "go-lang", "web"},
+			wantNames: []string{"web", "Go Lang"},
+			wantSlugs: []string{"web", "go-lang"},
+		},
+		{
+			name:      "collapse whitespace in names",
+			names:     []string{"  Go \t  Lang  "},
+			wantNames: []string{"Go Lang"},
+			wantSlugs: []string{"go-lang"},
+		},
+		{
+			name:      "skip names without a slug",
+			names:     []string{"", "   ", "!!", "web"},
+			wantNames: []string{"web"},
+			wantSlugs: []string{"web"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTagRepo := mocks.NewMockTagRepositoryPort(t)
+			mockTagRepo.On("UpsertTags", mock.Anything, mock.Anything).
+				Return(func(_ context.Context, tags []domain.Tag) ([]domain.Tag, error) {
+					return tags, nil
+				}).Once()
+
+			tags, err := service.NewTagService(mockTagRepo).UpsertTags(context.Background(), tc.names)
+			require.NoError(t, err)
+
+			var names, slugs []string
+			for _, tag := range tags {
+				assert.NotEmpty(t, tag.ID)
+				names = append(names, tag.Name)
+				slugs = append(slugs, tag.Slug)
+			}
+			assert.Equal(t, tc.wantNames, names)
+			assert.Equal(t, tc.wantSlugs, slugs)
+		})
+	}
+
+	t.Run("store nothing when every name is empty", func(t *testing.T) {
+		tags, err := service.NewTagService(mocks.NewMockTagRepositoryPort(t)).UpsertTags(context.Background(), []string{"", " ", "?"})
+		require.NoError(t, err)
+		assert.Empty(t, tags)
+	})
+
+	t.Run("store nothing for no names", func(t *testing.T) {
+		tags, err := service.NewTagService(mocks.NewMockTagRepositoryPort(t)).UpsertTags(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, tags)
+	})
+
+	t.Run("return repository errors", func(t *testing.T) {
+		mockTagRepo := mocks.NewMockTagRepositoryPort(t)
+		mockTagRepo.On("UpsertTags", mock.Anything, mock.Anything).Return(([]domain.Tag)(nil), errors.New("db down")).Once()
+
+		_, err := service.NewTagService(mockTagRepo).UpsertTags(context.Background(), []string{"go"})
+		assert.EqualError(t, err, "db down")
+	})
+}
+
+func TestTagService_ListTags(t *testing.T) {
+	mockTagRepo := mocks.NewMockTagRepositoryPort(t)
+	mockTagRepo.On("ListTagSummaries", mock.Anything).
+		Return([]domain.TagSummary{{Tag: domain.Tag{ID: "tag-1", Name: "Go", Slug: "go"}, PostCount: 3}}, nil).Once()
+
+	tags, err := service.NewTagService(mockTagRepo).ListTags(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, 3, tags[0].PostCount)
+}
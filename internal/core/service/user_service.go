@@ -0,0 +1,171 @@
+package service
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/port"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// UserService backs the admin user management API
+type UserService struct {
+	userRepo    port.AuthRepositoryPort
+	authService port.AuthServicePort
+	postService port.PostServicePort
+}
+
+func NewUserService(userRepo port.AuthRepositoryPort, authService port.AuthServicePort, postService port.PostServicePort) *UserService {
+	return &UserService{
+		userRepo:    userRepo,
+		authService: authService,
+		postService: postService,
+	}
+}
+
+func (s *UserService) ListUsers(ctx context.Context, opts domain.UserListOptions) (*domain.UserPage, error) {
+	opts.Normalize()
+
+	users, total, err := s.userRepo.ListUsers(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserPage{Users: users, Total: total}, nil
+}
+
+func (s *UserService) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	return s.findUser(ctx, userID)
+}
+
+// ChangeRole sets the user's role. Their access tokens are expired so that the
+// new role takes effect on the next refresh rather than when they run out.
+func (s *UserService) ChangeRole(ctx context.Context, actor domain.Actor, userID string, role string) (*domain.User, error) {
+	if !domain.IsValidRole(role) {
+		return nil, domain.ErrInvalidRole
+	}
+	if userID == actor.UserID {
+		return nil, domain.ErrCannotModifySelf
+	}
+
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == role {
+		return user, nil
+	}
+
+	if err := s.userRepo.UpdateUserRole(ctx, userID, role); err != nil {
+		return nil, err
+	}
+	if err := s.authService.ExpireAccessTokens(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	user.Role = role
+	return user, nil
+}
+
+// SuspendUser blocks the user from logging in and revokes all of their tokens
+func (s *UserService) SuspendUser(ctx context.Context, actor domain.Actor, userID string) (*domain.User, error) {
+	if userID == actor.UserID {
+		return nil, domain.ErrCannotModifySelf
+	}
+
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.SuspendedAt != nil {
+		return nil, domain.ErrAlreadySuspended
+	}
+
+	now := time.Now()
+	if err := s.userRepo.SetUserSuspended(ctx, userID, &now); err != nil {
+		return nil, err
+	}
+	if err := s.authService.LogoutEverywhere(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	user.SuspendedAt = &now
+	return user, nil
+}
+
+func (s *UserService) UnsuspendUser(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.SuspendedAt == nil {
+		return nil, domain.ErrNotSuspended
+	}
+
+	if err := s.userRepo.SetUserSuspended(ctx, userID, nil); err != nil {
+		return nil, err
+	}
+
+	user.SuspendedAt = nil
+	return user, nil
+}
+
+// DeleteUser removes the user after handing their posts to req.ReassignTo, or
+// to the acting admin's trash. Sessions are ended first: the user-wide token
+// revocation is deleted along with the user, session revocations are not.
+func (s *UserService) DeleteUser(ctx context.Context, actor domain.Actor, userID string, req domain.DeleteUserReq) error {
+	if userID == actor.UserID {
+		return domain.ErrCannotModifySelf
+	}
+	if _, err := s.findUser(ctx, userID); err != nil {
+		return err
+	}
+
+	var newOwnerID string
+	switch req.Posts {
+	case domain.PostsReassign:
+		if req.ReassignTo == "" || req.ReassignTo == userID {
+			return domain.ErrInvalidReassignee
+		}
+		if _, err := s.findUser(ctx, req.ReassignTo); err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				return domain.ErrInvalidReassignee
+			}
+			return err
+		}
+		newOwnerID = req.ReassignTo
+	case domain.PostsTrash:
+		newOwnerID = actor.UserID
+	default:
+		return domain.ErrInvalidDisposition
+	}
+
+	sessions, err := s.authService.ListSessions(ctx, userID, "")
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err := s.authService.RevokeSession(ctx, userID, session.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.postService.TransferPosts(ctx, userID, newOwnerID, req.Posts == domain.PostsTrash); err != nil {
+		return err
+	}
+
+	return s.userRepo.DeleteUser(ctx, userID)
+}
+
+func (s *UserService) findUser(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := s.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,130 @@
+//go:build unit
+
+package service_test
+
+import (
+	"blogg/internal/core/domain"
+	"blogg/internal/core/service"
+	"blogg/mocks"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService(t *testing.T) {
+	admin := domain.Actor{UserID: "admin-1", Role: domain.RoleAdmin}
+
+	type deps struct {
+		users *mocks.MockAuthRepositoryPort
+		auth  *mocks.MockAuthServicePort
+		posts *mocks.MockPostServicePort
+	}
+	setup := func(t *testing.T) (*service.UserService, deps) {
+		d := deps{
+			users: mocks.NewMockAuthRepositoryPort(t),
+			auth:  mocks.NewMockAuthServicePort(t),
+			posts: mocks.NewMockPostServicePort(t),
+		}
+		return service.NewUserService(d.users, d.auth, d.posts), d
+	}
+
+	t.Run("normalize paging before listing", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("ListUsers", mock.Anything, domain.UserListOptions{Query: "jo", Page: 1, Limit: domain.DefaultUserListLimit}).
+			Return([]domain.User{{ID: "user-1"}}, 1, nil).Once()
+
+		page, err := svc.ListUsers(context.Background(), domain.UserListOptions{Query: "jo"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, page.Total)
+	})
+
+	t.Run("report a missing user", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-9").Return((*domain.User)(nil), sql.ErrNoRows).Once()
+
+		_, err := svc.GetUser(context.Background(), "user-9")
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+
+	t.Run("change a role and expire the user's access tokens", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1", Role: domain.RoleUser}, nil).Once()
+		d.users.On("UpdateUserRole", mock.Anything, "user-1", domain.RoleEditor).Return(nil).Once()
+		d.auth.On("ExpireAccessTokens", mock.Anything, "user-1").Return(nil).Once()
+
+		user, err := svc.ChangeRole(context.Background(), admin, "user-1", domain.RoleEditor)
+		require.NoError(t, err)
+		assert.Equal(t, domain.RoleEditor, user.Role)
+	})
+
+	t.Run("refuse to change the acting admin", func(t *testing.T) {
+		svc, _ := setup(t)
+
+		_, err := svc.ChangeRole(context.Background(), admin, admin.UserID, domain.RoleUser)
+		assert.ErrorIs(t, err, domain.ErrCannotModifySelf)
+		_, err = svc.SuspendUser(context.Background(), admin, admin.UserID)
+		assert.ErrorIs(t, err, domain.ErrCannotModifySelf)
+		err = svc.DeleteUser(context.Background(), admin, admin.UserID, domain.DeleteUserReq{Posts: domain.PostsTrash})
+		assert.ErrorIs(t, err, domain.ErrCannotModifySelf)
+	})
+
+	t.Run("suspend a user and log them out everywhere", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1"}, nil).Once()
+		d.users.On("SetUserSuspended", mock.Anything, "user-1", mock.MatchedBy(func(at *time.Time) bool { return at != nil })).Return(nil).Once()
+		d.auth.On("LogoutEverywhere", mock.Anything, "user-1").Return(nil).Once()
+
+		user, err := svc.SuspendUser(context.Background(), admin, "user-1")
+		require.NoError(t, err)
+		assert.NotNil(t, user.SuspendedAt)
+	})
+
+	t.Run("lift a suspension", func(t *testing.T) {
+		svc, d := setup(t)
+		suspendedAt := time.Now()
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1", SuspendedAt: &suspendedAt}, nil).Once()
+		d.users.On("SetUserSuspended", mock.Anything, "user-1", (*time.Time)(nil)).Return(nil).Once()
+
+		user, err := svc.UnsuspendUser(context.Background(), "user-1")
+		require.NoError(t, err)
+		assert.Nil(t, user.SuspendedAt)
+	})
+
+	t.Run("delete a user and trash their posts", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1"}, nil).Once()
+		d.auth.On("ListSessions", mock.Anything, "user-1", "").Return([]domain.Session{{ID: "session-1"}}, nil).Once()
+		d.auth.On("RevokeSession", mock.Anything, "user-1", "session-1").Return(nil).Once()
+		d.posts.On("TransferPosts", mock.Anything, "user-1", admin.UserID, true).Return(nil).Once()
+		d.users.On("DeleteUser", mock.Anything, "user-1").Return(nil).Once()
+
+		err := svc.DeleteUser(context.Background(), admin, "user-1", domain.DeleteUserReq{Posts: domain.PostsTrash})
+		require.NoError(t, err)
+	})
+
+	t.Run("delete a user and reassign their posts", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1"}, nil).Once()
+		d.users.On("FindUserByID", mock.Anything, "user-2").Return(&domain.User{ID: "user-2"}, nil).Once()
+		d.auth.On("ListSessions", mock.Anything, "user-1", "").Return([]domain.Session{}, nil).Once()
+		d.posts.On("TransferPosts", mock.Anything, "user-1", "user-2", false).Return(nil).Once()
+		d.users.On("DeleteUser", mock.Anything, "user-1").Return(nil).Once()
+
+		err := svc.DeleteUser(context.Background(), admin, "user-1", domain.DeleteUserReq{Posts: domain.PostsReassign, ReassignTo: "user-2"})
+		require.NoError(t, err)
+	})
+
+	t.Run("refuse to reassign posts to a missing user", func(t *testing.T) {
+		svc, d := setup(t)
+		d.users.On("FindUserByID", mock.Anything, "user-1").Return(&domain.User{ID: "user-1"}, nil).Once()
+		d.users.On("FindUserByID", mock.Anything, "user-9").Return((*domain.User)(nil), sql.ErrNoRows).Once()
+
+		err := svc.DeleteUser(context.Background(), admin, "user-1", domain.DeleteUserReq{Posts: domain.PostsReassign, ReassignTo: "user-9"})
+		assert.ErrorIs(t, err, domain.ErrInvalidReassignee)
+	})
+}
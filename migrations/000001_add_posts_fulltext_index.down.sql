@@ -0,0 +1,3 @@
+ALTER TABLE posts
+    DROP INDEX ft_posts_title,
+    DROP INDEX ft_posts_search;
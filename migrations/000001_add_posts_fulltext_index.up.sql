@@ -0,0 +1,5 @@
+-- Full-text search over posts (see SearchRepository).
+-- The ngram parser is used so Thai text, which has no spaces between words, is tokenized too.
+ALTER TABLE posts
+    ADD FULLTEXT INDEX ft_posts_title (title) WITH PARSER ngram,
+    ADD FULLTEXT INDEX ft_posts_search (title, excerpt, content) WITH PARSER ngram;
@@ -0,0 +1,5 @@
+-- Nested categories: a NULL parent_id marks a top-level category.
+ALTER TABLE categories
+    ADD COLUMN parent_id VARCHAR(36) NULL AFTER slug,
+    ADD INDEX idx_categories_parent_id (parent_id),
+    ADD CONSTRAINT fk_categories_parent FOREIGN KEY (parent_id) REFERENCES categories (id) ON DELETE SET NULL;
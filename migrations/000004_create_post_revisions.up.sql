@@ -0,0 +1,17 @@
+-- Immutable snapshots of post text, one per create/update/restore.
+CREATE TABLE post_revisions (
+    id VARCHAR(36) NOT NULL,
+    post_id VARCHAR(36) NOT NULL,
+    author_id VARCHAR(36) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content MEDIUMTEXT NOT NULL,
+    excerpt TEXT NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_post_revisions_post_created (post_id, created_at),
+    CONSTRAINT fk_post_revisions_post FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Seed a baseline revision for posts written before revisions existed.
+INSERT INTO post_revisions (id, post_id, author_id, title, content, excerpt, created_at)
+SELECT UUID(), id, user_id, title, content, COALESCE(excerpt, ''), updated_at FROM posts;
@@ -0,0 +1,4 @@
+-- Scheduled publishing: unpublished posts with publish_at set go live at that time.
+ALTER TABLE posts
+    ADD COLUMN publish_at DATETIME NULL AFTER published_at,
+    ADD INDEX idx_posts_publish_at (publish_at);
@@ -0,0 +1,6 @@
+ALTER TABLE posts
+    DROP INDEX idx_posts_deleted_at,
+    DROP INDEX idx_posts_slug,
+    DROP INDEX uk_posts_live_slug,
+    DROP COLUMN live_slug,
+    ADD UNIQUE INDEX slug (slug);
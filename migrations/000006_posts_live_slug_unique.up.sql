@@ -0,0 +1,10 @@
+-- Slugs only need to be unique among posts that are not in the trash, so a
+-- deleted post no longer blocks reuse of its slug. live_slug is NULL for
+-- trashed posts and a UNIQUE index allows any number of NULLs. The column is
+-- INVISIBLE so SELECT * (and the Post struct scan) does not see it.
+ALTER TABLE posts
+    DROP INDEX slug, -- UNIQUE KEY on slug from the base schema
+    ADD COLUMN live_slug VARCHAR(255) GENERATED ALWAYS AS (IF(deleted_at IS NULL, slug, NULL)) STORED INVISIBLE,
+    ADD UNIQUE INDEX uk_posts_live_slug (live_slug),
+    ADD INDEX idx_posts_slug (slug),
+    ADD INDEX idx_posts_deleted_at (deleted_at);
@@ -0,0 +1,10 @@
+-- Former post slugs, kept so old links can be redirected. A slug belongs to
+-- at most one post's history.
+CREATE TABLE post_slug_history (
+    slug VARCHAR(255) NOT NULL,
+    post_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (slug),
+    KEY idx_post_slug_history_post_id (post_id),
+    CONSTRAINT fk_post_slug_history_post FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,18 @@
+-- Access tokens revoked before their expiry, by jti.
+CREATE TABLE revoked_tokens (
+    jti VARCHAR(36) NOT NULL,
+    expires_at DATETIME NOT NULL,
+    PRIMARY KEY (jti),
+    KEY idx_revoked_tokens_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- "Log out everywhere": every token of the user issued at or before
+-- revoked_before is rejected.
+CREATE TABLE user_token_revocations (
+    user_id VARCHAR(36) NOT NULL,
+    revoked_before DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    PRIMARY KEY (user_id),
+    KEY idx_user_token_revocations_expires_at (expires_at),
+    CONSTRAINT fk_user_token_revocations_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+-- Suspended users cannot log in; their tokens are revoked when suspended.
+ALTER TABLE users
+    ADD COLUMN suspended_at DATETIME NULL AFTER role;
@@ -0,0 +1,19 @@
+-- Email verification. Accounts that existed before verification was
+-- introduced are treated as verified.
+ALTER TABLE users
+    ADD COLUMN email_verified_at DATETIME NULL AFTER email;
+
+UPDATE users SET email_verified_at = created_at;
+
+CREATE TABLE email_verification_tokens (
+    id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    expires_at DATETIME NOT NULL,
+    created_at DATETIME NOT NULL,
+    used_at DATETIME NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_email_verification_tokens_token_hash (token_hash),
+    KEY idx_email_verification_tokens_user_created (user_id, created_at),
+    CONSTRAINT fk_email_verification_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
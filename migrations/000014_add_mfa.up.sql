@@ -0,0 +1,25 @@
+-- TOTP two-factor authentication. The secret is stored as is because codes
+-- are checked against it; recovery codes are single use and stored hashed.
+CREATE TABLE user_mfa (
+    user_id VARCHAR(36) NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    enabled_at DATETIME NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (user_id),
+    CONSTRAINT fk_user_mfa_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE mfa_recovery_codes (
+    id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    used_at DATETIME NULL,
+    PRIMARY KEY (id),
+    KEY idx_mfa_recovery_codes_user_hash (user_id, code_hash),
+    CONSTRAINT fk_mfa_recovery_codes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Admins only get admin rights in sessions started with a second factor
+ALTER TABLE sessions
+    ADD COLUMN mfa BOOLEAN NOT NULL DEFAULT FALSE AFTER ip;
@@ -0,0 +1,17 @@
+-- Personal access tokens for scripts and CI, stored as SHA-256 hashes.
+-- scopes is a comma separated list such as "posts:read,posts:write".
+CREATE TABLE personal_access_tokens (
+    id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    scopes VARCHAR(255) NOT NULL,
+    mfa BOOLEAN NOT NULL DEFAULT FALSE,
+    expires_at DATETIME NULL,
+    last_used_at DATETIME NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_personal_access_tokens_token_hash (token_hash),
+    KEY idx_personal_access_tokens_user_id (user_id),
+    CONSTRAINT fk_personal_access_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
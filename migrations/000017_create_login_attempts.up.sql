@@ -0,0 +1,8 @@
+-- Failed login counts per username and per client IP, for login throttling
+CREATE TABLE login_attempts (
+    attempt_key VARCHAR(100) NOT NULL,
+    failures INT NOT NULL,
+    last_failure_at DATETIME NOT NULL,
+    PRIMARY KEY (attempt_key),
+    KEY idx_login_attempts_last_failure_at (last_failure_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
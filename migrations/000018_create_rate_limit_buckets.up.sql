@@ -0,0 +1,9 @@
+-- Token buckets of the rate limiter, shared by all instances
+CREATE TABLE rate_limit_buckets (
+    bucket_key VARCHAR(150) NOT NULL,
+    tokens DOUBLE NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    full_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (bucket_key),
+    KEY idx_rate_limit_buckets_full_at (full_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
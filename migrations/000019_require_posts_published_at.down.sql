@@ -0,0 +1,2 @@
+ALTER TABLE posts
+    DROP CHECK chk_posts_published_at;
@@ -0,0 +1,7 @@
+-- The public feed is ordered and paged by published_at, so published posts
+-- must have one. Rows published before it was always set take their
+-- creation time.
+UPDATE posts SET published_at = created_at WHERE is_published = TRUE AND published_at IS NULL;
+
+ALTER TABLE posts
+    ADD CONSTRAINT chk_posts_published_at CHECK (is_published = FALSE OR published_at IS NOT NULL);
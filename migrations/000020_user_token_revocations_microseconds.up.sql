@@ -0,0 +1,4 @@
+-- Tokens carry iat in microseconds; revoked_before needs the same precision
+-- or tokens issued just after a revocation are rejected with the older ones.
+ALTER TABLE user_token_revocations
+    MODIFY revoked_before DATETIME(6) NOT NULL;
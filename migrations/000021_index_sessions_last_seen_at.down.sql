@@ -0,0 +1,2 @@
+ALTER TABLE sessions
+    DROP INDEX idx_sessions_last_seen_at;
@@ -0,0 +1,3 @@
+-- Sessions not seen for longer than a refresh token lives are deleted
+ALTER TABLE sessions
+    ADD INDEX idx_sessions_last_seen_at (last_seen_at);
@@ -0,0 +1,3 @@
+ALTER TABLE refresh_tokens
+    DROP INDEX idx_refresh_tokens_family_id_expires_at,
+    ADD INDEX idx_refresh_tokens_family_id (family_id);
@@ -0,0 +1,4 @@
+-- Lets the cleanup find expired families from the index alone
+ALTER TABLE refresh_tokens
+    DROP INDEX idx_refresh_tokens_family_id,
+    ADD INDEX idx_refresh_tokens_family_id_expires_at (family_id, expires_at);
@@ -7,17 +7,18 @@ package mocks
 import (
 	"blogg/internal/core/domain"
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockAuthServicePort creates a new instance of MockAuthServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAccessTokenServicePort creates a new instance of MockAccessTokenServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockAuthServicePort(t interface {
+func NewMockAccessTokenServicePort(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockAuthServicePort {
-	mock := &MockAuthServicePort{}
+}) *MockAccessTokenServicePort {
+	mock := &MockAccessTokenServicePort{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -25,136 +26,224 @@ func NewMockAuthServicePort(t interface {
 	return mock
 }
 
-// MockAuthServicePort is an autogenerated mock type for the AuthServicePort type
-type MockAuthServicePort struct {
+// MockAccessTokenServicePort is an autogenerated mock type for the AccessTokenServicePort type
+type MockAccessTokenServicePort struct {
 	mock.Mock
 }
 
-type MockAuthServicePort_Expecter struct {
+type MockAccessTokenServicePort_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockAuthServicePort) EXPECT() *MockAuthServicePort_Expecter {
-	return &MockAuthServicePort_Expecter{mock: &_m.Mock}
+func (_m *MockAccessTokenServicePort) EXPECT() *MockAccessTokenServicePort_Expecter {
+	return &MockAccessTokenServicePort_Expecter{mock: &_m.Mock}
 }
 
-// Login provides a mock function for the type MockAuthServicePort
-func (_mock *MockAuthServicePort) Login(ctx context.Context, u *domain.UserLoginReq) (*domain.UserLoginRes, error) {
-	ret := _mock.Called(ctx, u)
+// Authenticate provides a mock function for the type MockAccessTokenServicePort
+func (_mock *MockAccessTokenServicePort) Authenticate(ctx context.Context, rawToken string) (*domain.PersonalAccessToken, *domain.User, error) {
+	ret := _mock.Called(ctx, rawToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for Authenticate")
 	}
 
-	var r0 *domain.UserLoginRes
+	var r0 *domain.PersonalAccessToken
+	var r1 *domain.User
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.PersonalAccessToken, *domain.User, error)); ok {
+		return returnFunc(ctx, rawToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, rawToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *domain.User); ok {
+		r1 = returnFunc(ctx, rawToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, rawToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAccessTokenServicePort_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAccessTokenServicePort_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rawToken string
+func (_e *MockAccessTokenServicePort_Expecter) Authenticate(ctx interface{}, rawToken interface{}) *MockAccessTokenServicePort_Authenticate_Call {
+	return &MockAccessTokenServicePort_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, rawToken)}
+}
+
+func (_c *MockAccessTokenServicePort_Authenticate_Call) Run(run func(ctx context.Context, rawToken string)) *MockAccessTokenServicePort_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccessTokenServicePort_Authenticate_Call) Return(personalAccessToken *domain.PersonalAccessToken, user *domain.User, err error) *MockAccessTokenServicePort_Authenticate_Call {
+	_c.Call.Return(personalAccessToken, user, err)
+	return _c
+}
+
+func (_c *MockAccessTokenServicePort_Authenticate_Call) RunAndReturn(run func(ctx context.Context, rawToken string) (*domain.PersonalAccessToken, *domain.User, error)) *MockAccessTokenServicePort_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateToken provides a mock function for the type MockAccessTokenServicePort
+func (_mock *MockAccessTokenServicePort) CreateToken(ctx context.Context, actor domain.Actor, mfa bool, req *domain.CreateAccessTokenReq) (*domain.CreateAccessTokenRes, error) {
+	ret := _mock.Called(ctx, actor, mfa, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateToken")
+	}
+
+	var r0 *domain.CreateAccessTokenRes
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserLoginReq) (*domain.UserLoginRes, error)); ok {
-		return returnFunc(ctx, u)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor, bool, *domain.CreateAccessTokenReq) (*domain.CreateAccessTokenRes, error)); ok {
+		return returnFunc(ctx, actor, mfa, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserLoginReq) *domain.UserLoginRes); ok {
-		r0 = returnFunc(ctx, u)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor, bool, *domain.CreateAccessTokenReq) *domain.CreateAccessTokenRes); ok {
+		r0 = returnFunc(ctx, actor, mfa, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UserLoginRes)
+			r0 = ret.Get(0).(*domain.CreateAccessTokenRes)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UserLoginReq) error); ok {
-		r1 = returnFunc(ctx, u)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Actor, bool, *domain.CreateAccessTokenReq) error); ok {
+		r1 = returnFunc(ctx, actor, mfa, req)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthServicePort_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type MockAuthServicePort_Login_Call struct {
+// MockAccessTokenServicePort_CreateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateToken'
+type MockAccessTokenServicePort_CreateToken_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// CreateToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - u *domain.UserLoginReq
-func (_e *MockAuthServicePort_Expecter) Login(ctx interface{}, u interface{}) *MockAuthServicePort_Login_Call {
-	return &MockAuthServicePort_Login_Call{Call: _e.mock.On("Login", ctx, u)}
+//   - actor domain.Actor
+//   - mfa bool
+//   - req *domain.CreateAccessTokenReq
+func (_e *MockAccessTokenServicePort_Expecter) CreateToken(ctx interface{}, actor interface{}, mfa interface{}, req interface{}) *MockAccessTokenServicePort_CreateToken_Call {
+	return &MockAccessTokenServicePort_CreateToken_Call{Call: _e.mock.On("CreateToken", ctx, actor, mfa, req)}
 }
 
-func (_c *MockAuthServicePort_Login_Call) Run(run func(ctx context.Context, u *domain.UserLoginReq)) *MockAuthServicePort_Login_Call {
+func (_c *MockAccessTokenServicePort_CreateToken_Call) Run(run func(ctx context.Context, actor domain.Actor, mfa bool, req *domain.CreateAccessTokenReq)) *MockAccessTokenServicePort_CreateToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.UserLoginReq
+		var arg1 domain.Actor
 		if args[1] != nil {
-			arg1 = args[1].(*domain.UserLoginReq)
+			arg1 = args[1].(domain.Actor)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 *domain.CreateAccessTokenReq
+		if args[3] != nil {
+			arg3 = args[3].(*domain.CreateAccessTokenReq)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthServicePort_Login_Call) Return(userLoginRes *domain.UserLoginRes, err error) *MockAuthServicePort_Login_Call {
-	_c.Call.Return(userLoginRes, err)
+func (_c *MockAccessTokenServicePort_CreateToken_Call) Return(createAccessTokenRes *domain.CreateAccessTokenRes, err error) *MockAccessTokenServicePort_CreateToken_Call {
+	_c.Call.Return(createAccessTokenRes, err)
 	return _c
 }
 
-func (_c *MockAuthServicePort_Login_Call) RunAndReturn(run func(ctx context.Context, u *domain.UserLoginReq) (*domain.UserLoginRes, error)) *MockAuthServicePort_Login_Call {
+func (_c *MockAccessTokenServicePort_CreateToken_Call) RunAndReturn(run func(ctx context.Context, actor domain.Actor, mfa bool, req *domain.CreateAccessTokenReq) (*domain.CreateAccessTokenRes, error)) *MockAccessTokenServicePort_CreateToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function for the type MockAuthServicePort
-func (_mock *MockAuthServicePort) Register(ctx context.Context, u *domain.UserRegisterReq) (*domain.UserRegisterRes, error) {
-	ret := _mock.Called(ctx, u)
+// ListTokens provides a mock function for the type MockAccessTokenServicePort
+func (_mock *MockAccessTokenServicePort) ListTokens(ctx context.Context, userID string) ([]domain.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Register")
+		panic("no return value specified for ListTokens")
 	}
 
-	var r0 *domain.UserRegisterRes
+	var r0 []domain.PersonalAccessToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserRegisterReq) (*domain.UserRegisterRes, error)); ok {
-		return returnFunc(ctx, u)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserRegisterReq) *domain.UserRegisterRes); ok {
-		r0 = returnFunc(ctx, u)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UserRegisterRes)
+			r0 = ret.Get(0).([]domain.PersonalAccessToken)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UserRegisterReq) error); ok {
-		r1 = returnFunc(ctx, u)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthServicePort_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
-type MockAuthServicePort_Register_Call struct {
+// MockAccessTokenServicePort_ListTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTokens'
+type MockAccessTokenServicePort_ListTokens_Call struct {
 	*mock.Call
 }
 
-// Register is a helper method to define mock.On call
+// ListTokens is a helper method to define mock.On call
 //   - ctx context.Context
-//   - u *domain.UserRegisterReq
-func (_e *MockAuthServicePort_Expecter) Register(ctx interface{}, u interface{}) *MockAuthServicePort_Register_Call {
-	return &MockAuthServicePort_Register_Call{Call: _e.mock.On("Register", ctx, u)}
+//   - userID string
+func (_e *MockAccessTokenServicePort_Expecter) ListTokens(ctx interface{}, userID interface{}) *MockAccessTokenServicePort_ListTokens_Call {
+	return &MockAccessTokenServicePort_ListTokens_Call{Call: _e.mock.On("ListTokens", ctx, userID)}
 }
 
-func (_c *MockAuthServicePort_Register_Call) Run(run func(ctx context.Context, u *domain.UserRegisterReq)) *MockAuthServicePort_Register_Call {
+func (_c *MockAccessTokenServicePort_ListTokens_Call) Run(run func(ctx context.Context, userID string)) *MockAccessTokenServicePort_ListTokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.UserRegisterReq
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.UserRegisterReq)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -164,23 +253,143 @@ func (_c *MockAuthServicePort_Register_Call) Run(run func(ctx context.Context, u
 	return _c
 }
 
-func (_c *MockAuthServicePort_Register_Call) Return(userRegisterRes *domain.UserRegisterRes, err error) *MockAuthServicePort_Register_Call {
-	_c.Call.Return(userRegisterRes, err)
+func (_c *MockAccessTokenServicePort_ListTokens_Call) Return(personalAccessTokens []domain.PersonalAccessToken, err error) *MockAccessTokenServicePort_ListTokens_Call {
+	_c.Call.Return(personalAccessTokens, err)
 	return _c
 }
 
-func (_c *MockAuthServicePort_Register_Call) RunAndReturn(run func(ctx context.Context, u *domain.UserRegisterReq) (*domain.UserRegisterRes, error)) *MockAuthServicePort_Register_Call {
+func (_c *MockAccessTokenServicePort_ListTokens_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]domain.PersonalAccessToken, error)) *MockAccessTokenServicePort_ListTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockAuthRepositoryPort creates a new instance of MockAuthRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// RevokeAllTokens provides a mock function for the type MockAccessTokenServicePort
+func (_mock *MockAccessTokenServicePort) RevokeAllTokens(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAccessTokenServicePort_RevokeAllTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllTokens'
+type MockAccessTokenServicePort_RevokeAllTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeAllTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAccessTokenServicePort_Expecter) RevokeAllTokens(ctx interface{}, userID interface{}) *MockAccessTokenServicePort_RevokeAllTokens_Call {
+	return &MockAccessTokenServicePort_RevokeAllTokens_Call{Call: _e.mock.On("RevokeAllTokens", ctx, userID)}
+}
+
+func (_c *MockAccessTokenServicePort_RevokeAllTokens_Call) Run(run func(ctx context.Context, userID string)) *MockAccessTokenServicePort_RevokeAllTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccessTokenServicePort_RevokeAllTokens_Call) Return(err error) *MockAccessTokenServicePort_RevokeAllTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAccessTokenServicePort_RevokeAllTokens_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockAccessTokenServicePort_RevokeAllTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function for the type MockAccessTokenServicePort
+func (_mock *MockAccessTokenServicePort) RevokeToken(ctx context.Context, userID string, tokenID string) error {
+	ret := _mock.Called(ctx, userID, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAccessTokenServicePort_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockAccessTokenServicePort_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - tokenID string
+func (_e *MockAccessTokenServicePort_Expecter) RevokeToken(ctx interface{}, userID interface{}, tokenID interface{}) *MockAccessTokenServicePort_RevokeToken_Call {
+	return &MockAccessTokenServicePort_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, userID, tokenID)}
+}
+
+func (_c *MockAccessTokenServicePort_RevokeToken_Call) Run(run func(ctx context.Context, userID string, tokenID string)) *MockAccessTokenServicePort_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccessTokenServicePort_RevokeToken_Call) Return(err error) *MockAccessTokenServicePort_RevokeToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAccessTokenServicePort_RevokeToken_Call) RunAndReturn(run func(ctx context.Context, userID string, tokenID string) error) *MockAccessTokenServicePort_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAccessTokenRepositoryPort creates a new instance of MockAccessTokenRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockAuthRepositoryPort(t interface {
+func NewMockAccessTokenRepositoryPort(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockAuthRepositoryPort {
-	mock := &MockAuthRepositoryPort{}
+}) *MockAccessTokenRepositoryPort {
+	mock := &MockAccessTokenRepositoryPort{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -188,57 +397,57 @@ func NewMockAuthRepositoryPort(t interface {
 	return mock
 }
 
-// MockAuthRepositoryPort is an autogenerated mock type for the AuthRepositoryPort type
-type MockAuthRepositoryPort struct {
+// MockAccessTokenRepositoryPort is an autogenerated mock type for the AccessTokenRepositoryPort type
+type MockAccessTokenRepositoryPort struct {
 	mock.Mock
 }
 
-type MockAuthRepositoryPort_Expecter struct {
+type MockAccessTokenRepositoryPort_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockAuthRepositoryPort) EXPECT() *MockAuthRepositoryPort_Expecter {
-	return &MockAuthRepositoryPort_Expecter{mock: &_m.Mock}
+func (_m *MockAccessTokenRepositoryPort) EXPECT() *MockAccessTokenRepositoryPort_Expecter {
+	return &MockAccessTokenRepositoryPort_Expecter{mock: &_m.Mock}
 }
 
-// CreateUser provides a mock function for the type MockAuthRepositoryPort
-func (_mock *MockAuthRepositoryPort) CreateUser(ctx context.Context, u *domain.User) error {
-	ret := _mock.Called(ctx, u)
+// CreateAccessToken provides a mock function for the type MockAccessTokenRepositoryPort
+func (_mock *MockAccessTokenRepositoryPort) CreateAccessToken(ctx context.Context, t *domain.PersonalAccessToken) error {
+	ret := _mock.Called(ctx, t)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for CreateAccessToken")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
-		r0 = returnFunc(ctx, u)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PersonalAccessToken) error); ok {
+		r0 = returnFunc(ctx, t)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockAuthRepositoryPort_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockAuthRepositoryPort_CreateUser_Call struct {
+// MockAccessTokenRepositoryPort_CreateAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccessToken'
+type MockAccessTokenRepositoryPort_CreateAccessToken_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// CreateAccessToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - u *domain.User
-func (_e *MockAuthRepositoryPort_Expecter) CreateUser(ctx interface{}, u interface{}) *MockAuthRepositoryPort_CreateUser_Call {
-	return &MockAuthRepositoryPort_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, u)}
+//   - t *domain.PersonalAccessToken
+func (_e *MockAccessTokenRepositoryPort_Expecter) CreateAccessToken(ctx interface{}, t interface{}) *MockAccessTokenRepositoryPort_CreateAccessToken_Call {
+	return &MockAccessTokenRepositoryPort_CreateAccessToken_Call{Call: _e.mock.On("CreateAccessToken", ctx, t)}
 }
 
-func (_c *MockAuthRepositoryPort_CreateUser_Call) Run(run func(ctx context.Context, u *domain.User)) *MockAuthRepositoryPort_CreateUser_Call {
+func (_c *MockAccessTokenRepositoryPort_CreateAccessToken_Call) Run(run func(ctx context.Context, t *domain.PersonalAccessToken)) *MockAccessTokenRepositoryPort_CreateAccessToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.User
+		var arg1 *domain.PersonalAccessToken
 		if args[1] != nil {
-			arg1 = args[1].(*domain.User)
+			arg1 = args[1].(*domain.PersonalAccessToken)
 		}
 		run(
 			arg0,
@@ -248,57 +457,9859 @@ func (_c *MockAuthRepositoryPort_CreateUser_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockAuthRepositoryPort_CreateUser_Call) Return(err error) *MockAuthRepositoryPort_CreateUser_Call {
+func (_c *MockAccessTokenRepositoryPort_CreateAccessToken_Call) Return(err error) *MockAccessTokenRepositoryPort_CreateAccessToken_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthRepositoryPort_CreateUser_Call) RunAndReturn(run func(ctx context.Context, u *domain.User) error) *MockAuthRepositoryPort_CreateUser_Call {
+func (_c *MockAccessTokenRepositoryPort_CreateAccessToken_Call) RunAndReturn(run func(ctx context.Context, t *domain.PersonalAccessToken) error) *MockAccessTokenRepositoryPort_CreateAccessToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindUserByEmail provides a mock function for the type MockAuthRepositoryPort
-func (_mock *MockAuthRepositoryPort) FindUserByEmail(ctx context.Context, email string) (*domain.User, error) {
-	ret := _mock.Called(ctx, email)
+// DeleteAccessToken provides a mock function for the type MockAccessTokenRepositoryPort
+func (_mock *MockAccessTokenRepositoryPort) DeleteAccessToken(ctx context.Context, userID string, tokenID string) (bool, error) {
+	ret := _mock.Called(ctx, userID, tokenID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindUserByEmail")
+		panic("no return value specified for DeleteAccessToken")
 	}
 
-	var r0 *domain.User
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, userID, tokenID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, userID, tokenID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, tokenID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthRepositoryPort_FindUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByEmail'
-type MockAuthRepositoryPort_FindUserByEmail_Call struct {
+// MockAccessTokenRepositoryPort_DeleteAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccessToken'
+type MockAccessTokenRepositoryPort_DeleteAccessToken_Call struct {
 	*mock.Call
 }
 
-// FindUserByEmail is a helper method to define mock.On call
+// DeleteAccessToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
+//   - userID string
+//   - tokenID string
+func (_e *MockAccessTokenRepositoryPort_Expecter) DeleteAccessToken(ctx interface{}, userID interface{}, tokenID interface{}) *MockAccessTokenRepositoryPort_DeleteAccessToken_Call {
+	return &MockAccessTokenRepositoryPort_DeleteAccessToken_Call{Call: _e.mock.On("DeleteAccessToken", ctx, userID, tokenID)}
+}
+
+func (_c *MockAccessTokenRepositoryPort_DeleteAccessToken_Call) Run(run func(ctx context.Context, userID string, tokenID string)) *MockAccessTokenRepositoryPort_DeleteAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_DeleteAccessToken_Call) Return(b bool, err error) *MockAccessTokenRepositoryPort_DeleteAccessToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_DeleteAccessToken_Call) RunAndReturn(run func(ctx context.Context, userID string, tokenID string) (bool, error)) *MockAccessTokenRepositoryPort_DeleteAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserAccessTokens provides a mock function for the type MockAccessTokenRepositoryPort
+func (_mock *MockAccessTokenRepositoryPort) DeleteUserAccessTokens(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserAccessTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserAccessTokens'
+type MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteUserAccessTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAccessTokenRepositoryPort_Expecter) DeleteUserAccessTokens(ctx interface{}, userID interface{}) *MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call {
+	return &MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call{Call: _e.mock.On("DeleteUserAccessTokens", ctx, userID)}
+}
+
+func (_c *MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call) Run(run func(ctx context.Context, userID string)) *MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call) Return(err error) *MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockAccessTokenRepositoryPort_DeleteUserAccessTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAccessTokenByHash provides a mock function for the type MockAccessTokenRepositoryPort
+func (_mock *MockAccessTokenRepositoryPort) FindAccessTokenByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAccessTokenByHash")
+	}
+
+	var r0 *domain.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAccessTokenByHash'
+type MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call struct {
+	*mock.Call
+}
+
+// FindAccessTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockAccessTokenRepositoryPort_Expecter) FindAccessTokenByHash(ctx interface{}, tokenHash interface{}) *MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call {
+	return &MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call{Call: _e.mock.On("FindAccessTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call) Return(personalAccessToken *domain.PersonalAccessToken, err error) *MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call {
+	_c.Call.Return(personalAccessToken, err)
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error)) *MockAccessTokenRepositoryPort_FindAccessTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAccessTokensByUserID provides a mock function for the type MockAccessTokenRepositoryPort
+func (_mock *MockAccessTokenRepositoryPort) FindAccessTokensByUserID(ctx context.Context, userID string) ([]domain.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAccessTokensByUserID")
+	}
+
+	var r0 []domain.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAccessTokensByUserID'
+type MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call struct {
+	*mock.Call
+}
+
+// FindAccessTokensByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAccessTokenRepositoryPort_Expecter) FindAccessTokensByUserID(ctx interface{}, userID interface{}) *MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call {
+	return &MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call{Call: _e.mock.On("FindAccessTokensByUserID", ctx, userID)}
+}
+
+func (_c *MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call) Return(personalAccessTokens []domain.PersonalAccessToken, err error) *MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call {
+	_c.Call.Return(personalAccessTokens, err)
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]domain.PersonalAccessToken, error)) *MockAccessTokenRepositoryPort_FindAccessTokensByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchAccessToken provides a mock function for the type MockAccessTokenRepositoryPort
+func (_mock *MockAccessTokenRepositoryPort) TouchAccessToken(ctx context.Context, tokenID string, usedAt time.Time) error {
+	ret := _mock.Called(ctx, tokenID, usedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchAccessToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, tokenID, usedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAccessTokenRepositoryPort_TouchAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchAccessToken'
+type MockAccessTokenRepositoryPort_TouchAccessToken_Call struct {
+	*mock.Call
+}
+
+// TouchAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - usedAt time.Time
+func (_e *MockAccessTokenRepositoryPort_Expecter) TouchAccessToken(ctx interface{}, tokenID interface{}, usedAt interface{}) *MockAccessTokenRepositoryPort_TouchAccessToken_Call {
+	return &MockAccessTokenRepositoryPort_TouchAccessToken_Call{Call: _e.mock.On("TouchAccessToken", ctx, tokenID, usedAt)}
+}
+
+func (_c *MockAccessTokenRepositoryPort_TouchAccessToken_Call) Run(run func(ctx context.Context, tokenID string, usedAt time.Time)) *MockAccessTokenRepositoryPort_TouchAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_TouchAccessToken_Call) Return(err error) *MockAccessTokenRepositoryPort_TouchAccessToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAccessTokenRepositoryPort_TouchAccessToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string, usedAt time.Time) error) *MockAccessTokenRepositoryPort_TouchAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthServicePort creates a new instance of MockAuthServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthServicePort {
+	mock := &MockAuthServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuthServicePort is an autogenerated mock type for the AuthServicePort type
+type MockAuthServicePort struct {
+	mock.Mock
+}
+
+type MockAuthServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthServicePort) EXPECT() *MockAuthServicePort_Expecter {
+	return &MockAuthServicePort_Expecter{mock: &_m.Mock}
+}
+
+// CleanupLoginAttempts provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) CleanupLoginAttempts(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupLoginAttempts")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_CleanupLoginAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupLoginAttempts'
+type MockAuthServicePort_CleanupLoginAttempts_Call struct {
+	*mock.Call
+}
+
+// CleanupLoginAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthServicePort_Expecter) CleanupLoginAttempts(ctx interface{}) *MockAuthServicePort_CleanupLoginAttempts_Call {
+	return &MockAuthServicePort_CleanupLoginAttempts_Call{Call: _e.mock.On("CleanupLoginAttempts", ctx)}
+}
+
+func (_c *MockAuthServicePort_CleanupLoginAttempts_Call) Run(run func(ctx context.Context)) *MockAuthServicePort_CleanupLoginAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_CleanupLoginAttempts_Call) Return(n int, err error) *MockAuthServicePort_CleanupLoginAttempts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_CleanupLoginAttempts_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockAuthServicePort_CleanupLoginAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CleanupRefreshTokens provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) CleanupRefreshTokens(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupRefreshTokens")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_CleanupRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupRefreshTokens'
+type MockAuthServicePort_CleanupRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// CleanupRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthServicePort_Expecter) CleanupRefreshTokens(ctx interface{}) *MockAuthServicePort_CleanupRefreshTokens_Call {
+	return &MockAuthServicePort_CleanupRefreshTokens_Call{Call: _e.mock.On("CleanupRefreshTokens", ctx)}
+}
+
+func (_c *MockAuthServicePort_CleanupRefreshTokens_Call) Run(run func(ctx context.Context)) *MockAuthServicePort_CleanupRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_CleanupRefreshTokens_Call) Return(n int, err error) *MockAuthServicePort_CleanupRefreshTokens_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_CleanupRefreshTokens_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockAuthServicePort_CleanupRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CleanupRevokedTokens provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) CleanupRevokedTokens(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupRevokedTokens")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_CleanupRevokedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupRevokedTokens'
+type MockAuthServicePort_CleanupRevokedTokens_Call struct {
+	*mock.Call
+}
+
+// CleanupRevokedTokens is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthServicePort_Expecter) CleanupRevokedTokens(ctx interface{}) *MockAuthServicePort_CleanupRevokedTokens_Call {
+	return &MockAuthServicePort_CleanupRevokedTokens_Call{Call: _e.mock.On("CleanupRevokedTokens", ctx)}
+}
+
+func (_c *MockAuthServicePort_CleanupRevokedTokens_Call) Run(run func(ctx context.Context)) *MockAuthServicePort_CleanupRevokedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_CleanupRevokedTokens_Call) Return(n int, err error) *MockAuthServicePort_CleanupRevokedTokens_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_CleanupRevokedTokens_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockAuthServicePort_CleanupRevokedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CleanupSessions provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) CleanupSessions(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupSessions")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_CleanupSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupSessions'
+type MockAuthServicePort_CleanupSessions_Call struct {
+	*mock.Call
+}
+
+// CleanupSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthServicePort_Expecter) CleanupSessions(ctx interface{}) *MockAuthServicePort_CleanupSessions_Call {
+	return &MockAuthServicePort_CleanupSessions_Call{Call: _e.mock.On("CleanupSessions", ctx)}
+}
+
+func (_c *MockAuthServicePort_CleanupSessions_Call) Run(run func(ctx context.Context)) *MockAuthServicePort_CleanupSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_CleanupSessions_Call) Return(n int, err error) *MockAuthServicePort_CleanupSessions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_CleanupSessions_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockAuthServicePort_CleanupSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireAccessTokens provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) ExpireAccessTokens(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireAccessTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthServicePort_ExpireAccessTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireAccessTokens'
+type MockAuthServicePort_ExpireAccessTokens_Call struct {
+	*mock.Call
+}
+
+// ExpireAccessTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuthServicePort_Expecter) ExpireAccessTokens(ctx interface{}, userID interface{}) *MockAuthServicePort_ExpireAccessTokens_Call {
+	return &MockAuthServicePort_ExpireAccessTokens_Call{Call: _e.mock.On("ExpireAccessTokens", ctx, userID)}
+}
+
+func (_c *MockAuthServicePort_ExpireAccessTokens_Call) Run(run func(ctx context.Context, userID string)) *MockAuthServicePort_ExpireAccessTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_ExpireAccessTokens_Call) Return(err error) *MockAuthServicePort_ExpireAccessTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_ExpireAccessTokens_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockAuthServicePort_ExpireAccessTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) ListSessions(ctx context.Context, userID string, currentSessionID string) ([]domain.Session, error) {
+	ret := _mock.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []domain.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.Session, error)); ok {
+		return returnFunc(ctx, userID, currentSessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []domain.Session); ok {
+		r0 = returnFunc(ctx, userID, currentSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockAuthServicePort_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - currentSessionID string
+func (_e *MockAuthServicePort_Expecter) ListSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *MockAuthServicePort_ListSessions_Call {
+	return &MockAuthServicePort_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *MockAuthServicePort_ListSessions_Call) Run(run func(ctx context.Context, userID string, currentSessionID string)) *MockAuthServicePort_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_ListSessions_Call) Return(sessions []domain.Session, err error) *MockAuthServicePort_ListSessions_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_ListSessions_Call) RunAndReturn(run func(ctx context.Context, userID string, currentSessionID string) ([]domain.Session, error)) *MockAuthServicePort_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) Login(ctx context.Context, u *domain.UserLoginReq) (*domain.UserLoginRes, error) {
+	ret := _mock.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *domain.UserLoginRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserLoginReq) (*domain.UserLoginRes, error)); ok {
+		return returnFunc(ctx, u)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserLoginReq) *domain.UserLoginRes); ok {
+		r0 = returnFunc(ctx, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserLoginRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UserLoginReq) error); ok {
+		r1 = returnFunc(ctx, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthServicePort_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u *domain.UserLoginReq
+func (_e *MockAuthServicePort_Expecter) Login(ctx interface{}, u interface{}) *MockAuthServicePort_Login_Call {
+	return &MockAuthServicePort_Login_Call{Call: _e.mock.On("Login", ctx, u)}
+}
+
+func (_c *MockAuthServicePort_Login_Call) Run(run func(ctx context.Context, u *domain.UserLoginReq)) *MockAuthServicePort_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UserLoginReq
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UserLoginReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_Login_Call) Return(userLoginRes *domain.UserLoginRes, err error) *MockAuthServicePort_Login_Call {
+	_c.Call.Return(userLoginRes, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_Login_Call) RunAndReturn(run func(ctx context.Context, u *domain.UserLoginReq) (*domain.UserLoginRes, error)) *MockAuthServicePort_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginAs provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) LoginAs(ctx context.Context, user *domain.User, userAgent string, ip string) (*domain.UserLoginRes, error) {
+	ret := _mock.Called(ctx, user, userAgent, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginAs")
+	}
+
+	var r0 *domain.UserLoginRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, string, string) (*domain.UserLoginRes, error)); ok {
+		return returnFunc(ctx, user, userAgent, ip)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, string, string) *domain.UserLoginRes); ok {
+		r0 = returnFunc(ctx, user, userAgent, ip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserLoginRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.User, string, string) error); ok {
+		r1 = returnFunc(ctx, user, userAgent, ip)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_LoginAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginAs'
+type MockAuthServicePort_LoginAs_Call struct {
+	*mock.Call
+}
+
+// LoginAs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - userAgent string
+//   - ip string
+func (_e *MockAuthServicePort_Expecter) LoginAs(ctx interface{}, user interface{}, userAgent interface{}, ip interface{}) *MockAuthServicePort_LoginAs_Call {
+	return &MockAuthServicePort_LoginAs_Call{Call: _e.mock.On("LoginAs", ctx, user, userAgent, ip)}
+}
+
+func (_c *MockAuthServicePort_LoginAs_Call) Run(run func(ctx context.Context, user *domain.User, userAgent string, ip string)) *MockAuthServicePort_LoginAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_LoginAs_Call) Return(userLoginRes *domain.UserLoginRes, err error) *MockAuthServicePort_LoginAs_Call {
+	_c.Call.Return(userLoginRes, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_LoginAs_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, userAgent string, ip string) (*domain.UserLoginRes, error)) *MockAuthServicePort_LoginAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginMFA provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) LoginMFA(ctx context.Context, req *domain.LoginMFAReq) (*domain.UserLoginRes, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginMFA")
+	}
+
+	var r0 *domain.UserLoginRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.LoginMFAReq) (*domain.UserLoginRes, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.LoginMFAReq) *domain.UserLoginRes); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserLoginRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.LoginMFAReq) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_LoginMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginMFA'
+type MockAuthServicePort_LoginMFA_Call struct {
+	*mock.Call
+}
+
+// LoginMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.LoginMFAReq
+func (_e *MockAuthServicePort_Expecter) LoginMFA(ctx interface{}, req interface{}) *MockAuthServicePort_LoginMFA_Call {
+	return &MockAuthServicePort_LoginMFA_Call{Call: _e.mock.On("LoginMFA", ctx, req)}
+}
+
+func (_c *MockAuthServicePort_LoginMFA_Call) Run(run func(ctx context.Context, req *domain.LoginMFAReq)) *MockAuthServicePort_LoginMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.LoginMFAReq
+		if args[1] != nil {
+			arg1 = args[1].(*domain.LoginMFAReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_LoginMFA_Call) Return(userLoginRes *domain.UserLoginRes, err error) *MockAuthServicePort_LoginMFA_Call {
+	_c.Call.Return(userLoginRes, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_LoginMFA_Call) RunAndReturn(run func(ctx context.Context, req *domain.LoginMFAReq) (*domain.UserLoginRes, error)) *MockAuthServicePort_LoginMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) Logout(ctx context.Context, req *domain.LogoutReq) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.LogoutReq) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthServicePort_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthServicePort_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.LogoutReq
+func (_e *MockAuthServicePort_Expecter) Logout(ctx interface{}, req interface{}) *MockAuthServicePort_Logout_Call {
+	return &MockAuthServicePort_Logout_Call{Call: _e.mock.On("Logout", ctx, req)}
+}
+
+func (_c *MockAuthServicePort_Logout_Call) Run(run func(ctx context.Context, req *domain.LogoutReq)) *MockAuthServicePort_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.LogoutReq
+		if args[1] != nil {
+			arg1 = args[1].(*domain.LogoutReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_Logout_Call) Return(err error) *MockAuthServicePort_Logout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_Logout_Call) RunAndReturn(run func(ctx context.Context, req *domain.LogoutReq) error) *MockAuthServicePort_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutEverywhere provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) LogoutEverywhere(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutEverywhere")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthServicePort_LogoutEverywhere_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutEverywhere'
+type MockAuthServicePort_LogoutEverywhere_Call struct {
+	*mock.Call
+}
+
+// LogoutEverywhere is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuthServicePort_Expecter) LogoutEverywhere(ctx interface{}, userID interface{}) *MockAuthServicePort_LogoutEverywhere_Call {
+	return &MockAuthServicePort_LogoutEverywhere_Call{Call: _e.mock.On("LogoutEverywhere", ctx, userID)}
+}
+
+func (_c *MockAuthServicePort_LogoutEverywhere_Call) Run(run func(ctx context.Context, userID string)) *MockAuthServicePort_LogoutEverywhere_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_LogoutEverywhere_Call) Return(err error) *MockAuthServicePort_LogoutEverywhere_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_LogoutEverywhere_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockAuthServicePort_LogoutEverywhere_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) Refresh(ctx context.Context, refreshToken string) (*domain.UserLoginRes, error) {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *domain.UserLoginRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.UserLoginRes, error)); ok {
+		return returnFunc(ctx, refreshToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.UserLoginRes); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserLoginRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockAuthServicePort_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *MockAuthServicePort_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *MockAuthServicePort_Refresh_Call {
+	return &MockAuthServicePort_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *MockAuthServicePort_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *MockAuthServicePort_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_Refresh_Call) Return(userLoginRes *domain.UserLoginRes, err error) *MockAuthServicePort_Refresh_Call {
+	_c.Call.Return(userLoginRes, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_Refresh_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) (*domain.UserLoginRes, error)) *MockAuthServicePort_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) Register(ctx context.Context, u *domain.UserRegisterReq) (*domain.UserRegisterRes, error) {
+	ret := _mock.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 *domain.UserRegisterRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserRegisterReq) (*domain.UserRegisterRes, error)); ok {
+		return returnFunc(ctx, u)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserRegisterReq) *domain.UserRegisterRes); ok {
+		r0 = returnFunc(ctx, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserRegisterRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UserRegisterReq) error); ok {
+		r1 = returnFunc(ctx, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthServicePort_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockAuthServicePort_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u *domain.UserRegisterReq
+func (_e *MockAuthServicePort_Expecter) Register(ctx interface{}, u interface{}) *MockAuthServicePort_Register_Call {
+	return &MockAuthServicePort_Register_Call{Call: _e.mock.On("Register", ctx, u)}
+}
+
+func (_c *MockAuthServicePort_Register_Call) Run(run func(ctx context.Context, u *domain.UserRegisterReq)) *MockAuthServicePort_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UserRegisterReq
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UserRegisterReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_Register_Call) Return(userRegisterRes *domain.UserRegisterRes, err error) *MockAuthServicePort_Register_Call {
+	_c.Call.Return(userRegisterRes, err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_Register_Call) RunAndReturn(run func(ctx context.Context, u *domain.UserRegisterReq) (*domain.UserRegisterRes, error)) *MockAuthServicePort_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type MockAuthServicePort
+func (_mock *MockAuthServicePort) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	ret := _mock.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthServicePort_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockAuthServicePort_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sessionID string
+func (_e *MockAuthServicePort_Expecter) RevokeSession(ctx interface{}, userID interface{}, sessionID interface{}) *MockAuthServicePort_RevokeSession_Call {
+	return &MockAuthServicePort_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, sessionID)}
+}
+
+func (_c *MockAuthServicePort_RevokeSession_Call) Run(run func(ctx context.Context, userID string, sessionID string)) *MockAuthServicePort_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthServicePort_RevokeSession_Call) Return(err error) *MockAuthServicePort_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthServicePort_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, userID string, sessionID string) error) *MockAuthServicePort_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthRepositoryPort creates a new instance of MockAuthRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthRepositoryPort {
+	mock := &MockAuthRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuthRepositoryPort is an autogenerated mock type for the AuthRepositoryPort type
+type MockAuthRepositoryPort struct {
+	mock.Mock
+}
+
+type MockAuthRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthRepositoryPort) EXPECT() *MockAuthRepositoryPort_Expecter {
+	return &MockAuthRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// CreateUser provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) CreateUser(ctx context.Context, u *domain.User) error {
+	ret := _mock.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = returnFunc(ctx, u)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepositoryPort_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockAuthRepositoryPort_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u *domain.User
+func (_e *MockAuthRepositoryPort_Expecter) CreateUser(ctx interface{}, u interface{}) *MockAuthRepositoryPort_CreateUser_Call {
+	return &MockAuthRepositoryPort_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, u)}
+}
+
+func (_c *MockAuthRepositoryPort_CreateUser_Call) Run(run func(ctx context.Context, u *domain.User)) *MockAuthRepositoryPort_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_CreateUser_Call) Return(err error) *MockAuthRepositoryPort_CreateUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_CreateUser_Call) RunAndReturn(run func(ctx context.Context, u *domain.User) error) *MockAuthRepositoryPort_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) DeleteUser(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepositoryPort_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockAuthRepositoryPort_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuthRepositoryPort_Expecter) DeleteUser(ctx interface{}, userID interface{}) *MockAuthRepositoryPort_DeleteUser_Call {
+	return &MockAuthRepositoryPort_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, userID)}
+}
+
+func (_c *MockAuthRepositoryPort_DeleteUser_Call) Run(run func(ctx context.Context, userID string)) *MockAuthRepositoryPort_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_DeleteUser_Call) Return(err error) *MockAuthRepositoryPort_DeleteUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockAuthRepositoryPort_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByEmail provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) FindUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepositoryPort_FindUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByEmail'
+type MockAuthRepositoryPort_FindUserByEmail_Call struct {
+	*mock.Call
+}
+
+// FindUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
 func (_e *MockAuthRepositoryPort_Expecter) FindUserByEmail(ctx interface{}, email interface{}) *MockAuthRepositoryPort_FindUserByEmail_Call {
 	return &MockAuthRepositoryPort_FindUserByEmail_Call{Call: _e.mock.On("FindUserByEmail", ctx, email)}
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockAuthRepositoryPort_FindUserByEmail_Call {
+func (_c *MockAuthRepositoryPort_FindUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockAuthRepositoryPort_FindUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_FindUserByEmail_Call) Return(user *domain.User, err error) *MockAuthRepositoryPort_FindUserByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_FindUserByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockAuthRepositoryPort_FindUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByID provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) FindUserByID(ctx context.Context, userID string) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepositoryPort_FindUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByID'
+type MockAuthRepositoryPort_FindUserByID_Call struct {
+	*mock.Call
+}
+
+// FindUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuthRepositoryPort_Expecter) FindUserByID(ctx interface{}, userID interface{}) *MockAuthRepositoryPort_FindUserByID_Call {
+	return &MockAuthRepositoryPort_FindUserByID_Call{Call: _e.mock.On("FindUserByID", ctx, userID)}
+}
+
+func (_c *MockAuthRepositoryPort_FindUserByID_Call) Run(run func(ctx context.Context, userID string)) *MockAuthRepositoryPort_FindUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_FindUserByID_Call) Return(user *domain.User, err error) *MockAuthRepositoryPort_FindUserByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_FindUserByID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.User, error)) *MockAuthRepositoryPort_FindUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByUsername provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) FindUserByUsername(ctx context.Context, username string) (*domain.User, error) {
+	ret := _mock.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByUsername")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, username)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthRepositoryPort_FindUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByUsername'
+type MockAuthRepositoryPort_FindUserByUsername_Call struct {
+	*mock.Call
+}
+
+// FindUserByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockAuthRepositoryPort_Expecter) FindUserByUsername(ctx interface{}, username interface{}) *MockAuthRepositoryPort_FindUserByUsername_Call {
+	return &MockAuthRepositoryPort_FindUserByUsername_Call{Call: _e.mock.On("FindUserByUsername", ctx, username)}
+}
+
+func (_c *MockAuthRepositoryPort_FindUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockAuthRepositoryPort_FindUserByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_FindUserByUsername_Call) Return(user *domain.User, err error) *MockAuthRepositoryPort_FindUserByUsername_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_FindUserByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*domain.User, error)) *MockAuthRepositoryPort_FindUserByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsers provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) ListUsers(ctx context.Context, opts domain.UserListOptions) ([]domain.User, int, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 []domain.User
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserListOptions) ([]domain.User, int, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserListOptions) []domain.User); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserListOptions) int); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.UserListOptions) error); ok {
+		r2 = returnFunc(ctx, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAuthRepositoryPort_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockAuthRepositoryPort_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts domain.UserListOptions
+func (_e *MockAuthRepositoryPort_Expecter) ListUsers(ctx interface{}, opts interface{}) *MockAuthRepositoryPort_ListUsers_Call {
+	return &MockAuthRepositoryPort_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, opts)}
+}
+
+func (_c *MockAuthRepositoryPort_ListUsers_Call) Run(run func(ctx context.Context, opts domain.UserListOptions)) *MockAuthRepositoryPort_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserListOptions
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_ListUsers_Call) Return(users []domain.User, n int, err error) *MockAuthRepositoryPort_ListUsers_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_ListUsers_Call) RunAndReturn(run func(ctx context.Context, opts domain.UserListOptions) ([]domain.User, int, error)) *MockAuthRepositoryPort_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailVerified provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) MarkEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error {
+	ret := _mock.Called(ctx, userID, verifiedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailVerified")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, verifiedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepositoryPort_MarkEmailVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailVerified'
+type MockAuthRepositoryPort_MarkEmailVerified_Call struct {
+	*mock.Call
+}
+
+// MarkEmailVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - verifiedAt time.Time
+func (_e *MockAuthRepositoryPort_Expecter) MarkEmailVerified(ctx interface{}, userID interface{}, verifiedAt interface{}) *MockAuthRepositoryPort_MarkEmailVerified_Call {
+	return &MockAuthRepositoryPort_MarkEmailVerified_Call{Call: _e.mock.On("MarkEmailVerified", ctx, userID, verifiedAt)}
+}
+
+func (_c *MockAuthRepositoryPort_MarkEmailVerified_Call) Run(run func(ctx context.Context, userID string, verifiedAt time.Time)) *MockAuthRepositoryPort_MarkEmailVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_MarkEmailVerified_Call) Return(err error) *MockAuthRepositoryPort_MarkEmailVerified_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_MarkEmailVerified_Call) RunAndReturn(run func(ctx context.Context, userID string, verifiedAt time.Time) error) *MockAuthRepositoryPort_MarkEmailVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserSuspended provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) SetUserSuspended(ctx context.Context, userID string, suspendedAt *time.Time) error {
+	ret := _mock.Called(ctx, userID, suspendedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserSuspended")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, suspendedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepositoryPort_SetUserSuspended_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserSuspended'
+type MockAuthRepositoryPort_SetUserSuspended_Call struct {
+	*mock.Call
+}
+
+// SetUserSuspended is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - suspendedAt *time.Time
+func (_e *MockAuthRepositoryPort_Expecter) SetUserSuspended(ctx interface{}, userID interface{}, suspendedAt interface{}) *MockAuthRepositoryPort_SetUserSuspended_Call {
+	return &MockAuthRepositoryPort_SetUserSuspended_Call{Call: _e.mock.On("SetUserSuspended", ctx, userID, suspendedAt)}
+}
+
+func (_c *MockAuthRepositoryPort_SetUserSuspended_Call) Run(run func(ctx context.Context, userID string, suspendedAt *time.Time)) *MockAuthRepositoryPort_SetUserSuspended_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *time.Time
+		if args[2] != nil {
+			arg2 = args[2].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_SetUserSuspended_Call) Return(err error) *MockAuthRepositoryPort_SetUserSuspended_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_SetUserSuspended_Call) RunAndReturn(run func(ctx context.Context, userID string, suspendedAt *time.Time) error) *MockAuthRepositoryPort_SetUserSuspended_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserPassword provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) UpdateUserPassword(ctx context.Context, userID string, passwordHash string) error {
+	ret := _mock.Called(ctx, userID, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserPassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepositoryPort_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
+type MockAuthRepositoryPort_UpdateUserPassword_Call struct {
+	*mock.Call
+}
+
+// UpdateUserPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - passwordHash string
+func (_e *MockAuthRepositoryPort_Expecter) UpdateUserPassword(ctx interface{}, userID interface{}, passwordHash interface{}) *MockAuthRepositoryPort_UpdateUserPassword_Call {
+	return &MockAuthRepositoryPort_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, userID, passwordHash)}
+}
+
+func (_c *MockAuthRepositoryPort_UpdateUserPassword_Call) Run(run func(ctx context.Context, userID string, passwordHash string)) *MockAuthRepositoryPort_UpdateUserPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_UpdateUserPassword_Call) Return(err error) *MockAuthRepositoryPort_UpdateUserPassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_UpdateUserPassword_Call) RunAndReturn(run func(ctx context.Context, userID string, passwordHash string) error) *MockAuthRepositoryPort_UpdateUserPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserRole provides a mock function for the type MockAuthRepositoryPort
+func (_mock *MockAuthRepositoryPort) UpdateUserRole(ctx context.Context, userID string, role string) error {
+	ret := _mock.Called(ctx, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthRepositoryPort_UpdateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRole'
+type MockAuthRepositoryPort_UpdateUserRole_Call struct {
+	*mock.Call
+}
+
+// UpdateUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - role string
+func (_e *MockAuthRepositoryPort_Expecter) UpdateUserRole(ctx interface{}, userID interface{}, role interface{}) *MockAuthRepositoryPort_UpdateUserRole_Call {
+	return &MockAuthRepositoryPort_UpdateUserRole_Call{Call: _e.mock.On("UpdateUserRole", ctx, userID, role)}
+}
+
+func (_c *MockAuthRepositoryPort_UpdateUserRole_Call) Run(run func(ctx context.Context, userID string, role string)) *MockAuthRepositoryPort_UpdateUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_UpdateUserRole_Call) Return(err error) *MockAuthRepositoryPort_UpdateUserRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthRepositoryPort_UpdateUserRole_Call) RunAndReturn(run func(ctx context.Context, userID string, role string) error) *MockAuthRepositoryPort_UpdateUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRefreshTokenRepositoryPort creates a new instance of MockRefreshTokenRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRefreshTokenRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRefreshTokenRepositoryPort {
+	mock := &MockRefreshTokenRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRefreshTokenRepositoryPort is an autogenerated mock type for the RefreshTokenRepositoryPort type
+type MockRefreshTokenRepositoryPort struct {
+	mock.Mock
+}
+
+type MockRefreshTokenRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRefreshTokenRepositoryPort) EXPECT() *MockRefreshTokenRepositoryPort_Expecter {
+	return &MockRefreshTokenRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// CreateRefreshToken provides a mock function for the type MockRefreshTokenRepositoryPort
+func (_mock *MockRefreshTokenRepositoryPort) CreateRefreshToken(ctx context.Context, t *domain.RefreshToken) error {
+	ret := _mock.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepositoryPort_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
+type MockRefreshTokenRepositoryPort_CreateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// CreateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *domain.RefreshToken
+func (_e *MockRefreshTokenRepositoryPort_Expecter) CreateRefreshToken(ctx interface{}, t interface{}) *MockRefreshTokenRepositoryPort_CreateRefreshToken_Call {
+	return &MockRefreshTokenRepositoryPort_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, t)}
+}
+
+func (_c *MockRefreshTokenRepositoryPort_CreateRefreshToken_Call) Run(run func(ctx context.Context, t *domain.RefreshToken)) *MockRefreshTokenRepositoryPort_CreateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.RefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(*domain.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_CreateRefreshToken_Call) Return(err error) *MockRefreshTokenRepositoryPort_CreateRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_CreateRefreshToken_Call) RunAndReturn(run func(ctx context.Context, t *domain.RefreshToken) error) *MockRefreshTokenRepositoryPort_CreateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredRefreshTokenFamilies provides a mock function for the type MockRefreshTokenRepositoryPort
+func (_mock *MockRefreshTokenRepositoryPort) DeleteExpiredRefreshTokenFamilies(ctx context.Context, before time.Time) (int, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredRefreshTokenFamilies")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredRefreshTokenFamilies'
+type MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredRefreshTokenFamilies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockRefreshTokenRepositoryPort_Expecter) DeleteExpiredRefreshTokenFamilies(ctx interface{}, before interface{}) *MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call {
+	return &MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call{Call: _e.mock.On("DeleteExpiredRefreshTokenFamilies", ctx, before)}
+}
+
+func (_c *MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call) Run(run func(ctx context.Context, before time.Time)) *MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call) Return(n int, err error) *MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int, error)) *MockRefreshTokenRepositoryPort_DeleteExpiredRefreshTokenFamilies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRefreshTokenByHash provides a mock function for the type MockRefreshTokenRepositoryPort
+func (_mock *MockRefreshTokenRepositoryPort) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRefreshTokenByHash")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.RefreshToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.RefreshToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRefreshTokenByHash'
+type MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call struct {
+	*mock.Call
+}
+
+// FindRefreshTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockRefreshTokenRepositoryPort_Expecter) FindRefreshTokenByHash(ctx interface{}, tokenHash interface{}) *MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call {
+	return &MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call{Call: _e.mock.On("FindRefreshTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call) Return(refreshToken *domain.RefreshToken, err error) *MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)) *MockRefreshTokenRepositoryPort_FindRefreshTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRefreshTokenFamily provides a mock function for the type MockRefreshTokenRepositoryPort
+func (_mock *MockRefreshTokenRepositoryPort) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	ret := _mock.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRefreshTokenFamily")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRefreshTokenFamily'
+type MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeRefreshTokenFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID string
+func (_e *MockRefreshTokenRepositoryPort_Expecter) RevokeRefreshTokenFamily(ctx interface{}, familyID interface{}) *MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call {
+	return &MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call{Call: _e.mock.On("RevokeRefreshTokenFamily", ctx, familyID)}
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call) Run(run func(ctx context.Context, familyID string)) *MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call) Return(err error) *MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call) RunAndReturn(run func(ctx context.Context, familyID string) error) *MockRefreshTokenRepositoryPort_RevokeRefreshTokenFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserRefreshTokens provides a mock function for the type MockRefreshTokenRepositoryPort
+func (_mock *MockRefreshTokenRepositoryPort) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserRefreshTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserRefreshTokens'
+type MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRefreshTokenRepositoryPort_Expecter) RevokeUserRefreshTokens(ctx interface{}, userID interface{}) *MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call {
+	return &MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call{Call: _e.mock.On("RevokeUserRefreshTokens", ctx, userID)}
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call) Run(run func(ctx context.Context, userID string)) *MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call) Return(err error) *MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockRefreshTokenRepositoryPort_RevokeUserRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateRefreshToken provides a mock function for the type MockRefreshTokenRepositoryPort
+func (_mock *MockRefreshTokenRepositoryPort) RotateRefreshToken(ctx context.Context, oldID string, next *domain.RefreshToken) (bool, error) {
+	ret := _mock.Called(ctx, oldID, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateRefreshToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.RefreshToken) (bool, error)); ok {
+		return returnFunc(ctx, oldID, next)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.RefreshToken) bool); ok {
+		r0 = returnFunc(ctx, oldID, next)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *domain.RefreshToken) error); ok {
+		r1 = returnFunc(ctx, oldID, next)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepositoryPort_RotateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateRefreshToken'
+type MockRefreshTokenRepositoryPort_RotateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RotateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldID string
+//   - next *domain.RefreshToken
+func (_e *MockRefreshTokenRepositoryPort_Expecter) RotateRefreshToken(ctx interface{}, oldID interface{}, next interface{}) *MockRefreshTokenRepositoryPort_RotateRefreshToken_Call {
+	return &MockRefreshTokenRepositoryPort_RotateRefreshToken_Call{Call: _e.mock.On("RotateRefreshToken", ctx, oldID, next)}
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RotateRefreshToken_Call) Run(run func(ctx context.Context, oldID string, next *domain.RefreshToken)) *MockRefreshTokenRepositoryPort_RotateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *domain.RefreshToken
+		if args[2] != nil {
+			arg2 = args[2].(*domain.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RotateRefreshToken_Call) Return(b bool, err error) *MockRefreshTokenRepositoryPort_RotateRefreshToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepositoryPort_RotateRefreshToken_Call) RunAndReturn(run func(ctx context.Context, oldID string, next *domain.RefreshToken) (bool, error)) *MockRefreshTokenRepositoryPort_RotateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionRepositoryPort creates a new instance of MockSessionRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionRepositoryPort {
+	mock := &MockSessionRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionRepositoryPort is an autogenerated mock type for the SessionRepositoryPort type
+type MockSessionRepositoryPort struct {
+	mock.Mock
+}
+
+type MockSessionRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionRepositoryPort) EXPECT() *MockSessionRepositoryPort_Expecter {
+	return &MockSessionRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// CreateSession provides a mock function for the type MockSessionRepositoryPort
+func (_mock *MockSessionRepositoryPort) CreateSession(ctx context.Context, s *domain.Session) error {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Session) error); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepositoryPort_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type MockSessionRepositoryPort_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *domain.Session
+func (_e *MockSessionRepositoryPort_Expecter) CreateSession(ctx interface{}, s interface{}) *MockSessionRepositoryPort_CreateSession_Call {
+	return &MockSessionRepositoryPort_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, s)}
+}
+
+func (_c *MockSessionRepositoryPort_CreateSession_Call) Run(run func(ctx context.Context, s *domain.Session)) *MockSessionRepositoryPort_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Session
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_CreateSession_Call) Return(err error) *MockSessionRepositoryPort_CreateSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_CreateSession_Call) RunAndReturn(run func(ctx context.Context, s *domain.Session) error) *MockSessionRepositoryPort_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSessionsSeenBefore provides a mock function for the type MockSessionRepositoryPort
+func (_mock *MockSessionRepositoryPort) DeleteSessionsSeenBefore(ctx context.Context, before time.Time) (int, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSessionsSeenBefore")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSessionsSeenBefore'
+type MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteSessionsSeenBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockSessionRepositoryPort_Expecter) DeleteSessionsSeenBefore(ctx interface{}, before interface{}) *MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call {
+	return &MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call{Call: _e.mock.On("DeleteSessionsSeenBefore", ctx, before)}
+}
+
+func (_c *MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call) Return(n int, err error) *MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int, error)) *MockSessionRepositoryPort_DeleteSessionsSeenBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindActiveSessionsByUserID provides a mock function for the type MockSessionRepositoryPort
+func (_mock *MockSessionRepositoryPort) FindActiveSessionsByUserID(ctx context.Context, userID string, seenAfter time.Time) ([]domain.Session, error) {
+	ret := _mock.Called(ctx, userID, seenAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActiveSessionsByUserID")
+	}
+
+	var r0 []domain.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]domain.Session, error)); ok {
+		return returnFunc(ctx, userID, seenAfter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) []domain.Session); ok {
+		r0 = returnFunc(ctx, userID, seenAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, seenAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepositoryPort_FindActiveSessionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActiveSessionsByUserID'
+type MockSessionRepositoryPort_FindActiveSessionsByUserID_Call struct {
+	*mock.Call
+}
+
+// FindActiveSessionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - seenAfter time.Time
+func (_e *MockSessionRepositoryPort_Expecter) FindActiveSessionsByUserID(ctx interface{}, userID interface{}, seenAfter interface{}) *MockSessionRepositoryPort_FindActiveSessionsByUserID_Call {
+	return &MockSessionRepositoryPort_FindActiveSessionsByUserID_Call{Call: _e.mock.On("FindActiveSessionsByUserID", ctx, userID, seenAfter)}
+}
+
+func (_c *MockSessionRepositoryPort_FindActiveSessionsByUserID_Call) Run(run func(ctx context.Context, userID string, seenAfter time.Time)) *MockSessionRepositoryPort_FindActiveSessionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_FindActiveSessionsByUserID_Call) Return(sessions []domain.Session, err error) *MockSessionRepositoryPort_FindActiveSessionsByUserID_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_FindActiveSessionsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string, seenAfter time.Time) ([]domain.Session, error)) *MockSessionRepositoryPort_FindActiveSessionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSessionByID provides a mock function for the type MockSessionRepositoryPort
+func (_mock *MockSessionRepositoryPort) FindSessionByID(ctx context.Context, sessionID string) (*domain.Session, error) {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSessionByID")
+	}
+
+	var r0 *domain.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Session, error)); ok {
+		return returnFunc(ctx, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Session); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepositoryPort_FindSessionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSessionByID'
+type MockSessionRepositoryPort_FindSessionByID_Call struct {
+	*mock.Call
+}
+
+// FindSessionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *MockSessionRepositoryPort_Expecter) FindSessionByID(ctx interface{}, sessionID interface{}) *MockSessionRepositoryPort_FindSessionByID_Call {
+	return &MockSessionRepositoryPort_FindSessionByID_Call{Call: _e.mock.On("FindSessionByID", ctx, sessionID)}
+}
+
+func (_c *MockSessionRepositoryPort_FindSessionByID_Call) Run(run func(ctx context.Context, sessionID string)) *MockSessionRepositoryPort_FindSessionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_FindSessionByID_Call) Return(session *domain.Session, err error) *MockSessionRepositoryPort_FindSessionByID_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_FindSessionByID_Call) RunAndReturn(run func(ctx context.Context, sessionID string) (*domain.Session, error)) *MockSessionRepositoryPort_FindSessionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSessionMFA provides a mock function for the type MockSessionRepositoryPort
+func (_mock *MockSessionRepositoryPort) MarkSessionMFA(ctx context.Context, sessionID string) error {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSessionMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepositoryPort_MarkSessionMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSessionMFA'
+type MockSessionRepositoryPort_MarkSessionMFA_Call struct {
+	*mock.Call
+}
+
+// MarkSessionMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *MockSessionRepositoryPort_Expecter) MarkSessionMFA(ctx interface{}, sessionID interface{}) *MockSessionRepositoryPort_MarkSessionMFA_Call {
+	return &MockSessionRepositoryPort_MarkSessionMFA_Call{Call: _e.mock.On("MarkSessionMFA", ctx, sessionID)}
+}
+
+func (_c *MockSessionRepositoryPort_MarkSessionMFA_Call) Run(run func(ctx context.Context, sessionID string)) *MockSessionRepositoryPort_MarkSessionMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_MarkSessionMFA_Call) Return(err error) *MockSessionRepositoryPort_MarkSessionMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_MarkSessionMFA_Call) RunAndReturn(run func(ctx context.Context, sessionID string) error) *MockSessionRepositoryPort_MarkSessionMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type MockSessionRepositoryPort
+func (_mock *MockSessionRepositoryPort) RevokeSession(ctx context.Context, sessionID string) error {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepositoryPort_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockSessionRepositoryPort_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *MockSessionRepositoryPort_Expecter) RevokeSession(ctx interface{}, sessionID interface{}) *MockSessionRepositoryPort_RevokeSession_Call {
+	return &MockSessionRepositoryPort_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, sessionID)}
+}
+
+func (_c *MockSessionRepositoryPort_RevokeSession_Call) Run(run func(ctx context.Context, sessionID string)) *MockSessionRepositoryPort_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_RevokeSession_Call) Return(err error) *MockSessionRepositoryPort_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, sessionID string) error) *MockSessionRepositoryPort_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserSessions provides a mock function for the type MockSessionRepositoryPort
+func (_mock *MockSessionRepositoryPort) RevokeUserSessions(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepositoryPort_RevokeUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserSessions'
+type MockSessionRepositoryPort_RevokeUserSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockSessionRepositoryPort_Expecter) RevokeUserSessions(ctx interface{}, userID interface{}) *MockSessionRepositoryPort_RevokeUserSessions_Call {
+	return &MockSessionRepositoryPort_RevokeUserSessions_Call{Call: _e.mock.On("RevokeUserSessions", ctx, userID)}
+}
+
+func (_c *MockSessionRepositoryPort_RevokeUserSessions_Call) Run(run func(ctx context.Context, userID string)) *MockSessionRepositoryPort_RevokeUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_RevokeUserSessions_Call) Return(err error) *MockSessionRepositoryPort_RevokeUserSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_RevokeUserSessions_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockSessionRepositoryPort_RevokeUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchSession provides a mock function for the type MockSessionRepositoryPort
+func (_mock *MockSessionRepositoryPort) TouchSession(ctx context.Context, sessionID string, seenAt time.Time) error {
+	ret := _mock.Called(ctx, sessionID, seenAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, sessionID, seenAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepositoryPort_TouchSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchSession'
+type MockSessionRepositoryPort_TouchSession_Call struct {
+	*mock.Call
+}
+
+// TouchSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+//   - seenAt time.Time
+func (_e *MockSessionRepositoryPort_Expecter) TouchSession(ctx interface{}, sessionID interface{}, seenAt interface{}) *MockSessionRepositoryPort_TouchSession_Call {
+	return &MockSessionRepositoryPort_TouchSession_Call{Call: _e.mock.On("TouchSession", ctx, sessionID, seenAt)}
+}
+
+func (_c *MockSessionRepositoryPort_TouchSession_Call) Run(run func(ctx context.Context, sessionID string, seenAt time.Time)) *MockSessionRepositoryPort_TouchSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_TouchSession_Call) Return(err error) *MockSessionRepositoryPort_TouchSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepositoryPort_TouchSession_Call) RunAndReturn(run func(ctx context.Context, sessionID string, seenAt time.Time) error) *MockSessionRepositoryPort_TouchSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPasswordResetServicePort creates a new instance of MockPasswordResetServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPasswordResetServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPasswordResetServicePort {
+	mock := &MockPasswordResetServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPasswordResetServicePort is an autogenerated mock type for the PasswordResetServicePort type
+type MockPasswordResetServicePort struct {
+	mock.Mock
+}
+
+type MockPasswordResetServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPasswordResetServicePort) EXPECT() *MockPasswordResetServicePort_Expecter {
+	return &MockPasswordResetServicePort_Expecter{mock: &_m.Mock}
+}
+
+// ForgotPassword provides a mock function for the type MockPasswordResetServicePort
+func (_mock *MockPasswordResetServicePort) ForgotPassword(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgotPassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetServicePort_ForgotPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgotPassword'
+type MockPasswordResetServicePort_ForgotPassword_Call struct {
+	*mock.Call
+}
+
+// ForgotPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockPasswordResetServicePort_Expecter) ForgotPassword(ctx interface{}, email interface{}) *MockPasswordResetServicePort_ForgotPassword_Call {
+	return &MockPasswordResetServicePort_ForgotPassword_Call{Call: _e.mock.On("ForgotPassword", ctx, email)}
+}
+
+func (_c *MockPasswordResetServicePort_ForgotPassword_Call) Run(run func(ctx context.Context, email string)) *MockPasswordResetServicePort_ForgotPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetServicePort_ForgotPassword_Call) Return(err error) *MockPasswordResetServicePort_ForgotPassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetServicePort_ForgotPassword_Call) RunAndReturn(run func(ctx context.Context, email string) error) *MockPasswordResetServicePort_ForgotPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function for the type MockPasswordResetServicePort
+func (_mock *MockPasswordResetServicePort) ResetPassword(ctx context.Context, req *domain.ResetPasswordReq) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ResetPasswordReq) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetServicePort_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type MockPasswordResetServicePort_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.ResetPasswordReq
+func (_e *MockPasswordResetServicePort_Expecter) ResetPassword(ctx interface{}, req interface{}) *MockPasswordResetServicePort_ResetPassword_Call {
+	return &MockPasswordResetServicePort_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, req)}
+}
+
+func (_c *MockPasswordResetServicePort_ResetPassword_Call) Run(run func(ctx context.Context, req *domain.ResetPasswordReq)) *MockPasswordResetServicePort_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ResetPasswordReq
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ResetPasswordReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetServicePort_ResetPassword_Call) Return(err error) *MockPasswordResetServicePort_ResetPassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetServicePort_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, req *domain.ResetPasswordReq) error) *MockPasswordResetServicePort_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPasswordResetRepositoryPort creates a new instance of MockPasswordResetRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPasswordResetRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPasswordResetRepositoryPort {
+	mock := &MockPasswordResetRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPasswordResetRepositoryPort is an autogenerated mock type for the PasswordResetRepositoryPort type
+type MockPasswordResetRepositoryPort struct {
+	mock.Mock
+}
+
+type MockPasswordResetRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPasswordResetRepositoryPort) EXPECT() *MockPasswordResetRepositoryPort_Expecter {
+	return &MockPasswordResetRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// ConsumePasswordResetToken provides a mock function for the type MockPasswordResetRepositoryPort
+func (_mock *MockPasswordResetRepositoryPort) ConsumePasswordResetToken(ctx context.Context, tokenID string) (bool, error) {
+	ret := _mock.Called(ctx, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumePasswordResetToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, tokenID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, tokenID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumePasswordResetToken'
+type MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// ConsumePasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+func (_e *MockPasswordResetRepositoryPort_Expecter) ConsumePasswordResetToken(ctx interface{}, tokenID interface{}) *MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call {
+	return &MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call{Call: _e.mock.On("ConsumePasswordResetToken", ctx, tokenID)}
+}
+
+func (_c *MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call) Run(run func(ctx context.Context, tokenID string)) *MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call) Return(b bool, err error) *MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string) (bool, error)) *MockPasswordResetRepositoryPort_ConsumePasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePasswordResetToken provides a mock function for the type MockPasswordResetRepositoryPort
+func (_mock *MockPasswordResetRepositoryPort) CreatePasswordResetToken(ctx context.Context, t *domain.PasswordResetToken) error {
+	ret := _mock.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePasswordResetToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PasswordResetToken) error); ok {
+		r0 = returnFunc(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePasswordResetToken'
+type MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// CreatePasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *domain.PasswordResetToken
+func (_e *MockPasswordResetRepositoryPort_Expecter) CreatePasswordResetToken(ctx interface{}, t interface{}) *MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call {
+	return &MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call{Call: _e.mock.On("CreatePasswordResetToken", ctx, t)}
+}
+
+func (_c *MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call) Run(run func(ctx context.Context, t *domain.PasswordResetToken)) *MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PasswordResetToken
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PasswordResetToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call) Return(err error) *MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call) RunAndReturn(run func(ctx context.Context, t *domain.PasswordResetToken) error) *MockPasswordResetRepositoryPort_CreatePasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserPasswordResetTokens provides a mock function for the type MockPasswordResetRepositoryPort
+func (_mock *MockPasswordResetRepositoryPort) DeleteUserPasswordResetTokens(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserPasswordResetTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserPasswordResetTokens'
+type MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteUserPasswordResetTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockPasswordResetRepositoryPort_Expecter) DeleteUserPasswordResetTokens(ctx interface{}, userID interface{}) *MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call {
+	return &MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call{Call: _e.mock.On("DeleteUserPasswordResetTokens", ctx, userID)}
+}
+
+func (_c *MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call) Run(run func(ctx context.Context, userID string)) *MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call) Return(err error) *MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockPasswordResetRepositoryPort_DeleteUserPasswordResetTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPasswordResetTokenByHash provides a mock function for the type MockPasswordResetRepositoryPort
+func (_mock *MockPasswordResetRepositoryPort) FindPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPasswordResetTokenByHash")
+	}
+
+	var r0 *domain.PasswordResetToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.PasswordResetToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.PasswordResetToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PasswordResetToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPasswordResetTokenByHash'
+type MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call struct {
+	*mock.Call
+}
+
+// FindPasswordResetTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockPasswordResetRepositoryPort_Expecter) FindPasswordResetTokenByHash(ctx interface{}, tokenHash interface{}) *MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call {
+	return &MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call{Call: _e.mock.On("FindPasswordResetTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call) Return(passwordResetToken *domain.PasswordResetToken, err error) *MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call {
+	_c.Call.Return(passwordResetToken, err)
+	return _c
+}
+
+func (_c *MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error)) *MockPasswordResetRepositoryPort_FindPasswordResetTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEmailVerificationServicePort creates a new instance of MockEmailVerificationServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEmailVerificationServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEmailVerificationServicePort {
+	mock := &MockEmailVerificationServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEmailVerificationServicePort is an autogenerated mock type for the EmailVerificationServicePort type
+type MockEmailVerificationServicePort struct {
+	mock.Mock
+}
+
+type MockEmailVerificationServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEmailVerificationServicePort) EXPECT() *MockEmailVerificationServicePort_Expecter {
+	return &MockEmailVerificationServicePort_Expecter{mock: &_m.Mock}
+}
+
+// SendVerification provides a mock function for the type MockEmailVerificationServicePort
+func (_mock *MockEmailVerificationServicePort) SendVerification(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendVerification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEmailVerificationServicePort_SendVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendVerification'
+type MockEmailVerificationServicePort_SendVerification_Call struct {
+	*mock.Call
+}
+
+// SendVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockEmailVerificationServicePort_Expecter) SendVerification(ctx interface{}, userID interface{}) *MockEmailVerificationServicePort_SendVerification_Call {
+	return &MockEmailVerificationServicePort_SendVerification_Call{Call: _e.mock.On("SendVerification", ctx, userID)}
+}
+
+func (_c *MockEmailVerificationServicePort_SendVerification_Call) Run(run func(ctx context.Context, userID string)) *MockEmailVerificationServicePort_SendVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmailVerificationServicePort_SendVerification_Call) Return(err error) *MockEmailVerificationServicePort_SendVerification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEmailVerificationServicePort_SendVerification_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockEmailVerificationServicePort_SendVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmail provides a mock function for the type MockEmailVerificationServicePort
+func (_mock *MockEmailVerificationServicePort) VerifyEmail(ctx context.Context, rawToken string) error {
+	ret := _mock.Called(ctx, rawToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, rawToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEmailVerificationServicePort_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type MockEmailVerificationServicePort_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rawToken string
+func (_e *MockEmailVerificationServicePort_Expecter) VerifyEmail(ctx interface{}, rawToken interface{}) *MockEmailVerificationServicePort_VerifyEmail_Call {
+	return &MockEmailVerificationServicePort_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, rawToken)}
+}
+
+func (_c *MockEmailVerificationServicePort_VerifyEmail_Call) Run(run func(ctx context.Context, rawToken string)) *MockEmailVerificationServicePort_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmailVerificationServicePort_VerifyEmail_Call) Return(err error) *MockEmailVerificationServicePort_VerifyEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEmailVerificationServicePort_VerifyEmail_Call) RunAndReturn(run func(ctx context.Context, rawToken string) error) *MockEmailVerificationServicePort_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEmailVerificationRepositoryPort creates a new instance of MockEmailVerificationRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEmailVerificationRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEmailVerificationRepositoryPort {
+	mock := &MockEmailVerificationRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEmailVerificationRepositoryPort is an autogenerated mock type for the EmailVerificationRepositoryPort type
+type MockEmailVerificationRepositoryPort struct {
+	mock.Mock
+}
+
+type MockEmailVerificationRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEmailVerificationRepositoryPort) EXPECT() *MockEmailVerificationRepositoryPort_Expecter {
+	return &MockEmailVerificationRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// ConsumeEmailVerificationToken provides a mock function for the type MockEmailVerificationRepositoryPort
+func (_mock *MockEmailVerificationRepositoryPort) ConsumeEmailVerificationToken(ctx context.Context, tokenID string) (bool, error) {
+	ret := _mock.Called(ctx, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeEmailVerificationToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, tokenID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, tokenID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeEmailVerificationToken'
+type MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call struct {
+	*mock.Call
+}
+
+// ConsumeEmailVerificationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+func (_e *MockEmailVerificationRepositoryPort_Expecter) ConsumeEmailVerificationToken(ctx interface{}, tokenID interface{}) *MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call {
+	return &MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call{Call: _e.mock.On("ConsumeEmailVerificationToken", ctx, tokenID)}
+}
+
+func (_c *MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call) Run(run func(ctx context.Context, tokenID string)) *MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call) Return(b bool, err error) *MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call) RunAndReturn(run func(ctx context.Context, tokenID string) (bool, error)) *MockEmailVerificationRepositoryPort_ConsumeEmailVerificationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEmailVerificationToken provides a mock function for the type MockEmailVerificationRepositoryPort
+func (_mock *MockEmailVerificationRepositoryPort) CreateEmailVerificationToken(ctx context.Context, t *domain.EmailVerificationToken) error {
+	ret := _mock.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmailVerificationToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.EmailVerificationToken) error); ok {
+		r0 = returnFunc(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmailVerificationToken'
+type MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call struct {
+	*mock.Call
+}
+
+// CreateEmailVerificationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *domain.EmailVerificationToken
+func (_e *MockEmailVerificationRepositoryPort_Expecter) CreateEmailVerificationToken(ctx interface{}, t interface{}) *MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call {
+	return &MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call{Call: _e.mock.On("CreateEmailVerificationToken", ctx, t)}
+}
+
+func (_c *MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call) Run(run func(ctx context.Context, t *domain.EmailVerificationToken)) *MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.EmailVerificationToken
+		if args[1] != nil {
+			arg1 = args[1].(*domain.EmailVerificationToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call) Return(err error) *MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call) RunAndReturn(run func(ctx context.Context, t *domain.EmailVerificationToken) error) *MockEmailVerificationRepositoryPort_CreateEmailVerificationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindEmailVerificationTokenByHash provides a mock function for the type MockEmailVerificationRepositoryPort
+func (_mock *MockEmailVerificationRepositoryPort) FindEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindEmailVerificationTokenByHash")
+	}
+
+	var r0 *domain.EmailVerificationToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EmailVerificationToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EmailVerificationToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EmailVerificationToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindEmailVerificationTokenByHash'
+type MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call struct {
+	*mock.Call
+}
+
+// FindEmailVerificationTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockEmailVerificationRepositoryPort_Expecter) FindEmailVerificationTokenByHash(ctx interface{}, tokenHash interface{}) *MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call {
+	return &MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call{Call: _e.mock.On("FindEmailVerificationTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call) Return(emailVerificationToken *domain.EmailVerificationToken, err error) *MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call {
+	_c.Call.Return(emailVerificationToken, err)
+	return _c
+}
+
+func (_c *MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error)) *MockEmailVerificationRepositoryPort_FindEmailVerificationTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLatestEmailVerificationToken provides a mock function for the type MockEmailVerificationRepositoryPort
+func (_mock *MockEmailVerificationRepositoryPort) FindLatestEmailVerificationToken(ctx context.Context, userID string) (*domain.EmailVerificationToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLatestEmailVerificationToken")
+	}
+
+	var r0 *domain.EmailVerificationToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EmailVerificationToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EmailVerificationToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EmailVerificationToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLatestEmailVerificationToken'
+type MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call struct {
+	*mock.Call
+}
+
+// FindLatestEmailVerificationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockEmailVerificationRepositoryPort_Expecter) FindLatestEmailVerificationToken(ctx interface{}, userID interface{}) *MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call {
+	return &MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call{Call: _e.mock.On("FindLatestEmailVerificationToken", ctx, userID)}
+}
+
+func (_c *MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call) Run(run func(ctx context.Context, userID string)) *MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call) Return(emailVerificationToken *domain.EmailVerificationToken, err error) *MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call {
+	_c.Call.Return(emailVerificationToken, err)
+	return _c
+}
+
+func (_c *MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.EmailVerificationToken, error)) *MockEmailVerificationRepositoryPort_FindLatestEmailVerificationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoginAttemptStorePort creates a new instance of MockLoginAttemptStorePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginAttemptStorePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginAttemptStorePort {
+	mock := &MockLoginAttemptStorePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLoginAttemptStorePort is an autogenerated mock type for the LoginAttemptStorePort type
+type MockLoginAttemptStorePort struct {
+	mock.Mock
+}
+
+type MockLoginAttemptStorePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginAttemptStorePort) EXPECT() *MockLoginAttemptStorePort_Expecter {
+	return &MockLoginAttemptStorePort_Expecter{mock: &_m.Mock}
+}
+
+// DeleteLoginAttemptsBefore provides a mock function for the type MockLoginAttemptStorePort
+func (_mock *MockLoginAttemptStorePort) DeleteLoginAttemptsBefore(ctx context.Context, before time.Time) (int, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLoginAttemptsBefore")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLoginAttemptsBefore'
+type MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteLoginAttemptsBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockLoginAttemptStorePort_Expecter) DeleteLoginAttemptsBefore(ctx interface{}, before interface{}) *MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call {
+	return &MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call{Call: _e.mock.On("DeleteLoginAttemptsBefore", ctx, before)}
+}
+
+func (_c *MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call) Return(n int, err error) *MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int, error)) *MockLoginAttemptStorePort_DeleteLoginAttemptsBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoginAttempts provides a mock function for the type MockLoginAttemptStorePort
+func (_mock *MockLoginAttemptStorePort) GetLoginAttempts(ctx context.Context, key string) (*domain.LoginAttempts, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoginAttempts")
+	}
+
+	var r0 *domain.LoginAttempts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.LoginAttempts, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.LoginAttempts); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginAttempts)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginAttemptStorePort_GetLoginAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoginAttempts'
+type MockLoginAttemptStorePort_GetLoginAttempts_Call struct {
+	*mock.Call
+}
+
+// GetLoginAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockLoginAttemptStorePort_Expecter) GetLoginAttempts(ctx interface{}, key interface{}) *MockLoginAttemptStorePort_GetLoginAttempts_Call {
+	return &MockLoginAttemptStorePort_GetLoginAttempts_Call{Call: _e.mock.On("GetLoginAttempts", ctx, key)}
+}
+
+func (_c *MockLoginAttemptStorePort_GetLoginAttempts_Call) Run(run func(ctx context.Context, key string)) *MockLoginAttemptStorePort_GetLoginAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptStorePort_GetLoginAttempts_Call) Return(loginAttempts *domain.LoginAttempts, err error) *MockLoginAttemptStorePort_GetLoginAttempts_Call {
+	_c.Call.Return(loginAttempts, err)
+	return _c
+}
+
+func (_c *MockLoginAttemptStorePort_GetLoginAttempts_Call) RunAndReturn(run func(ctx context.Context, key string) (*domain.LoginAttempts, error)) *MockLoginAttemptStorePort_GetLoginAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordLoginFailure provides a mock function for the type MockLoginAttemptStorePort
+func (_mock *MockLoginAttemptStorePort) RecordLoginFailure(ctx context.Context, key string, at time.Time, resetBefore time.Time) error {
+	ret := _mock.Called(ctx, key, at, resetBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordLoginFailure")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, key, at, resetBefore)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginAttemptStorePort_RecordLoginFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordLoginFailure'
+type MockLoginAttemptStorePort_RecordLoginFailure_Call struct {
+	*mock.Call
+}
+
+// RecordLoginFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - at time.Time
+//   - resetBefore time.Time
+func (_e *MockLoginAttemptStorePort_Expecter) RecordLoginFailure(ctx interface{}, key interface{}, at interface{}, resetBefore interface{}) *MockLoginAttemptStorePort_RecordLoginFailure_Call {
+	return &MockLoginAttemptStorePort_RecordLoginFailure_Call{Call: _e.mock.On("RecordLoginFailure", ctx, key, at, resetBefore)}
+}
+
+func (_c *MockLoginAttemptStorePort_RecordLoginFailure_Call) Run(run func(ctx context.Context, key string, at time.Time, resetBefore time.Time)) *MockLoginAttemptStorePort_RecordLoginFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptStorePort_RecordLoginFailure_Call) Return(err error) *MockLoginAttemptStorePort_RecordLoginFailure_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginAttemptStorePort_RecordLoginFailure_Call) RunAndReturn(run func(ctx context.Context, key string, at time.Time, resetBefore time.Time) error) *MockLoginAttemptStorePort_RecordLoginFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetLoginAttempts provides a mock function for the type MockLoginAttemptStorePort
+func (_mock *MockLoginAttemptStorePort) ResetLoginAttempts(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetLoginAttempts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoginAttemptStorePort_ResetLoginAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetLoginAttempts'
+type MockLoginAttemptStorePort_ResetLoginAttempts_Call struct {
+	*mock.Call
+}
+
+// ResetLoginAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockLoginAttemptStorePort_Expecter) ResetLoginAttempts(ctx interface{}, key interface{}) *MockLoginAttemptStorePort_ResetLoginAttempts_Call {
+	return &MockLoginAttemptStorePort_ResetLoginAttempts_Call{Call: _e.mock.On("ResetLoginAttempts", ctx, key)}
+}
+
+func (_c *MockLoginAttemptStorePort_ResetLoginAttempts_Call) Run(run func(ctx context.Context, key string)) *MockLoginAttemptStorePort_ResetLoginAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptStorePort_ResetLoginAttempts_Call) Return(err error) *MockLoginAttemptStorePort_ResetLoginAttempts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoginAttemptStorePort_ResetLoginAttempts_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockLoginAttemptStorePort_ResetLoginAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMailerPort creates a new instance of MockMailerPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMailerPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMailerPort {
+	mock := &MockMailerPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMailerPort is an autogenerated mock type for the MailerPort type
+type MockMailerPort struct {
+	mock.Mock
+}
+
+type MockMailerPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMailerPort) EXPECT() *MockMailerPort_Expecter {
+	return &MockMailerPort_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockMailerPort
+func (_mock *MockMailerPort) Send(ctx context.Context, m domain.Mail) error {
+	ret := _mock.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Mail) error); ok {
+		r0 = returnFunc(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMailerPort_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockMailerPort_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - m domain.Mail
+func (_e *MockMailerPort_Expecter) Send(ctx interface{}, m interface{}) *MockMailerPort_Send_Call {
+	return &MockMailerPort_Send_Call{Call: _e.mock.On("Send", ctx, m)}
+}
+
+func (_c *MockMailerPort_Send_Call) Run(run func(ctx context.Context, m domain.Mail)) *MockMailerPort_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Mail
+		if args[1] != nil {
+			arg1 = args[1].(domain.Mail)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMailerPort_Send_Call) Return(err error) *MockMailerPort_Send_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMailerPort_Send_Call) RunAndReturn(run func(ctx context.Context, m domain.Mail) error) *MockMailerPort_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMFAServicePort creates a new instance of MockMFAServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMFAServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMFAServicePort {
+	mock := &MockMFAServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMFAServicePort is an autogenerated mock type for the MFAServicePort type
+type MockMFAServicePort struct {
+	mock.Mock
+}
+
+type MockMFAServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMFAServicePort) EXPECT() *MockMFAServicePort_Expecter {
+	return &MockMFAServicePort_Expecter{mock: &_m.Mock}
+}
+
+// Disable provides a mock function for the type MockMFAServicePort
+func (_mock *MockMFAServicePort) Disable(ctx context.Context, userID string, req *domain.DisableMFAReq) error {
+	ret := _mock.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disable")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.DisableMFAReq) error); ok {
+		r0 = returnFunc(ctx, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMFAServicePort_Disable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disable'
+type MockMFAServicePort_Disable_Call struct {
+	*mock.Call
+}
+
+// Disable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - req *domain.DisableMFAReq
+func (_e *MockMFAServicePort_Expecter) Disable(ctx interface{}, userID interface{}, req interface{}) *MockMFAServicePort_Disable_Call {
+	return &MockMFAServicePort_Disable_Call{Call: _e.mock.On("Disable", ctx, userID, req)}
+}
+
+func (_c *MockMFAServicePort_Disable_Call) Run(run func(ctx context.Context, userID string, req *domain.DisableMFAReq)) *MockMFAServicePort_Disable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *domain.DisableMFAReq
+		if args[2] != nil {
+			arg2 = args[2].(*domain.DisableMFAReq)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFAServicePort_Disable_Call) Return(err error) *MockMFAServicePort_Disable_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMFAServicePort_Disable_Call) RunAndReturn(run func(ctx context.Context, userID string, req *domain.DisableMFAReq) error) *MockMFAServicePort_Disable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enable provides a mock function for the type MockMFAServicePort
+func (_mock *MockMFAServicePort) Enable(ctx context.Context, userID string, sessionID string, code string) (*domain.EnableMFARes, error) {
+	ret := _mock.Called(ctx, userID, sessionID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enable")
+	}
+
+	var r0 *domain.EnableMFARes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.EnableMFARes, error)); ok {
+		return returnFunc(ctx, userID, sessionID, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.EnableMFARes); ok {
+		r0 = returnFunc(ctx, userID, sessionID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EnableMFARes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, sessionID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMFAServicePort_Enable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enable'
+type MockMFAServicePort_Enable_Call struct {
+	*mock.Call
+}
+
+// Enable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sessionID string
+//   - code string
+func (_e *MockMFAServicePort_Expecter) Enable(ctx interface{}, userID interface{}, sessionID interface{}, code interface{}) *MockMFAServicePort_Enable_Call {
+	return &MockMFAServicePort_Enable_Call{Call: _e.mock.On("Enable", ctx, userID, sessionID, code)}
+}
+
+func (_c *MockMFAServicePort_Enable_Call) Run(run func(ctx context.Context, userID string, sessionID string, code string)) *MockMFAServicePort_Enable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFAServicePort_Enable_Call) Return(enableMFARes *domain.EnableMFARes, err error) *MockMFAServicePort_Enable_Call {
+	_c.Call.Return(enableMFARes, err)
+	return _c
+}
+
+func (_c *MockMFAServicePort_Enable_Call) RunAndReturn(run func(ctx context.Context, userID string, sessionID string, code string) (*domain.EnableMFARes, error)) *MockMFAServicePort_Enable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enroll provides a mock function for the type MockMFAServicePort
+func (_mock *MockMFAServicePort) Enroll(ctx context.Context, userID string) (*domain.MFAEnrollment, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enroll")
+	}
+
+	var r0 *domain.MFAEnrollment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.MFAEnrollment, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.MFAEnrollment); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MFAEnrollment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMFAServicePort_Enroll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enroll'
+type MockMFAServicePort_Enroll_Call struct {
+	*mock.Call
+}
+
+// Enroll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMFAServicePort_Expecter) Enroll(ctx interface{}, userID interface{}) *MockMFAServicePort_Enroll_Call {
+	return &MockMFAServicePort_Enroll_Call{Call: _e.mock.On("Enroll", ctx, userID)}
+}
+
+func (_c *MockMFAServicePort_Enroll_Call) Run(run func(ctx context.Context, userID string)) *MockMFAServicePort_Enroll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFAServicePort_Enroll_Call) Return(mFAEnrollment *domain.MFAEnrollment, err error) *MockMFAServicePort_Enroll_Call {
+	_c.Call.Return(mFAEnrollment, err)
+	return _c
+}
+
+func (_c *MockMFAServicePort_Enroll_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.MFAEnrollment, error)) *MockMFAServicePort_Enroll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsEnabled provides a mock function for the type MockMFAServicePort
+func (_mock *MockMFAServicePort) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsEnabled")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMFAServicePort_IsEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEnabled'
+type MockMFAServicePort_IsEnabled_Call struct {
+	*mock.Call
+}
+
+// IsEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMFAServicePort_Expecter) IsEnabled(ctx interface{}, userID interface{}) *MockMFAServicePort_IsEnabled_Call {
+	return &MockMFAServicePort_IsEnabled_Call{Call: _e.mock.On("IsEnabled", ctx, userID)}
+}
+
+func (_c *MockMFAServicePort_IsEnabled_Call) Run(run func(ctx context.Context, userID string)) *MockMFAServicePort_IsEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFAServicePort_IsEnabled_Call) Return(b bool, err error) *MockMFAServicePort_IsEnabled_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockMFAServicePort_IsEnabled_Call) RunAndReturn(run func(ctx context.Context, userID string) (bool, error)) *MockMFAServicePort_IsEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyCode provides a mock function for the type MockMFAServicePort
+func (_mock *MockMFAServicePort) VerifyCode(ctx context.Context, userID string, code string) error {
+	ret := _mock.Called(ctx, userID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMFAServicePort_VerifyCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyCode'
+type MockMFAServicePort_VerifyCode_Call struct {
+	*mock.Call
+}
+
+// VerifyCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - code string
+func (_e *MockMFAServicePort_Expecter) VerifyCode(ctx interface{}, userID interface{}, code interface{}) *MockMFAServicePort_VerifyCode_Call {
+	return &MockMFAServicePort_VerifyCode_Call{Call: _e.mock.On("VerifyCode", ctx, userID, code)}
+}
+
+func (_c *MockMFAServicePort_VerifyCode_Call) Run(run func(ctx context.Context, userID string, code string)) *MockMFAServicePort_VerifyCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFAServicePort_VerifyCode_Call) Return(err error) *MockMFAServicePort_VerifyCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMFAServicePort_VerifyCode_Call) RunAndReturn(run func(ctx context.Context, userID string, code string) error) *MockMFAServicePort_VerifyCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMFARepositoryPort creates a new instance of MockMFARepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMFARepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMFARepositoryPort {
+	mock := &MockMFARepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMFARepositoryPort is an autogenerated mock type for the MFARepositoryPort type
+type MockMFARepositoryPort struct {
+	mock.Mock
+}
+
+type MockMFARepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMFARepositoryPort) EXPECT() *MockMFARepositoryPort_Expecter {
+	return &MockMFARepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// ConsumeRecoveryCode provides a mock function for the type MockMFARepositoryPort
+func (_mock *MockMFARepositoryPort) ConsumeRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	ret := _mock.Called(ctx, userID, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeRecoveryCode")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, userID, codeHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, userID, codeHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, codeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMFARepositoryPort_ConsumeRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeRecoveryCode'
+type MockMFARepositoryPort_ConsumeRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// ConsumeRecoveryCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - codeHash string
+func (_e *MockMFARepositoryPort_Expecter) ConsumeRecoveryCode(ctx interface{}, userID interface{}, codeHash interface{}) *MockMFARepositoryPort_ConsumeRecoveryCode_Call {
+	return &MockMFARepositoryPort_ConsumeRecoveryCode_Call{Call: _e.mock.On("ConsumeRecoveryCode", ctx, userID, codeHash)}
+}
+
+func (_c *MockMFARepositoryPort_ConsumeRecoveryCode_Call) Run(run func(ctx context.Context, userID string, codeHash string)) *MockMFARepositoryPort_ConsumeRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_ConsumeRecoveryCode_Call) Return(b bool, err error) *MockMFARepositoryPort_ConsumeRecoveryCode_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_ConsumeRecoveryCode_Call) RunAndReturn(run func(ctx context.Context, userID string, codeHash string) (bool, error)) *MockMFARepositoryPort_ConsumeRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMFA provides a mock function for the type MockMFARepositoryPort
+func (_mock *MockMFARepositoryPort) DeleteMFA(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMFARepositoryPort_DeleteMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMFA'
+type MockMFARepositoryPort_DeleteMFA_Call struct {
+	*mock.Call
+}
+
+// DeleteMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMFARepositoryPort_Expecter) DeleteMFA(ctx interface{}, userID interface{}) *MockMFARepositoryPort_DeleteMFA_Call {
+	return &MockMFARepositoryPort_DeleteMFA_Call{Call: _e.mock.On("DeleteMFA", ctx, userID)}
+}
+
+func (_c *MockMFARepositoryPort_DeleteMFA_Call) Run(run func(ctx context.Context, userID string)) *MockMFARepositoryPort_DeleteMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_DeleteMFA_Call) Return(err error) *MockMFARepositoryPort_DeleteMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_DeleteMFA_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockMFARepositoryPort_DeleteMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableMFA provides a mock function for the type MockMFARepositoryPort
+func (_mock *MockMFARepositoryPort) EnableMFA(ctx context.Context, userID string, enabledAt time.Time, recoveryCodeHashes []string) error {
+	ret := _mock.Called(ctx, userID, enabledAt, recoveryCodeHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, []string) error); ok {
+		r0 = returnFunc(ctx, userID, enabledAt, recoveryCodeHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMFARepositoryPort_EnableMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableMFA'
+type MockMFARepositoryPort_EnableMFA_Call struct {
+	*mock.Call
+}
+
+// EnableMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - enabledAt time.Time
+//   - recoveryCodeHashes []string
+func (_e *MockMFARepositoryPort_Expecter) EnableMFA(ctx interface{}, userID interface{}, enabledAt interface{}, recoveryCodeHashes interface{}) *MockMFARepositoryPort_EnableMFA_Call {
+	return &MockMFARepositoryPort_EnableMFA_Call{Call: _e.mock.On("EnableMFA", ctx, userID, enabledAt, recoveryCodeHashes)}
+}
+
+func (_c *MockMFARepositoryPort_EnableMFA_Call) Run(run func(ctx context.Context, userID string, enabledAt time.Time, recoveryCodeHashes []string)) *MockMFARepositoryPort_EnableMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_EnableMFA_Call) Return(err error) *MockMFARepositoryPort_EnableMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_EnableMFA_Call) RunAndReturn(run func(ctx context.Context, userID string, enabledAt time.Time, recoveryCodeHashes []string) error) *MockMFARepositoryPort_EnableMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMFAByUserID provides a mock function for the type MockMFARepositoryPort
+func (_mock *MockMFARepositoryPort) FindMFAByUserID(ctx context.Context, userID string) (*domain.MFA, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMFAByUserID")
+	}
+
+	var r0 *domain.MFA
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.MFA, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.MFA); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MFA)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMFARepositoryPort_FindMFAByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMFAByUserID'
+type MockMFARepositoryPort_FindMFAByUserID_Call struct {
+	*mock.Call
+}
+
+// FindMFAByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMFARepositoryPort_Expecter) FindMFAByUserID(ctx interface{}, userID interface{}) *MockMFARepositoryPort_FindMFAByUserID_Call {
+	return &MockMFARepositoryPort_FindMFAByUserID_Call{Call: _e.mock.On("FindMFAByUserID", ctx, userID)}
+}
+
+func (_c *MockMFARepositoryPort_FindMFAByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockMFARepositoryPort_FindMFAByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_FindMFAByUserID_Call) Return(mFA *domain.MFA, err error) *MockMFARepositoryPort_FindMFAByUserID_Call {
+	_c.Call.Return(mFA, err)
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_FindMFAByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.MFA, error)) *MockMFARepositoryPort_FindMFAByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMFASecret provides a mock function for the type MockMFARepositoryPort
+func (_mock *MockMFARepositoryPort) SaveMFASecret(ctx context.Context, m *domain.MFA) error {
+	ret := _mock.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMFASecret")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.MFA) error); ok {
+		r0 = returnFunc(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMFARepositoryPort_SaveMFASecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMFASecret'
+type MockMFARepositoryPort_SaveMFASecret_Call struct {
+	*mock.Call
+}
+
+// SaveMFASecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - m *domain.MFA
+func (_e *MockMFARepositoryPort_Expecter) SaveMFASecret(ctx interface{}, m interface{}) *MockMFARepositoryPort_SaveMFASecret_Call {
+	return &MockMFARepositoryPort_SaveMFASecret_Call{Call: _e.mock.On("SaveMFASecret", ctx, m)}
+}
+
+func (_c *MockMFARepositoryPort_SaveMFASecret_Call) Run(run func(ctx context.Context, m *domain.MFA)) *MockMFARepositoryPort_SaveMFASecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.MFA
+		if args[1] != nil {
+			arg1 = args[1].(*domain.MFA)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_SaveMFASecret_Call) Return(err error) *MockMFARepositoryPort_SaveMFASecret_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_SaveMFASecret_Call) RunAndReturn(run func(ctx context.Context, m *domain.MFA) error) *MockMFARepositoryPort_SaveMFASecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseMFAStep provides a mock function for the type MockMFARepositoryPort
+func (_mock *MockMFARepositoryPort) UseMFAStep(ctx context.Context, userID string, step int64) (bool, error) {
+	ret := _mock.Called(ctx, userID, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseMFAStep")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) (bool, error)); ok {
+		return returnFunc(ctx, userID, step)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) bool); ok {
+		r0 = returnFunc(ctx, userID, step)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = returnFunc(ctx, userID, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMFARepositoryPort_UseMFAStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseMFAStep'
+type MockMFARepositoryPort_UseMFAStep_Call struct {
+	*mock.Call
+}
+
+// UseMFAStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - step int64
+func (_e *MockMFARepositoryPort_Expecter) UseMFAStep(ctx interface{}, userID interface{}, step interface{}) *MockMFARepositoryPort_UseMFAStep_Call {
+	return &MockMFARepositoryPort_UseMFAStep_Call{Call: _e.mock.On("UseMFAStep", ctx, userID, step)}
+}
+
+func (_c *MockMFARepositoryPort_UseMFAStep_Call) Run(run func(ctx context.Context, userID string, step int64)) *MockMFARepositoryPort_UseMFAStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_UseMFAStep_Call) Return(b bool, err error) *MockMFARepositoryPort_UseMFAStep_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockMFARepositoryPort_UseMFAStep_Call) RunAndReturn(run func(ctx context.Context, userID string, step int64) (bool, error)) *MockMFARepositoryPort_UseMFAStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOIDCProviderPort creates a new instance of MockOIDCProviderPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOIDCProviderPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOIDCProviderPort {
+	mock := &MockOIDCProviderPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOIDCProviderPort is an autogenerated mock type for the OIDCProviderPort type
+type MockOIDCProviderPort struct {
+	mock.Mock
+}
+
+type MockOIDCProviderPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOIDCProviderPort) EXPECT() *MockOIDCProviderPort_Expecter {
+	return &MockOIDCProviderPort_Expecter{mock: &_m.Mock}
+}
+
+// AuthCodeURL provides a mock function for the type MockOIDCProviderPort
+func (_mock *MockOIDCProviderPort) AuthCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	ret := _mock.Called(ctx, state, nonce, codeChallenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthCodeURL")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return returnFunc(ctx, state, nonce, codeChallenge)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = returnFunc(ctx, state, nonce, codeChallenge)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, state, nonce, codeChallenge)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOIDCProviderPort_AuthCodeURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthCodeURL'
+type MockOIDCProviderPort_AuthCodeURL_Call struct {
+	*mock.Call
+}
+
+// AuthCodeURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state string
+//   - nonce string
+//   - codeChallenge string
+func (_e *MockOIDCProviderPort_Expecter) AuthCodeURL(ctx interface{}, state interface{}, nonce interface{}, codeChallenge interface{}) *MockOIDCProviderPort_AuthCodeURL_Call {
+	return &MockOIDCProviderPort_AuthCodeURL_Call{Call: _e.mock.On("AuthCodeURL", ctx, state, nonce, codeChallenge)}
+}
+
+func (_c *MockOIDCProviderPort_AuthCodeURL_Call) Run(run func(ctx context.Context, state string, nonce string, codeChallenge string)) *MockOIDCProviderPort_AuthCodeURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOIDCProviderPort_AuthCodeURL_Call) Return(s string, err error) *MockOIDCProviderPort_AuthCodeURL_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockOIDCProviderPort_AuthCodeURL_Call) RunAndReturn(run func(ctx context.Context, state string, nonce string, codeChallenge string) (string, error)) *MockOIDCProviderPort_AuthCodeURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exchange provides a mock function for the type MockOIDCProviderPort
+func (_mock *MockOIDCProviderPort) Exchange(ctx context.Context, code string, codeVerifier string) (*domain.OIDCClaims, error) {
+	ret := _mock.Called(ctx, code, codeVerifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exchange")
+	}
+
+	var r0 *domain.OIDCClaims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.OIDCClaims, error)); ok {
+		return returnFunc(ctx, code, codeVerifier)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.OIDCClaims); ok {
+		r0 = returnFunc(ctx, code, codeVerifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OIDCClaims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, code, codeVerifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOIDCProviderPort_Exchange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exchange'
+type MockOIDCProviderPort_Exchange_Call struct {
+	*mock.Call
+}
+
+// Exchange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - codeVerifier string
+func (_e *MockOIDCProviderPort_Expecter) Exchange(ctx interface{}, code interface{}, codeVerifier interface{}) *MockOIDCProviderPort_Exchange_Call {
+	return &MockOIDCProviderPort_Exchange_Call{Call: _e.mock.On("Exchange", ctx, code, codeVerifier)}
+}
+
+func (_c *MockOIDCProviderPort_Exchange_Call) Run(run func(ctx context.Context, code string, codeVerifier string)) *MockOIDCProviderPort_Exchange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOIDCProviderPort_Exchange_Call) Return(oIDCClaims *domain.OIDCClaims, err error) *MockOIDCProviderPort_Exchange_Call {
+	_c.Call.Return(oIDCClaims, err)
+	return _c
+}
+
+func (_c *MockOIDCProviderPort_Exchange_Call) RunAndReturn(run func(ctx context.Context, code string, codeVerifier string) (*domain.OIDCClaims, error)) *MockOIDCProviderPort_Exchange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOIDCServicePort creates a new instance of MockOIDCServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOIDCServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOIDCServicePort {
+	mock := &MockOIDCServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOIDCServicePort is an autogenerated mock type for the OIDCServicePort type
+type MockOIDCServicePort struct {
+	mock.Mock
+}
+
+type MockOIDCServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOIDCServicePort) EXPECT() *MockOIDCServicePort_Expecter {
+	return &MockOIDCServicePort_Expecter{mock: &_m.Mock}
+}
+
+// Callback provides a mock function for the type MockOIDCServicePort
+func (_mock *MockOIDCServicePort) Callback(ctx context.Context, req *domain.OIDCCallbackReq) (*domain.UserLoginRes, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Callback")
+	}
+
+	var r0 *domain.UserLoginRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.OIDCCallbackReq) (*domain.UserLoginRes, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.OIDCCallbackReq) *domain.UserLoginRes); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserLoginRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.OIDCCallbackReq) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOIDCServicePort_Callback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Callback'
+type MockOIDCServicePort_Callback_Call struct {
+	*mock.Call
+}
+
+// Callback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.OIDCCallbackReq
+func (_e *MockOIDCServicePort_Expecter) Callback(ctx interface{}, req interface{}) *MockOIDCServicePort_Callback_Call {
+	return &MockOIDCServicePort_Callback_Call{Call: _e.mock.On("Callback", ctx, req)}
+}
+
+func (_c *MockOIDCServicePort_Callback_Call) Run(run func(ctx context.Context, req *domain.OIDCCallbackReq)) *MockOIDCServicePort_Callback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.OIDCCallbackReq
+		if args[1] != nil {
+			arg1 = args[1].(*domain.OIDCCallbackReq)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOIDCServicePort_Callback_Call) Return(userLoginRes *domain.UserLoginRes, err error) *MockOIDCServicePort_Callback_Call {
+	_c.Call.Return(userLoginRes, err)
+	return _c
+}
+
+func (_c *MockOIDCServicePort_Callback_Call) RunAndReturn(run func(ctx context.Context, req *domain.OIDCCallbackReq) (*domain.UserLoginRes, error)) *MockOIDCServicePort_Callback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartLogin provides a mock function for the type MockOIDCServicePort
+func (_mock *MockOIDCServicePort) StartLogin(ctx context.Context, provider string) (*domain.OIDCStart, error) {
+	ret := _mock.Called(ctx, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartLogin")
+	}
+
+	var r0 *domain.OIDCStart
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.OIDCStart, error)); ok {
+		return returnFunc(ctx, provider)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.OIDCStart); ok {
+		r0 = returnFunc(ctx, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OIDCStart)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOIDCServicePort_StartLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartLogin'
+type MockOIDCServicePort_StartLogin_Call struct {
+	*mock.Call
+}
+
+// StartLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+func (_e *MockOIDCServicePort_Expecter) StartLogin(ctx interface{}, provider interface{}) *MockOIDCServicePort_StartLogin_Call {
+	return &MockOIDCServicePort_StartLogin_Call{Call: _e.mock.On("StartLogin", ctx, provider)}
+}
+
+func (_c *MockOIDCServicePort_StartLogin_Call) Run(run func(ctx context.Context, provider string)) *MockOIDCServicePort_StartLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOIDCServicePort_StartLogin_Call) Return(oIDCStart *domain.OIDCStart, err error) *MockOIDCServicePort_StartLogin_Call {
+	_c.Call.Return(oIDCStart, err)
+	return _c
+}
+
+func (_c *MockOIDCServicePort_StartLogin_Call) RunAndReturn(run func(ctx context.Context, provider string) (*domain.OIDCStart, error)) *MockOIDCServicePort_StartLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdentityRepositoryPort creates a new instance of MockIdentityRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdentityRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdentityRepositoryPort {
+	mock := &MockIdentityRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdentityRepositoryPort is an autogenerated mock type for the IdentityRepositoryPort type
+type MockIdentityRepositoryPort struct {
+	mock.Mock
+}
+
+type MockIdentityRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdentityRepositoryPort) EXPECT() *MockIdentityRepositoryPort_Expecter {
+	return &MockIdentityRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// CreateUserWithIdentity provides a mock function for the type MockIdentityRepositoryPort
+func (_mock *MockIdentityRepositoryPort) CreateUserWithIdentity(ctx context.Context, u *domain.User, identity *domain.Identity) error {
+	ret := _mock.Called(ctx, u, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserWithIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.Identity) error); ok {
+		r0 = returnFunc(ctx, u, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdentityRepositoryPort_CreateUserWithIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserWithIdentity'
+type MockIdentityRepositoryPort_CreateUserWithIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateUserWithIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u *domain.User
+//   - identity *domain.Identity
+func (_e *MockIdentityRepositoryPort_Expecter) CreateUserWithIdentity(ctx interface{}, u interface{}, identity interface{}) *MockIdentityRepositoryPort_CreateUserWithIdentity_Call {
+	return &MockIdentityRepositoryPort_CreateUserWithIdentity_Call{Call: _e.mock.On("CreateUserWithIdentity", ctx, u, identity)}
+}
+
+func (_c *MockIdentityRepositoryPort_CreateUserWithIdentity_Call) Run(run func(ctx context.Context, u *domain.User, identity *domain.Identity)) *MockIdentityRepositoryPort_CreateUserWithIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.Identity
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Identity)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdentityRepositoryPort_CreateUserWithIdentity_Call) Return(err error) *MockIdentityRepositoryPort_CreateUserWithIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdentityRepositoryPort_CreateUserWithIdentity_Call) RunAndReturn(run func(ctx context.Context, u *domain.User, identity *domain.Identity) error) *MockIdentityRepositoryPort_CreateUserWithIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindIdentity provides a mock function for the type MockIdentityRepositoryPort
+func (_mock *MockIdentityRepositoryPort) FindIdentity(ctx context.Context, provider string, subject string) (*domain.Identity, error) {
+	ret := _mock.Called(ctx, provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindIdentity")
+	}
+
+	var r0 *domain.Identity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Identity, error)); ok {
+		return returnFunc(ctx, provider, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Identity); ok {
+		r0 = returnFunc(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Identity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdentityRepositoryPort_FindIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindIdentity'
+type MockIdentityRepositoryPort_FindIdentity_Call struct {
+	*mock.Call
+}
+
+// FindIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - subject string
+func (_e *MockIdentityRepositoryPort_Expecter) FindIdentity(ctx interface{}, provider interface{}, subject interface{}) *MockIdentityRepositoryPort_FindIdentity_Call {
+	return &MockIdentityRepositoryPort_FindIdentity_Call{Call: _e.mock.On("FindIdentity", ctx, provider, subject)}
+}
+
+func (_c *MockIdentityRepositoryPort_FindIdentity_Call) Run(run func(ctx context.Context, provider string, subject string)) *MockIdentityRepositoryPort_FindIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdentityRepositoryPort_FindIdentity_Call) Return(identity *domain.Identity, err error) *MockIdentityRepositoryPort_FindIdentity_Call {
+	_c.Call.Return(identity, err)
+	return _c
+}
+
+func (_c *MockIdentityRepositoryPort_FindIdentity_Call) RunAndReturn(run func(ctx context.Context, provider string, subject string) (*domain.Identity, error)) *MockIdentityRepositoryPort_FindIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchIdentity provides a mock function for the type MockIdentityRepositoryPort
+func (_mock *MockIdentityRepositoryPort) TouchIdentity(ctx context.Context, identityID string, loginAt time.Time) error {
+	ret := _mock.Called(ctx, identityID, loginAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, identityID, loginAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdentityRepositoryPort_TouchIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchIdentity'
+type MockIdentityRepositoryPort_TouchIdentity_Call struct {
+	*mock.Call
+}
+
+// TouchIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identityID string
+//   - loginAt time.Time
+func (_e *MockIdentityRepositoryPort_Expecter) TouchIdentity(ctx interface{}, identityID interface{}, loginAt interface{}) *MockIdentityRepositoryPort_TouchIdentity_Call {
+	return &MockIdentityRepositoryPort_TouchIdentity_Call{Call: _e.mock.On("TouchIdentity", ctx, identityID, loginAt)}
+}
+
+func (_c *MockIdentityRepositoryPort_TouchIdentity_Call) Run(run func(ctx context.Context, identityID string, loginAt time.Time)) *MockIdentityRepositoryPort_TouchIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdentityRepositoryPort_TouchIdentity_Call) Return(err error) *MockIdentityRepositoryPort_TouchIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdentityRepositoryPort_TouchIdentity_Call) RunAndReturn(run func(ctx context.Context, identityID string, loginAt time.Time) error) *MockIdentityRepositoryPort_TouchIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostServicePort creates a new instance of MockPostServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostServicePort {
+	mock := &MockPostServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostServicePort is an autogenerated mock type for the PostServicePort type
+type MockPostServicePort struct {
+	mock.Mock
+}
+
+type MockPostServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostServicePort) EXPECT() *MockPostServicePort_Expecter {
+	return &MockPostServicePort_Expecter{mock: &_m.Mock}
+}
+
+// CheckSlug provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) CheckSlug(ctx context.Context, slug string, postID string) (*domain.SlugAvailability, error) {
+	ret := _mock.Called(ctx, slug, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSlug")
+	}
+
+	var r0 *domain.SlugAvailability
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.SlugAvailability, error)); ok {
+		return returnFunc(ctx, slug, postID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.SlugAvailability); ok {
+		r0 = returnFunc(ctx, slug, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SlugAvailability)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, slug, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_CheckSlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSlug'
+type MockPostServicePort_CheckSlug_Call struct {
+	*mock.Call
+}
+
+// CheckSlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - postID string
+func (_e *MockPostServicePort_Expecter) CheckSlug(ctx interface{}, slug interface{}, postID interface{}) *MockPostServicePort_CheckSlug_Call {
+	return &MockPostServicePort_CheckSlug_Call{Call: _e.mock.On("CheckSlug", ctx, slug, postID)}
+}
+
+func (_c *MockPostServicePort_CheckSlug_Call) Run(run func(ctx context.Context, slug string, postID string)) *MockPostServicePort_CheckSlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_CheckSlug_Call) Return(slugAvailability *domain.SlugAvailability, err error) *MockPostServicePort_CheckSlug_Call {
+	_c.Call.Return(slugAvailability, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_CheckSlug_Call) RunAndReturn(run func(ctx context.Context, slug string, postID string) (*domain.SlugAvailability, error)) *MockPostServicePort_CheckSlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePost provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) CreatePost(ctx context.Context, req *domain.Post, categoryIDs []string, tagNames []string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, req, categoryIDs, tagNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePost")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post, []string, []string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, req, categoryIDs, tagNames)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post, []string, []string) *domain.Post); ok {
+		r0 = returnFunc(ctx, req, categoryIDs, tagNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Post, []string, []string) error); ok {
+		r1 = returnFunc(ctx, req, categoryIDs, tagNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
+type MockPostServicePort_CreatePost_Call struct {
+	*mock.Call
+}
+
+// CreatePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.Post
+//   - categoryIDs []string
+//   - tagNames []string
+func (_e *MockPostServicePort_Expecter) CreatePost(ctx interface{}, req interface{}, categoryIDs interface{}, tagNames interface{}) *MockPostServicePort_CreatePost_Call {
+	return &MockPostServicePort_CreatePost_Call{Call: _e.mock.On("CreatePost", ctx, req, categoryIDs, tagNames)}
+}
+
+func (_c *MockPostServicePort_CreatePost_Call) Run(run func(ctx context.Context, req *domain.Post, categoryIDs []string, tagNames []string)) *MockPostServicePort_CreatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Post
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Post)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_CreatePost_Call) Return(post *domain.Post, err error) *MockPostServicePort_CreatePost_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_CreatePost_Call) RunAndReturn(run func(ctx context.Context, req *domain.Post, categoryIDs []string, tagNames []string) (*domain.Post, error)) *MockPostServicePort_CreatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePost provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) DeletePost(ctx context.Context, id string, actor domain.Actor) error {
+	ret := _mock.Called(ctx, id, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Actor) error); ok {
+		r0 = returnFunc(ctx, id, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostServicePort_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
+type MockPostServicePort_DeletePost_Call struct {
+	*mock.Call
+}
+
+// DeletePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - actor domain.Actor
+func (_e *MockPostServicePort_Expecter) DeletePost(ctx interface{}, id interface{}, actor interface{}) *MockPostServicePort_DeletePost_Call {
+	return &MockPostServicePort_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, id, actor)}
+}
+
+func (_c *MockPostServicePort_DeletePost_Call) Run(run func(ctx context.Context, id string, actor domain.Actor)) *MockPostServicePort_DeletePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.Actor
+		if args[2] != nil {
+			arg2 = args[2].(domain.Actor)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_DeletePost_Call) Return(err error) *MockPostServicePort_DeletePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostServicePort_DeletePost_Call) RunAndReturn(run func(ctx context.Context, id string, actor domain.Actor) error) *MockPostServicePort_DeletePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffRevisions provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) DiffRevisions(ctx context.Context, postID string, fromID string, toID string, actor domain.Actor) (*domain.PostRevisionDiff, error) {
+	ret := _mock.Called(ctx, postID, fromID, toID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRevisions")
+	}
+
+	var r0 *domain.PostRevisionDiff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, domain.Actor) (*domain.PostRevisionDiff, error)); ok {
+		return returnFunc(ctx, postID, fromID, toID, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, domain.Actor) *domain.PostRevisionDiff); ok {
+		r0 = returnFunc(ctx, postID, fromID, toID, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PostRevisionDiff)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, domain.Actor) error); ok {
+		r1 = returnFunc(ctx, postID, fromID, toID, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_DiffRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRevisions'
+type MockPostServicePort_DiffRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - fromID string
+//   - toID string
+//   - actor domain.Actor
+func (_e *MockPostServicePort_Expecter) DiffRevisions(ctx interface{}, postID interface{}, fromID interface{}, toID interface{}, actor interface{}) *MockPostServicePort_DiffRevisions_Call {
+	return &MockPostServicePort_DiffRevisions_Call{Call: _e.mock.On("DiffRevisions", ctx, postID, fromID, toID, actor)}
+}
+
+func (_c *MockPostServicePort_DiffRevisions_Call) Run(run func(ctx context.Context, postID string, fromID string, toID string, actor domain.Actor)) *MockPostServicePort_DiffRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 domain.Actor
+		if args[4] != nil {
+			arg4 = args[4].(domain.Actor)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_DiffRevisions_Call) Return(postRevisionDiff *domain.PostRevisionDiff, err error) *MockPostServicePort_DiffRevisions_Call {
+	_c.Call.Return(postRevisionDiff, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_DiffRevisions_Call) RunAndReturn(run func(ctx context.Context, postID string, fromID string, toID string, actor domain.Actor) (*domain.PostRevisionDiff, error)) *MockPostServicePort_DiffRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostByID provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) GetPostByID(ctx context.Context, id string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostByID")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_GetPostByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostByID'
+type MockPostServicePort_GetPostByID_Call struct {
+	*mock.Call
+}
+
+// GetPostByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockPostServicePort_Expecter) GetPostByID(ctx interface{}, id interface{}) *MockPostServicePort_GetPostByID_Call {
+	return &MockPostServicePort_GetPostByID_Call{Call: _e.mock.On("GetPostByID", ctx, id)}
+}
+
+func (_c *MockPostServicePort_GetPostByID_Call) Run(run func(ctx context.Context, id string)) *MockPostServicePort_GetPostByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_GetPostByID_Call) Return(post *domain.Post, err error) *MockPostServicePort_GetPostByID_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_GetPostByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Post, error)) *MockPostServicePort_GetPostByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPublishedPostBySlug provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) GetPublishedPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublishedPostBySlug")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_GetPublishedPostBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublishedPostBySlug'
+type MockPostServicePort_GetPublishedPostBySlug_Call struct {
+	*mock.Call
+}
+
+// GetPublishedPostBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockPostServicePort_Expecter) GetPublishedPostBySlug(ctx interface{}, slug interface{}) *MockPostServicePort_GetPublishedPostBySlug_Call {
+	return &MockPostServicePort_GetPublishedPostBySlug_Call{Call: _e.mock.On("GetPublishedPostBySlug", ctx, slug)}
+}
+
+func (_c *MockPostServicePort_GetPublishedPostBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockPostServicePort_GetPublishedPostBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_GetPublishedPostBySlug_Call) Return(post *domain.Post, err error) *MockPostServicePort_GetPublishedPostBySlug_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_GetPublishedPostBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Post, error)) *MockPostServicePort_GetPublishedPostBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevision provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) GetRevision(ctx context.Context, postID string, revisionID string, actor domain.Actor) (*domain.PostRevision, error) {
+	ret := _mock.Called(ctx, postID, revisionID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 *domain.PostRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Actor) (*domain.PostRevision, error)); ok {
+		return returnFunc(ctx, postID, revisionID, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Actor) *domain.PostRevision); ok {
+		r0 = returnFunc(ctx, postID, revisionID, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PostRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.Actor) error); ok {
+		r1 = returnFunc(ctx, postID, revisionID, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockPostServicePort_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - revisionID string
+//   - actor domain.Actor
+func (_e *MockPostServicePort_Expecter) GetRevision(ctx interface{}, postID interface{}, revisionID interface{}, actor interface{}) *MockPostServicePort_GetRevision_Call {
+	return &MockPostServicePort_GetRevision_Call{Call: _e.mock.On("GetRevision", ctx, postID, revisionID, actor)}
+}
+
+func (_c *MockPostServicePort_GetRevision_Call) Run(run func(ctx context.Context, postID string, revisionID string, actor domain.Actor)) *MockPostServicePort_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.Actor
+		if args[3] != nil {
+			arg3 = args[3].(domain.Actor)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_GetRevision_Call) Return(postRevision *domain.PostRevision, err error) *MockPostServicePort_GetRevision_Call {
+	_c.Call.Return(postRevision, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_GetRevision_Call) RunAndReturn(run func(ctx context.Context, postID string, revisionID string, actor domain.Actor) (*domain.PostRevision, error)) *MockPostServicePort_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPosts provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) ListPosts(ctx context.Context, opts domain.PostListOptions) (*domain.PostPage, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPosts")
+	}
+
+	var r0 *domain.PostPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostListOptions) (*domain.PostPage, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostListOptions) *domain.PostPage); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PostPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PostListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_ListPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPosts'
+type MockPostServicePort_ListPosts_Call struct {
+	*mock.Call
+}
+
+// ListPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts domain.PostListOptions
+func (_e *MockPostServicePort_Expecter) ListPosts(ctx interface{}, opts interface{}) *MockPostServicePort_ListPosts_Call {
+	return &MockPostServicePort_ListPosts_Call{Call: _e.mock.On("ListPosts", ctx, opts)}
+}
+
+func (_c *MockPostServicePort_ListPosts_Call) Run(run func(ctx context.Context, opts domain.PostListOptions)) *MockPostServicePort_ListPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PostListOptions
+		if args[1] != nil {
+			arg1 = args[1].(domain.PostListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_ListPosts_Call) Return(postPage *domain.PostPage, err error) *MockPostServicePort_ListPosts_Call {
+	_c.Call.Return(postPage, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_ListPosts_Call) RunAndReturn(run func(ctx context.Context, opts domain.PostListOptions) (*domain.PostPage, error)) *MockPostServicePort_ListPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPostsByUser provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) ListPostsByUser(ctx context.Context, userID string, opts domain.PostListOptions) (*domain.PostPage, error) {
+	ret := _mock.Called(ctx, userID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPostsByUser")
+	}
+
+	var r0 *domain.PostPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.PostListOptions) (*domain.PostPage, error)); ok {
+		return returnFunc(ctx, userID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.PostListOptions) *domain.PostPage); ok {
+		r0 = returnFunc(ctx, userID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PostPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.PostListOptions) error); ok {
+		r1 = returnFunc(ctx, userID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_ListPostsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPostsByUser'
+type MockPostServicePort_ListPostsByUser_Call struct {
+	*mock.Call
+}
+
+// ListPostsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - opts domain.PostListOptions
+func (_e *MockPostServicePort_Expecter) ListPostsByUser(ctx interface{}, userID interface{}, opts interface{}) *MockPostServicePort_ListPostsByUser_Call {
+	return &MockPostServicePort_ListPostsByUser_Call{Call: _e.mock.On("ListPostsByUser", ctx, userID, opts)}
+}
+
+func (_c *MockPostServicePort_ListPostsByUser_Call) Run(run func(ctx context.Context, userID string, opts domain.PostListOptions)) *MockPostServicePort_ListPostsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.PostListOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.PostListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_ListPostsByUser_Call) Return(postPage *domain.PostPage, err error) *MockPostServicePort_ListPostsByUser_Call {
+	_c.Call.Return(postPage, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_ListPostsByUser_Call) RunAndReturn(run func(ctx context.Context, userID string, opts domain.PostListOptions) (*domain.PostPage, error)) *MockPostServicePort_ListPostsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRevisions provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) ListRevisions(ctx context.Context, postID string, actor domain.Actor) ([]domain.PostRevision, error) {
+	ret := _mock.Called(ctx, postID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []domain.PostRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Actor) ([]domain.PostRevision, error)); ok {
+		return returnFunc(ctx, postID, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Actor) []domain.PostRevision); ok {
+		r0 = returnFunc(ctx, postID, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PostRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.Actor) error); ok {
+		r1 = returnFunc(ctx, postID, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type MockPostServicePort_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - actor domain.Actor
+func (_e *MockPostServicePort_Expecter) ListRevisions(ctx interface{}, postID interface{}, actor interface{}) *MockPostServicePort_ListRevisions_Call {
+	return &MockPostServicePort_ListRevisions_Call{Call: _e.mock.On("ListRevisions", ctx, postID, actor)}
+}
+
+func (_c *MockPostServicePort_ListRevisions_Call) Run(run func(ctx context.Context, postID string, actor domain.Actor)) *MockPostServicePort_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.Actor
+		if args[2] != nil {
+			arg2 = args[2].(domain.Actor)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_ListRevisions_Call) Return(postRevisions []domain.PostRevision, err error) *MockPostServicePort_ListRevisions_Call {
+	_c.Call.Return(postRevisions, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_ListRevisions_Call) RunAndReturn(run func(ctx context.Context, postID string, actor domain.Actor) ([]domain.PostRevision, error)) *MockPostServicePort_ListRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrash provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) ListTrash(ctx context.Context, actor domain.Actor) ([]*domain.Post, error) {
+	ret := _mock.Called(ctx, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 []*domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor) ([]*domain.Post, error)); ok {
+		return returnFunc(ctx, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor) []*domain.Post); ok {
+		r0 = returnFunc(ctx, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Actor) error); ok {
+		r1 = returnFunc(ctx, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_ListTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrash'
+type MockPostServicePort_ListTrash_Call struct {
+	*mock.Call
+}
+
+// ListTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor domain.Actor
+func (_e *MockPostServicePort_Expecter) ListTrash(ctx interface{}, actor interface{}) *MockPostServicePort_ListTrash_Call {
+	return &MockPostServicePort_ListTrash_Call{Call: _e.mock.On("ListTrash", ctx, actor)}
+}
+
+func (_c *MockPostServicePort_ListTrash_Call) Run(run func(ctx context.Context, actor domain.Actor)) *MockPostServicePort_ListTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Actor
+		if args[1] != nil {
+			arg1 = args[1].(domain.Actor)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_ListTrash_Call) Return(posts []*domain.Post, err error) *MockPostServicePort_ListTrash_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_ListTrash_Call) RunAndReturn(run func(ctx context.Context, actor domain.Actor) ([]*domain.Post, error)) *MockPostServicePort_ListTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishDuePosts provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) PublishDuePosts(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDuePosts")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_PublishDuePosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishDuePosts'
+type MockPostServicePort_PublishDuePosts_Call struct {
+	*mock.Call
+}
+
+// PublishDuePosts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPostServicePort_Expecter) PublishDuePosts(ctx interface{}) *MockPostServicePort_PublishDuePosts_Call {
+	return &MockPostServicePort_PublishDuePosts_Call{Call: _e.mock.On("PublishDuePosts", ctx)}
+}
+
+func (_c *MockPostServicePort_PublishDuePosts_Call) Run(run func(ctx context.Context)) *MockPostServicePort_PublishDuePosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_PublishDuePosts_Call) Return(n int, err error) *MockPostServicePort_PublishDuePosts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_PublishDuePosts_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockPostServicePort_PublishDuePosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgePost provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) PurgePost(ctx context.Context, id string, actor domain.Actor) error {
+	ret := _mock.Called(ctx, id, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Actor) error); ok {
+		r0 = returnFunc(ctx, id, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostServicePort_PurgePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgePost'
+type MockPostServicePort_PurgePost_Call struct {
+	*mock.Call
+}
+
+// PurgePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - actor domain.Actor
+func (_e *MockPostServicePort_Expecter) PurgePost(ctx interface{}, id interface{}, actor interface{}) *MockPostServicePort_PurgePost_Call {
+	return &MockPostServicePort_PurgePost_Call{Call: _e.mock.On("PurgePost", ctx, id, actor)}
+}
+
+func (_c *MockPostServicePort_PurgePost_Call) Run(run func(ctx context.Context, id string, actor domain.Actor)) *MockPostServicePort_PurgePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.Actor
+		if args[2] != nil {
+			arg2 = args[2].(domain.Actor)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_PurgePost_Call) Return(err error) *MockPostServicePort_PurgePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostServicePort_PurgePost_Call) RunAndReturn(run func(ctx context.Context, id string, actor domain.Actor) error) *MockPostServicePort_PurgePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTrash provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) PurgeTrash(ctx context.Context, retention time.Duration) (int, error) {
+	ret := _mock.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) (int, error)); ok {
+		return returnFunc(ctx, retention)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) int); ok {
+		r0 = returnFunc(ctx, retention)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = returnFunc(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_PurgeTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrash'
+type MockPostServicePort_PurgeTrash_Call struct {
+	*mock.Call
+}
+
+// PurgeTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+func (_e *MockPostServicePort_Expecter) PurgeTrash(ctx interface{}, retention interface{}) *MockPostServicePort_PurgeTrash_Call {
+	return &MockPostServicePort_PurgeTrash_Call{Call: _e.mock.On("PurgeTrash", ctx, retention)}
+}
+
+func (_c *MockPostServicePort_PurgeTrash_Call) Run(run func(ctx context.Context, retention time.Duration)) *MockPostServicePort_PurgeTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_PurgeTrash_Call) Return(n int, err error) *MockPostServicePort_PurgeTrash_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_PurgeTrash_Call) RunAndReturn(run func(ctx context.Context, retention time.Duration) (int, error)) *MockPostServicePort_PurgeTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestorePost provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) RestorePost(ctx context.Context, id string, actor domain.Actor) (*domain.Post, error) {
+	ret := _mock.Called(ctx, id, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestorePost")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Actor) (*domain.Post, error)); ok {
+		return returnFunc(ctx, id, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Actor) *domain.Post); ok {
+		r0 = returnFunc(ctx, id, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.Actor) error); ok {
+		r1 = returnFunc(ctx, id, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_RestorePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestorePost'
+type MockPostServicePort_RestorePost_Call struct {
+	*mock.Call
+}
+
+// RestorePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - actor domain.Actor
+func (_e *MockPostServicePort_Expecter) RestorePost(ctx interface{}, id interface{}, actor interface{}) *MockPostServicePort_RestorePost_Call {
+	return &MockPostServicePort_RestorePost_Call{Call: _e.mock.On("RestorePost", ctx, id, actor)}
+}
+
+func (_c *MockPostServicePort_RestorePost_Call) Run(run func(ctx context.Context, id string, actor domain.Actor)) *MockPostServicePort_RestorePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.Actor
+		if args[2] != nil {
+			arg2 = args[2].(domain.Actor)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_RestorePost_Call) Return(post *domain.Post, err error) *MockPostServicePort_RestorePost_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_RestorePost_Call) RunAndReturn(run func(ctx context.Context, id string, actor domain.Actor) (*domain.Post, error)) *MockPostServicePort_RestorePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreRevision provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) RestoreRevision(ctx context.Context, postID string, revisionID string, actor domain.Actor) (*domain.Post, error) {
+	ret := _mock.Called(ctx, postID, revisionID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreRevision")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Actor) (*domain.Post, error)); ok {
+		return returnFunc(ctx, postID, revisionID, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Actor) *domain.Post); ok {
+		r0 = returnFunc(ctx, postID, revisionID, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.Actor) error); ok {
+		r1 = returnFunc(ctx, postID, revisionID, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_RestoreRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRevision'
+type MockPostServicePort_RestoreRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - revisionID string
+//   - actor domain.Actor
+func (_e *MockPostServicePort_Expecter) RestoreRevision(ctx interface{}, postID interface{}, revisionID interface{}, actor interface{}) *MockPostServicePort_RestoreRevision_Call {
+	return &MockPostServicePort_RestoreRevision_Call{Call: _e.mock.On("RestoreRevision", ctx, postID, revisionID, actor)}
+}
+
+func (_c *MockPostServicePort_RestoreRevision_Call) Run(run func(ctx context.Context, postID string, revisionID string, actor domain.Actor)) *MockPostServicePort_RestoreRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.Actor
+		if args[3] != nil {
+			arg3 = args[3].(domain.Actor)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_RestoreRevision_Call) Return(post *domain.Post, err error) *MockPostServicePort_RestoreRevision_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_RestoreRevision_Call) RunAndReturn(run func(ctx context.Context, postID string, revisionID string, actor domain.Actor) (*domain.Post, error)) *MockPostServicePort_RestoreRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchPosts provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) SearchPosts(ctx context.Context, q domain.PostSearchQuery) ([]domain.PostSearchHit, int, error) {
+	ret := _mock.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPosts")
+	}
+
+	var r0 []domain.PostSearchHit
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostSearchQuery) ([]domain.PostSearchHit, int, error)); ok {
+		return returnFunc(ctx, q)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostSearchQuery) []domain.PostSearchHit); ok {
+		r0 = returnFunc(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PostSearchHit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PostSearchQuery) int); ok {
+		r1 = returnFunc(ctx, q)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.PostSearchQuery) error); ok {
+		r2 = returnFunc(ctx, q)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPostServicePort_SearchPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchPosts'
+type MockPostServicePort_SearchPosts_Call struct {
+	*mock.Call
+}
+
+// SearchPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.PostSearchQuery
+func (_e *MockPostServicePort_Expecter) SearchPosts(ctx interface{}, q interface{}) *MockPostServicePort_SearchPosts_Call {
+	return &MockPostServicePort_SearchPosts_Call{Call: _e.mock.On("SearchPosts", ctx, q)}
+}
+
+func (_c *MockPostServicePort_SearchPosts_Call) Run(run func(ctx context.Context, q domain.PostSearchQuery)) *MockPostServicePort_SearchPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PostSearchQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.PostSearchQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_SearchPosts_Call) Return(postSearchHits []domain.PostSearchHit, n int, err error) *MockPostServicePort_SearchPosts_Call {
+	_c.Call.Return(postSearchHits, n, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_SearchPosts_Call) RunAndReturn(run func(ctx context.Context, q domain.PostSearchQuery) ([]domain.PostSearchHit, int, error)) *MockPostServicePort_SearchPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferPosts provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) TransferPosts(ctx context.Context, fromUserID string, toUserID string, trash bool) error {
+	ret := _mock.Called(ctx, fromUserID, toUserID, trash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferPosts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = returnFunc(ctx, fromUserID, toUserID, trash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostServicePort_TransferPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferPosts'
+type MockPostServicePort_TransferPosts_Call struct {
+	*mock.Call
+}
+
+// TransferPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromUserID string
+//   - toUserID string
+//   - trash bool
+func (_e *MockPostServicePort_Expecter) TransferPosts(ctx interface{}, fromUserID interface{}, toUserID interface{}, trash interface{}) *MockPostServicePort_TransferPosts_Call {
+	return &MockPostServicePort_TransferPosts_Call{Call: _e.mock.On("TransferPosts", ctx, fromUserID, toUserID, trash)}
+}
+
+func (_c *MockPostServicePort_TransferPosts_Call) Run(run func(ctx context.Context, fromUserID string, toUserID string, trash bool)) *MockPostServicePort_TransferPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_TransferPosts_Call) Return(err error) *MockPostServicePort_TransferPosts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostServicePort_TransferPosts_Call) RunAndReturn(run func(ctx context.Context, fromUserID string, toUserID string, trash bool) error) *MockPostServicePort_TransferPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePost provides a mock function for the type MockPostServicePort
+func (_mock *MockPostServicePort) UpdatePost(ctx context.Context, id string, actor domain.Actor, req *domain.Post, publish *bool, categoryIDs *[]string, tagNames *[]string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, id, actor, req, publish, categoryIDs, tagNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePost")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Actor, *domain.Post, *bool, *[]string, *[]string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, id, actor, req, publish, categoryIDs, tagNames)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Actor, *domain.Post, *bool, *[]string, *[]string) *domain.Post); ok {
+		r0 = returnFunc(ctx, id, actor, req, publish, categoryIDs, tagNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.Actor, *domain.Post, *bool, *[]string, *[]string) error); ok {
+		r1 = returnFunc(ctx, id, actor, req, publish, categoryIDs, tagNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostServicePort_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
+type MockPostServicePort_UpdatePost_Call struct {
+	*mock.Call
+}
+
+// UpdatePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - actor domain.Actor
+//   - req *domain.Post
+//   - publish *bool
+//   - categoryIDs *[]string
+//   - tagNames *[]string
+func (_e *MockPostServicePort_Expecter) UpdatePost(ctx interface{}, id interface{}, actor interface{}, req interface{}, publish interface{}, categoryIDs interface{}, tagNames interface{}) *MockPostServicePort_UpdatePost_Call {
+	return &MockPostServicePort_UpdatePost_Call{Call: _e.mock.On("UpdatePost", ctx, id, actor, req, publish, categoryIDs, tagNames)}
+}
+
+func (_c *MockPostServicePort_UpdatePost_Call) Run(run func(ctx context.Context, id string, actor domain.Actor, req *domain.Post, publish *bool, categoryIDs *[]string, tagNames *[]string)) *MockPostServicePort_UpdatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.Actor
+		if args[2] != nil {
+			arg2 = args[2].(domain.Actor)
+		}
+		var arg3 *domain.Post
+		if args[3] != nil {
+			arg3 = args[3].(*domain.Post)
+		}
+		var arg4 *bool
+		if args[4] != nil {
+			arg4 = args[4].(*bool)
+		}
+		var arg5 *[]string
+		if args[5] != nil {
+			arg5 = args[5].(*[]string)
+		}
+		var arg6 *[]string
+		if args[6] != nil {
+			arg6 = args[6].(*[]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostServicePort_UpdatePost_Call) Return(post *domain.Post, err error) *MockPostServicePort_UpdatePost_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostServicePort_UpdatePost_Call) RunAndReturn(run func(ctx context.Context, id string, actor domain.Actor, req *domain.Post, publish *bool, categoryIDs *[]string, tagNames *[]string) (*domain.Post, error)) *MockPostServicePort_UpdatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostRepositoryPort creates a new instance of MockPostRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostRepositoryPort {
+	mock := &MockPostRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostRepositoryPort is an autogenerated mock type for the PostRepositoryPort type
+type MockPostRepositoryPort struct {
+	mock.Mock
+}
+
+type MockPostRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostRepositoryPort) EXPECT() *MockPostRepositoryPort_Expecter {
+	return &MockPostRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// AddCategoriesToPost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) AddCategoriesToPost(ctx context.Context, postID string, categoryIDs []string) error {
+	ret := _mock.Called(ctx, postID, categoryIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCategoriesToPost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, postID, categoryIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_AddCategoriesToPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCategoriesToPost'
+type MockPostRepositoryPort_AddCategoriesToPost_Call struct {
+	*mock.Call
+}
+
+// AddCategoriesToPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - categoryIDs []string
+func (_e *MockPostRepositoryPort_Expecter) AddCategoriesToPost(ctx interface{}, postID interface{}, categoryIDs interface{}) *MockPostRepositoryPort_AddCategoriesToPost_Call {
+	return &MockPostRepositoryPort_AddCategoriesToPost_Call{Call: _e.mock.On("AddCategoriesToPost", ctx, postID, categoryIDs)}
+}
+
+func (_c *MockPostRepositoryPort_AddCategoriesToPost_Call) Run(run func(ctx context.Context, postID string, categoryIDs []string)) *MockPostRepositoryPort_AddCategoriesToPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_AddCategoriesToPost_Call) Return(err error) *MockPostRepositoryPort_AddCategoriesToPost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_AddCategoriesToPost_Call) RunAndReturn(run func(ctx context.Context, postID string, categoryIDs []string) error) *MockPostRepositoryPort_AddCategoriesToPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTagsToPost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) AddTagsToPost(ctx context.Context, postID string, tagIDs []string) error {
+	ret := _mock.Called(ctx, postID, tagIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTagsToPost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, postID, tagIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_AddTagsToPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTagsToPost'
+type MockPostRepositoryPort_AddTagsToPost_Call struct {
+	*mock.Call
+}
+
+// AddTagsToPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - tagIDs []string
+func (_e *MockPostRepositoryPort_Expecter) AddTagsToPost(ctx interface{}, postID interface{}, tagIDs interface{}) *MockPostRepositoryPort_AddTagsToPost_Call {
+	return &MockPostRepositoryPort_AddTagsToPost_Call{Call: _e.mock.On("AddTagsToPost", ctx, postID, tagIDs)}
+}
+
+func (_c *MockPostRepositoryPort_AddTagsToPost_Call) Run(run func(ctx context.Context, postID string, tagIDs []string)) *MockPostRepositoryPort_AddTagsToPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_AddTagsToPost_Call) Return(err error) *MockPostRepositoryPort_AddTagsToPost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_AddTagsToPost_Call) RunAndReturn(run func(ctx context.Context, postID string, tagIDs []string) error) *MockPostRepositoryPort_AddTagsToPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountPosts provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) CountPosts(ctx context.Context, opts domain.PostListOptions) (int, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPosts")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostListOptions) (int, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostListOptions) int); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PostListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_CountPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPosts'
+type MockPostRepositoryPort_CountPosts_Call struct {
+	*mock.Call
+}
+
+// CountPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts domain.PostListOptions
+func (_e *MockPostRepositoryPort_Expecter) CountPosts(ctx interface{}, opts interface{}) *MockPostRepositoryPort_CountPosts_Call {
+	return &MockPostRepositoryPort_CountPosts_Call{Call: _e.mock.On("CountPosts", ctx, opts)}
+}
+
+func (_c *MockPostRepositoryPort_CountPosts_Call) Run(run func(ctx context.Context, opts domain.PostListOptions)) *MockPostRepositoryPort_CountPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PostListOptions
+		if args[1] != nil {
+			arg1 = args[1].(domain.PostListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_CountPosts_Call) Return(n int, err error) *MockPostRepositoryPort_CountPosts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_CountPosts_Call) RunAndReturn(run func(ctx context.Context, opts domain.PostListOptions) (int, error)) *MockPostRepositoryPort_CountPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) CreatePost(ctx context.Context, p *domain.Post) error {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post) error); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
+type MockPostRepositoryPort_CreatePost_Call struct {
+	*mock.Call
+}
+
+// CreatePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p *domain.Post
+func (_e *MockPostRepositoryPort_Expecter) CreatePost(ctx interface{}, p interface{}) *MockPostRepositoryPort_CreatePost_Call {
+	return &MockPostRepositoryPort_CreatePost_Call{Call: _e.mock.On("CreatePost", ctx, p)}
+}
+
+func (_c *MockPostRepositoryPort_CreatePost_Call) Run(run func(ctx context.Context, p *domain.Post)) *MockPostRepositoryPort_CreatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Post
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Post)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_CreatePost_Call) Return(err error) *MockPostRepositoryPort_CreatePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_CreatePost_Call) RunAndReturn(run func(ctx context.Context, p *domain.Post) error) *MockPostRepositoryPort_CreatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) DeletePost(ctx context.Context, postID string) error {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
+type MockPostRepositoryPort_DeletePost_Call struct {
+	*mock.Call
+}
+
+// DeletePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) DeletePost(ctx interface{}, postID interface{}) *MockPostRepositoryPort_DeletePost_Call {
+	return &MockPostRepositoryPort_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_DeletePost_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_DeletePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_DeletePost_Call) Return(err error) *MockPostRepositoryPort_DeletePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_DeletePost_Call) RunAndReturn(run func(ctx context.Context, postID string) error) *MockPostRepositoryPort_DeletePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPostByID provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindPostByID(ctx context.Context, postID string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPostByID")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, postID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindPostByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostByID'
+type MockPostRepositoryPort_FindPostByID_Call struct {
+	*mock.Call
+}
+
+// FindPostByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) FindPostByID(ctx interface{}, postID interface{}) *MockPostRepositoryPort_FindPostByID_Call {
+	return &MockPostRepositoryPort_FindPostByID_Call{Call: _e.mock.On("FindPostByID", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_FindPostByID_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_FindPostByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPostByID_Call) Return(post *domain.Post, err error) *MockPostRepositoryPort_FindPostByID_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPostByID_Call) RunAndReturn(run func(ctx context.Context, postID string) (*domain.Post, error)) *MockPostRepositoryPort_FindPostByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPostBySlug provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPostBySlug")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindPostBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostBySlug'
+type MockPostRepositoryPort_FindPostBySlug_Call struct {
+	*mock.Call
+}
+
+// FindPostBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockPostRepositoryPort_Expecter) FindPostBySlug(ctx interface{}, slug interface{}) *MockPostRepositoryPort_FindPostBySlug_Call {
+	return &MockPostRepositoryPort_FindPostBySlug_Call{Call: _e.mock.On("FindPostBySlug", ctx, slug)}
+}
+
+func (_c *MockPostRepositoryPort_FindPostBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockPostRepositoryPort_FindPostBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPostBySlug_Call) Return(post *domain.Post, err error) *MockPostRepositoryPort_FindPostBySlug_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPostBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Post, error)) *MockPostRepositoryPort_FindPostBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPostBySlugHistory provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindPostBySlugHistory(ctx context.Context, slug string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPostBySlugHistory")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindPostBySlugHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostBySlugHistory'
+type MockPostRepositoryPort_FindPostBySlugHistory_Call struct {
+	*mock.Call
+}
+
+// FindPostBySlugHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockPostRepositoryPort_Expecter) FindPostBySlugHistory(ctx interface{}, slug interface{}) *MockPostRepositoryPort_FindPostBySlugHistory_Call {
+	return &MockPostRepositoryPort_FindPostBySlugHistory_Call{Call: _e.mock.On("FindPostBySlugHistory", ctx, slug)}
+}
+
+func (_c *MockPostRepositoryPort_FindPostBySlugHistory_Call) Run(run func(ctx context.Context, slug string)) *MockPostRepositoryPort_FindPostBySlugHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPostBySlugHistory_Call) Return(post *domain.Post, err error) *MockPostRepositoryPort_FindPostBySlugHistory_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPostBySlugHistory_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Post, error)) *MockPostRepositoryPort_FindPostBySlugHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPostsByUserID provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindPostsByUserID(ctx context.Context, userID string, opts domain.PostListOptions) ([]*domain.Post, error) {
+	ret := _mock.Called(ctx, userID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPostsByUserID")
+	}
+
+	var r0 []*domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.PostListOptions) ([]*domain.Post, error)); ok {
+		return returnFunc(ctx, userID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.PostListOptions) []*domain.Post); ok {
+		r0 = returnFunc(ctx, userID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.PostListOptions) error); ok {
+		r1 = returnFunc(ctx, userID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindPostsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostsByUserID'
+type MockPostRepositoryPort_FindPostsByUserID_Call struct {
+	*mock.Call
+}
+
+// FindPostsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - opts domain.PostListOptions
+func (_e *MockPostRepositoryPort_Expecter) FindPostsByUserID(ctx interface{}, userID interface{}, opts interface{}) *MockPostRepositoryPort_FindPostsByUserID_Call {
+	return &MockPostRepositoryPort_FindPostsByUserID_Call{Call: _e.mock.On("FindPostsByUserID", ctx, userID, opts)}
+}
+
+func (_c *MockPostRepositoryPort_FindPostsByUserID_Call) Run(run func(ctx context.Context, userID string, opts domain.PostListOptions)) *MockPostRepositoryPort_FindPostsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.PostListOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.PostListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPostsByUserID_Call) Return(posts []*domain.Post, err error) *MockPostRepositoryPort_FindPostsByUserID_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPostsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string, opts domain.PostListOptions) ([]*domain.Post, error)) *MockPostRepositoryPort_FindPostsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPublishedPostBySlug provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindPublishedPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPublishedPostBySlug")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindPublishedPostBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPublishedPostBySlug'
+type MockPostRepositoryPort_FindPublishedPostBySlug_Call struct {
+	*mock.Call
+}
+
+// FindPublishedPostBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockPostRepositoryPort_Expecter) FindPublishedPostBySlug(ctx interface{}, slug interface{}) *MockPostRepositoryPort_FindPublishedPostBySlug_Call {
+	return &MockPostRepositoryPort_FindPublishedPostBySlug_Call{Call: _e.mock.On("FindPublishedPostBySlug", ctx, slug)}
+}
+
+func (_c *MockPostRepositoryPort_FindPublishedPostBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockPostRepositoryPort_FindPublishedPostBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPublishedPostBySlug_Call) Return(post *domain.Post, err error) *MockPostRepositoryPort_FindPublishedPostBySlug_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPublishedPostBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Post, error)) *MockPostRepositoryPort_FindPublishedPostBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPublishedPostBySlugHistory provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindPublishedPostBySlugHistory(ctx context.Context, slug string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPublishedPostBySlugHistory")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPublishedPostBySlugHistory'
+type MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call struct {
+	*mock.Call
+}
+
+// FindPublishedPostBySlugHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockPostRepositoryPort_Expecter) FindPublishedPostBySlugHistory(ctx interface{}, slug interface{}) *MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call {
+	return &MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call{Call: _e.mock.On("FindPublishedPostBySlugHistory", ctx, slug)}
+}
+
+func (_c *MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call) Run(run func(ctx context.Context, slug string)) *MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call) Return(post *domain.Post, err error) *MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Post, error)) *MockPostRepositoryPort_FindPublishedPostBySlugHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTrashedPostByID provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindTrashedPostByID(ctx context.Context, postID string) (*domain.Post, error) {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTrashedPostByID")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+		return returnFunc(ctx, postID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindTrashedPostByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTrashedPostByID'
+type MockPostRepositoryPort_FindTrashedPostByID_Call struct {
+	*mock.Call
+}
+
+// FindTrashedPostByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) FindTrashedPostByID(ctx interface{}, postID interface{}) *MockPostRepositoryPort_FindTrashedPostByID_Call {
+	return &MockPostRepositoryPort_FindTrashedPostByID_Call{Call: _e.mock.On("FindTrashedPostByID", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPostByID_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_FindTrashedPostByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPostByID_Call) Return(post *domain.Post, err error) *MockPostRepositoryPort_FindTrashedPostByID_Call {
+	_c.Call.Return(post, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPostByID_Call) RunAndReturn(run func(ctx context.Context, postID string) (*domain.Post, error)) *MockPostRepositoryPort_FindTrashedPostByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTrashedPosts provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindTrashedPosts(ctx context.Context) ([]*domain.Post, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTrashedPosts")
+	}
+
+	var r0 []*domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Post, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Post); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindTrashedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTrashedPosts'
+type MockPostRepositoryPort_FindTrashedPosts_Call struct {
+	*mock.Call
+}
+
+// FindTrashedPosts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPostRepositoryPort_Expecter) FindTrashedPosts(ctx interface{}) *MockPostRepositoryPort_FindTrashedPosts_Call {
+	return &MockPostRepositoryPort_FindTrashedPosts_Call{Call: _e.mock.On("FindTrashedPosts", ctx)}
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPosts_Call) Run(run func(ctx context.Context)) *MockPostRepositoryPort_FindTrashedPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPosts_Call) Return(posts []*domain.Post, err error) *MockPostRepositoryPort_FindTrashedPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPosts_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Post, error)) *MockPostRepositoryPort_FindTrashedPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTrashedPostsByUserID provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) FindTrashedPostsByUserID(ctx context.Context, userID string) ([]*domain.Post, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTrashedPostsByUserID")
+	}
+
+	var r0 []*domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Post, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Post); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_FindTrashedPostsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTrashedPostsByUserID'
+type MockPostRepositoryPort_FindTrashedPostsByUserID_Call struct {
+	*mock.Call
+}
+
+// FindTrashedPostsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockPostRepositoryPort_Expecter) FindTrashedPostsByUserID(ctx interface{}, userID interface{}) *MockPostRepositoryPort_FindTrashedPostsByUserID_Call {
+	return &MockPostRepositoryPort_FindTrashedPostsByUserID_Call{Call: _e.mock.On("FindTrashedPostsByUserID", ctx, userID)}
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPostsByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockPostRepositoryPort_FindTrashedPostsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPostsByUserID_Call) Return(posts []*domain.Post, err error) *MockPostRepositoryPort_FindTrashedPostsByUserID_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_FindTrashedPostsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.Post, error)) *MockPostRepositoryPort_FindTrashedPostsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostCategories provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) GetPostCategories(ctx context.Context, postID string) ([]domain.Category, error) {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostCategories")
+	}
+
+	var r0 []domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Category, error)); ok {
+		return returnFunc(ctx, postID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Category); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_GetPostCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostCategories'
+type MockPostRepositoryPort_GetPostCategories_Call struct {
+	*mock.Call
+}
+
+// GetPostCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) GetPostCategories(ctx interface{}, postID interface{}) *MockPostRepositoryPort_GetPostCategories_Call {
+	return &MockPostRepositoryPort_GetPostCategories_Call{Call: _e.mock.On("GetPostCategories", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_GetPostCategories_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_GetPostCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_GetPostCategories_Call) Return(categorys []domain.Category, err error) *MockPostRepositoryPort_GetPostCategories_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_GetPostCategories_Call) RunAndReturn(run func(ctx context.Context, postID string) ([]domain.Category, error)) *MockPostRepositoryPort_GetPostCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostTags provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) GetPostTags(ctx context.Context, postID string) ([]domain.Tag, error) {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostTags")
+	}
+
+	var r0 []domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Tag, error)); ok {
+		return returnFunc(ctx, postID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Tag); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_GetPostTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostTags'
+type MockPostRepositoryPort_GetPostTags_Call struct {
+	*mock.Call
+}
+
+// GetPostTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) GetPostTags(ctx interface{}, postID interface{}) *MockPostRepositoryPort_GetPostTags_Call {
+	return &MockPostRepositoryPort_GetPostTags_Call{Call: _e.mock.On("GetPostTags", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_GetPostTags_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_GetPostTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_GetPostTags_Call) Return(tags []domain.Tag, err error) *MockPostRepositoryPort_GetPostTags_Call {
+	_c.Call.Return(tags, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_GetPostTags_Call) RunAndReturn(run func(ctx context.Context, postID string) ([]domain.Tag, error)) *MockPostRepositoryPort_GetPostTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPosts provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) ListPosts(ctx context.Context, opts domain.PostListOptions) ([]*domain.Post, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPosts")
+	}
+
+	var r0 []*domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostListOptions) ([]*domain.Post, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostListOptions) []*domain.Post); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PostListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_ListPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPosts'
+type MockPostRepositoryPort_ListPosts_Call struct {
+	*mock.Call
+}
+
+// ListPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts domain.PostListOptions
+func (_e *MockPostRepositoryPort_Expecter) ListPosts(ctx interface{}, opts interface{}) *MockPostRepositoryPort_ListPosts_Call {
+	return &MockPostRepositoryPort_ListPosts_Call{Call: _e.mock.On("ListPosts", ctx, opts)}
+}
+
+func (_c *MockPostRepositoryPort_ListPosts_Call) Run(run func(ctx context.Context, opts domain.PostListOptions)) *MockPostRepositoryPort_ListPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PostListOptions
+		if args[1] != nil {
+			arg1 = args[1].(domain.PostListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_ListPosts_Call) Return(posts []*domain.Post, err error) *MockPostRepositoryPort_ListPosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_ListPosts_Call) RunAndReturn(run func(ctx context.Context, opts domain.PostListOptions) ([]*domain.Post, error)) *MockPostRepositoryPort_ListPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishDuePosts provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) PublishDuePosts(ctx context.Context, now time.Time, limit int) ([]*domain.Post, error) {
+	ret := _mock.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDuePosts")
+	}
+
+	var r0 []*domain.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*domain.Post, error)); ok {
+		return returnFunc(ctx, now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []*domain.Post); ok {
+		r0 = returnFunc(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_PublishDuePosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishDuePosts'
+type MockPostRepositoryPort_PublishDuePosts_Call struct {
+	*mock.Call
+}
+
+// PublishDuePosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockPostRepositoryPort_Expecter) PublishDuePosts(ctx interface{}, now interface{}, limit interface{}) *MockPostRepositoryPort_PublishDuePosts_Call {
+	return &MockPostRepositoryPort_PublishDuePosts_Call{Call: _e.mock.On("PublishDuePosts", ctx, now, limit)}
+}
+
+func (_c *MockPostRepositoryPort_PublishDuePosts_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockPostRepositoryPort_PublishDuePosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_PublishDuePosts_Call) Return(posts []*domain.Post, err error) *MockPostRepositoryPort_PublishDuePosts_Call {
+	_c.Call.Return(posts, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_PublishDuePosts_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int) ([]*domain.Post, error)) *MockPostRepositoryPort_PublishDuePosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgePost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) PurgePost(ctx context.Context, postID string) error {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_PurgePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgePost'
+type MockPostRepositoryPort_PurgePost_Call struct {
+	*mock.Call
+}
+
+// PurgePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) PurgePost(ctx interface{}, postID interface{}) *MockPostRepositoryPort_PurgePost_Call {
+	return &MockPostRepositoryPort_PurgePost_Call{Call: _e.mock.On("PurgePost", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_PurgePost_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_PurgePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_PurgePost_Call) Return(err error) *MockPostRepositoryPort_PurgePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_PurgePost_Call) RunAndReturn(run func(ctx context.Context, postID string) error) *MockPostRepositoryPort_PurgePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTrashedPosts provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) PurgeTrashedPosts(ctx context.Context, before time.Time) (int, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrashedPosts")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_PurgeTrashedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrashedPosts'
+type MockPostRepositoryPort_PurgeTrashedPosts_Call struct {
+	*mock.Call
+}
+
+// PurgeTrashedPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockPostRepositoryPort_Expecter) PurgeTrashedPosts(ctx interface{}, before interface{}) *MockPostRepositoryPort_PurgeTrashedPosts_Call {
+	return &MockPostRepositoryPort_PurgeTrashedPosts_Call{Call: _e.mock.On("PurgeTrashedPosts", ctx, before)}
+}
+
+func (_c *MockPostRepositoryPort_PurgeTrashedPosts_Call) Run(run func(ctx context.Context, before time.Time)) *MockPostRepositoryPort_PurgeTrashedPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_PurgeTrashedPosts_Call) Return(n int, err error) *MockPostRepositoryPort_PurgeTrashedPosts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_PurgeTrashedPosts_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int, error)) *MockPostRepositoryPort_PurgeTrashedPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignPosts provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) ReassignPosts(ctx context.Context, fromUserID string, toUserID string) error {
+	ret := _mock.Called(ctx, fromUserID, toUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignPosts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, fromUserID, toUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_ReassignPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignPosts'
+type MockPostRepositoryPort_ReassignPosts_Call struct {
+	*mock.Call
+}
+
+// ReassignPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromUserID string
+//   - toUserID string
+func (_e *MockPostRepositoryPort_Expecter) ReassignPosts(ctx interface{}, fromUserID interface{}, toUserID interface{}) *MockPostRepositoryPort_ReassignPosts_Call {
+	return &MockPostRepositoryPort_ReassignPosts_Call{Call: _e.mock.On("ReassignPosts", ctx, fromUserID, toUserID)}
+}
+
+func (_c *MockPostRepositoryPort_ReassignPosts_Call) Run(run func(ctx context.Context, fromUserID string, toUserID string)) *MockPostRepositoryPort_ReassignPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_ReassignPosts_Call) Return(err error) *MockPostRepositoryPort_ReassignPosts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_ReassignPosts_Call) RunAndReturn(run func(ctx context.Context, fromUserID string, toUserID string) error) *MockPostRepositoryPort_ReassignPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSlugChange provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) RecordSlugChange(ctx context.Context, postID string, oldSlug string, newSlug string) error {
+	ret := _mock.Called(ctx, postID, oldSlug, newSlug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSlugChange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, postID, oldSlug, newSlug)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_RecordSlugChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSlugChange'
+type MockPostRepositoryPort_RecordSlugChange_Call struct {
+	*mock.Call
+}
+
+// RecordSlugChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - oldSlug string
+//   - newSlug string
+func (_e *MockPostRepositoryPort_Expecter) RecordSlugChange(ctx interface{}, postID interface{}, oldSlug interface{}, newSlug interface{}) *MockPostRepositoryPort_RecordSlugChange_Call {
+	return &MockPostRepositoryPort_RecordSlugChange_Call{Call: _e.mock.On("RecordSlugChange", ctx, postID, oldSlug, newSlug)}
+}
+
+func (_c *MockPostRepositoryPort_RecordSlugChange_Call) Run(run func(ctx context.Context, postID string, oldSlug string, newSlug string)) *MockPostRepositoryPort_RecordSlugChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_RecordSlugChange_Call) Return(err error) *MockPostRepositoryPort_RecordSlugChange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_RecordSlugChange_Call) RunAndReturn(run func(ctx context.Context, postID string, oldSlug string, newSlug string) error) *MockPostRepositoryPort_RecordSlugChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCategoriesFromPost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) RemoveCategoriesFromPost(ctx context.Context, postID string) error {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCategoriesFromPost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_RemoveCategoriesFromPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCategoriesFromPost'
+type MockPostRepositoryPort_RemoveCategoriesFromPost_Call struct {
+	*mock.Call
+}
+
+// RemoveCategoriesFromPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) RemoveCategoriesFromPost(ctx interface{}, postID interface{}) *MockPostRepositoryPort_RemoveCategoriesFromPost_Call {
+	return &MockPostRepositoryPort_RemoveCategoriesFromPost_Call{Call: _e.mock.On("RemoveCategoriesFromPost", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_RemoveCategoriesFromPost_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_RemoveCategoriesFromPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_RemoveCategoriesFromPost_Call) Return(err error) *MockPostRepositoryPort_RemoveCategoriesFromPost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_RemoveCategoriesFromPost_Call) RunAndReturn(run func(ctx context.Context, postID string) error) *MockPostRepositoryPort_RemoveCategoriesFromPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTagsFromPost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) RemoveTagsFromPost(ctx context.Context, postID string) error {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTagsFromPost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_RemoveTagsFromPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTagsFromPost'
+type MockPostRepositoryPort_RemoveTagsFromPost_Call struct {
+	*mock.Call
+}
+
+// RemoveTagsFromPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) RemoveTagsFromPost(ctx interface{}, postID interface{}) *MockPostRepositoryPort_RemoveTagsFromPost_Call {
+	return &MockPostRepositoryPort_RemoveTagsFromPost_Call{Call: _e.mock.On("RemoveTagsFromPost", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_RemoveTagsFromPost_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_RemoveTagsFromPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_RemoveTagsFromPost_Call) Return(err error) *MockPostRepositoryPort_RemoveTagsFromPost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_RemoveTagsFromPost_Call) RunAndReturn(run func(ctx context.Context, postID string) error) *MockPostRepositoryPort_RemoveTagsFromPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestorePost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) RestorePost(ctx context.Context, postID string) error {
+	ret := _mock.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestorePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_RestorePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestorePost'
+type MockPostRepositoryPort_RestorePost_Call struct {
+	*mock.Call
+}
+
+// RestorePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockPostRepositoryPort_Expecter) RestorePost(ctx interface{}, postID interface{}) *MockPostRepositoryPort_RestorePost_Call {
+	return &MockPostRepositoryPort_RestorePost_Call{Call: _e.mock.On("RestorePost", ctx, postID)}
+}
+
+func (_c *MockPostRepositoryPort_RestorePost_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_RestorePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_RestorePost_Call) Return(err error) *MockPostRepositoryPort_RestorePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_RestorePost_Call) RunAndReturn(run func(ctx context.Context, postID string) error) *MockPostRepositoryPort_RestorePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrashPostsByUserID provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) TrashPostsByUserID(ctx context.Context, userID string) ([]string, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashPostsByUserID")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostRepositoryPort_TrashPostsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashPostsByUserID'
+type MockPostRepositoryPort_TrashPostsByUserID_Call struct {
+	*mock.Call
+}
+
+// TrashPostsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockPostRepositoryPort_Expecter) TrashPostsByUserID(ctx interface{}, userID interface{}) *MockPostRepositoryPort_TrashPostsByUserID_Call {
+	return &MockPostRepositoryPort_TrashPostsByUserID_Call{Call: _e.mock.On("TrashPostsByUserID", ctx, userID)}
+}
+
+func (_c *MockPostRepositoryPort_TrashPostsByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockPostRepositoryPort_TrashPostsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_TrashPostsByUserID_Call) Return(strings []string, err error) *MockPostRepositoryPort_TrashPostsByUserID_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_TrashPostsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]string, error)) *MockPostRepositoryPort_TrashPostsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePost provides a mock function for the type MockPostRepositoryPort
+func (_mock *MockPostRepositoryPort) UpdatePost(ctx context.Context, p *domain.Post) error {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post) error); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostRepositoryPort_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
+type MockPostRepositoryPort_UpdatePost_Call struct {
+	*mock.Call
+}
+
+// UpdatePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p *domain.Post
+func (_e *MockPostRepositoryPort_Expecter) UpdatePost(ctx interface{}, p interface{}) *MockPostRepositoryPort_UpdatePost_Call {
+	return &MockPostRepositoryPort_UpdatePost_Call{Call: _e.mock.On("UpdatePost", ctx, p)}
+}
+
+func (_c *MockPostRepositoryPort_UpdatePost_Call) Run(run func(ctx context.Context, p *domain.Post)) *MockPostRepositoryPort_UpdatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Post
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Post)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_UpdatePost_Call) Return(err error) *MockPostRepositoryPort_UpdatePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostRepositoryPort_UpdatePost_Call) RunAndReturn(run func(ctx context.Context, p *domain.Post) error) *MockPostRepositoryPort_UpdatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCategoryServicePort creates a new instance of MockCategoryServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCategoryServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCategoryServicePort {
+	mock := &MockCategoryServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCategoryServicePort is an autogenerated mock type for the CategoryServicePort type
+type MockCategoryServicePort struct {
+	mock.Mock
+}
+
+type MockCategoryServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCategoryServicePort) EXPECT() *MockCategoryServicePort_Expecter {
+	return &MockCategoryServicePort_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function for the type MockCategoryServicePort
+func (_mock *MockCategoryServicePort) CreateCategory(ctx context.Context, userID string, c *domain.Category) (*domain.Category, error) {
+	ret := _mock.Called(ctx, userID, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.Category) (*domain.Category, error)); ok {
+		return returnFunc(ctx, userID, c)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.Category) *domain.Category); ok {
+		r0 = returnFunc(ctx, userID, c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *domain.Category) error); ok {
+		r1 = returnFunc(ctx, userID, c)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryServicePort_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type MockCategoryServicePort_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - c *domain.Category
+func (_e *MockCategoryServicePort_Expecter) CreateCategory(ctx interface{}, userID interface{}, c interface{}) *MockCategoryServicePort_CreateCategory_Call {
+	return &MockCategoryServicePort_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, userID, c)}
+}
+
+func (_c *MockCategoryServicePort_CreateCategory_Call) Run(run func(ctx context.Context, userID string, c *domain.Category)) *MockCategoryServicePort_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *domain.Category
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryServicePort_CreateCategory_Call) Return(category *domain.Category, err error) *MockCategoryServicePort_CreateCategory_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategoryServicePort_CreateCategory_Call) RunAndReturn(run func(ctx context.Context, userID string, c *domain.Category) (*domain.Category, error)) *MockCategoryServicePort_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function for the type MockCategoryServicePort
+func (_mock *MockCategoryServicePort) DeleteCategory(ctx context.Context, userID string, id string) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryServicePort_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type MockCategoryServicePort_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+func (_e *MockCategoryServicePort_Expecter) DeleteCategory(ctx interface{}, userID interface{}, id interface{}) *MockCategoryServicePort_DeleteCategory_Call {
+	return &MockCategoryServicePort_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, userID, id)}
+}
+
+func (_c *MockCategoryServicePort_DeleteCategory_Call) Run(run func(ctx context.Context, userID string, id string)) *MockCategoryServicePort_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryServicePort_DeleteCategory_Call) Return(err error) *MockCategoryServicePort_DeleteCategory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryServicePort_DeleteCategory_Call) RunAndReturn(run func(ctx context.Context, userID string, id string) error) *MockCategoryServicePort_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryBySlug provides a mock function for the type MockCategoryServicePort
+func (_mock *MockCategoryServicePort) GetCategoryBySlug(ctx context.Context, slug string) (*domain.CategorySummary, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryBySlug")
+	}
+
+	var r0 *domain.CategorySummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.CategorySummary, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.CategorySummary); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CategorySummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryServicePort_GetCategoryBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryBySlug'
+type MockCategoryServicePort_GetCategoryBySlug_Call struct {
+	*mock.Call
+}
+
+// GetCategoryBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockCategoryServicePort_Expecter) GetCategoryBySlug(ctx interface{}, slug interface{}) *MockCategoryServicePort_GetCategoryBySlug_Call {
+	return &MockCategoryServicePort_GetCategoryBySlug_Call{Call: _e.mock.On("GetCategoryBySlug", ctx, slug)}
+}
+
+func (_c *MockCategoryServicePort_GetCategoryBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockCategoryServicePort_GetCategoryBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryServicePort_GetCategoryBySlug_Call) Return(categorySummary *domain.CategorySummary, err error) *MockCategoryServicePort_GetCategoryBySlug_Call {
+	_c.Call.Return(categorySummary, err)
+	return _c
+}
+
+func (_c *MockCategoryServicePort_GetCategoryBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.CategorySummary, error)) *MockCategoryServicePort_GetCategoryBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function for the type MockCategoryServicePort
+func (_mock *MockCategoryServicePort) ListCategories(ctx context.Context) ([]domain.CategorySummary, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []domain.CategorySummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.CategorySummary, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.CategorySummary); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CategorySummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryServicePort_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type MockCategoryServicePort_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCategoryServicePort_Expecter) ListCategories(ctx interface{}) *MockCategoryServicePort_ListCategories_Call {
+	return &MockCategoryServicePort_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+}
+
+func (_c *MockCategoryServicePort_ListCategories_Call) Run(run func(ctx context.Context)) *MockCategoryServicePort_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryServicePort_ListCategories_Call) Return(categorySummarys []domain.CategorySummary, err error) *MockCategoryServicePort_ListCategories_Call {
+	_c.Call.Return(categorySummarys, err)
+	return _c
+}
+
+func (_c *MockCategoryServicePort_ListCategories_Call) RunAndReturn(run func(ctx context.Context) ([]domain.CategorySummary, error)) *MockCategoryServicePort_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeCategories provides a mock function for the type MockCategoryServicePort
+func (_mock *MockCategoryServicePort) MergeCategories(ctx context.Context, userID string, sourceID string, targetID string) error {
+	ret := _mock.Called(ctx, userID, sourceID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeCategories")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, sourceID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryServicePort_MergeCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeCategories'
+type MockCategoryServicePort_MergeCategories_Call struct {
+	*mock.Call
+}
+
+// MergeCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sourceID string
+//   - targetID string
+func (_e *MockCategoryServicePort_Expecter) MergeCategories(ctx interface{}, userID interface{}, sourceID interface{}, targetID interface{}) *MockCategoryServicePort_MergeCategories_Call {
+	return &MockCategoryServicePort_MergeCategories_Call{Call: _e.mock.On("MergeCategories", ctx, userID, sourceID, targetID)}
+}
+
+func (_c *MockCategoryServicePort_MergeCategories_Call) Run(run func(ctx context.Context, userID string, sourceID string, targetID string)) *MockCategoryServicePort_MergeCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryServicePort_MergeCategories_Call) Return(err error) *MockCategoryServicePort_MergeCategories_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryServicePort_MergeCategories_Call) RunAndReturn(run func(ctx context.Context, userID string, sourceID string, targetID string) error) *MockCategoryServicePort_MergeCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveCategory provides a mock function for the type MockCategoryServicePort
+func (_mock *MockCategoryServicePort) MoveCategory(ctx context.Context, userID string, id string, parentID *string) (*domain.Category, error) {
+	ret := _mock.Called(ctx, userID, id, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCategory")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *string) (*domain.Category, error)); ok {
+		return returnFunc(ctx, userID, id, parentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *string) *domain.Category); ok {
+		r0 = returnFunc(ctx, userID, id, parentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *string) error); ok {
+		r1 = returnFunc(ctx, userID, id, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryServicePort_MoveCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveCategory'
+type MockCategoryServicePort_MoveCategory_Call struct {
+	*mock.Call
+}
+
+// MoveCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+//   - parentID *string
+func (_e *MockCategoryServicePort_Expecter) MoveCategory(ctx interface{}, userID interface{}, id interface{}, parentID interface{}) *MockCategoryServicePort_MoveCategory_Call {
+	return &MockCategoryServicePort_MoveCategory_Call{Call: _e.mock.On("MoveCategory", ctx, userID, id, parentID)}
+}
+
+func (_c *MockCategoryServicePort_MoveCategory_Call) Run(run func(ctx context.Context, userID string, id string, parentID *string)) *MockCategoryServicePort_MoveCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryServicePort_MoveCategory_Call) Return(category *domain.Category, err error) *MockCategoryServicePort_MoveCategory_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategoryServicePort_MoveCategory_Call) RunAndReturn(run func(ctx context.Context, userID string, id string, parentID *string) (*domain.Category, error)) *MockCategoryServicePort_MoveCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameCategory provides a mock function for the type MockCategoryServicePort
+func (_mock *MockCategoryServicePort) RenameCategory(ctx context.Context, userID string, id string, c *domain.Category) (*domain.Category, error) {
+	ret := _mock.Called(ctx, userID, id, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameCategory")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *domain.Category) (*domain.Category, error)); ok {
+		return returnFunc(ctx, userID, id, c)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *domain.Category) *domain.Category); ok {
+		r0 = returnFunc(ctx, userID, id, c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *domain.Category) error); ok {
+		r1 = returnFunc(ctx, userID, id, c)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryServicePort_RenameCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCategory'
+type MockCategoryServicePort_RenameCategory_Call struct {
+	*mock.Call
+}
+
+// RenameCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+//   - c *domain.Category
+func (_e *MockCategoryServicePort_Expecter) RenameCategory(ctx interface{}, userID interface{}, id interface{}, c interface{}) *MockCategoryServicePort_RenameCategory_Call {
+	return &MockCategoryServicePort_RenameCategory_Call{Call: _e.mock.On("RenameCategory", ctx, userID, id, c)}
+}
+
+func (_c *MockCategoryServicePort_RenameCategory_Call) Run(run func(ctx context.Context, userID string, id string, c *domain.Category)) *MockCategoryServicePort_RenameCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *domain.Category
+		if args[3] != nil {
+			arg3 = args[3].(*domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryServicePort_RenameCategory_Call) Return(category *domain.Category, err error) *MockCategoryServicePort_RenameCategory_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategoryServicePort_RenameCategory_Call) RunAndReturn(run func(ctx context.Context, userID string, id string, c *domain.Category) (*domain.Category, error)) *MockCategoryServicePort_RenameCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCategoryRepositoryPort creates a new instance of MockCategoryRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCategoryRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCategoryRepositoryPort {
+	mock := &MockCategoryRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCategoryRepositoryPort is an autogenerated mock type for the CategoryRepositoryPort type
+type MockCategoryRepositoryPort struct {
+	mock.Mock
+}
+
+type MockCategoryRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCategoryRepositoryPort) EXPECT() *MockCategoryRepositoryPort_Expecter {
+	return &MockCategoryRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) CreateCategory(ctx context.Context, c *domain.Category) error {
+	ret := _mock.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Category) error); ok {
+		r0 = returnFunc(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepositoryPort_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type MockCategoryRepositoryPort_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c *domain.Category
+func (_e *MockCategoryRepositoryPort_Expecter) CreateCategory(ctx interface{}, c interface{}) *MockCategoryRepositoryPort_CreateCategory_Call {
+	return &MockCategoryRepositoryPort_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, c)}
+}
+
+func (_c *MockCategoryRepositoryPort_CreateCategory_Call) Run(run func(ctx context.Context, c *domain.Category)) *MockCategoryRepositoryPort_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_CreateCategory_Call) Return(err error) *MockCategoryRepositoryPort_CreateCategory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_CreateCategory_Call) RunAndReturn(run func(ctx context.Context, c *domain.Category) error) *MockCategoryRepositoryPort_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) DeleteCategory(ctx context.Context, categoryID string) error {
+	ret := _mock.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepositoryPort_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type MockCategoryRepositoryPort_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID string
+func (_e *MockCategoryRepositoryPort_Expecter) DeleteCategory(ctx interface{}, categoryID interface{}) *MockCategoryRepositoryPort_DeleteCategory_Call {
+	return &MockCategoryRepositoryPort_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, categoryID)}
+}
+
+func (_c *MockCategoryRepositoryPort_DeleteCategory_Call) Run(run func(ctx context.Context, categoryID string)) *MockCategoryRepositoryPort_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_DeleteCategory_Call) Return(err error) *MockCategoryRepositoryPort_DeleteCategory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_DeleteCategory_Call) RunAndReturn(run func(ctx context.Context, categoryID string) error) *MockCategoryRepositoryPort_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCategoriesByIDs provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) FindCategoriesByIDs(ctx context.Context, categoryIDs []string) ([]domain.Category, error) {
+	ret := _mock.Called(ctx, categoryIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCategoriesByIDs")
+	}
+
+	var r0 []domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Category, error)); ok {
+		return returnFunc(ctx, categoryIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.Category); ok {
+		r0 = returnFunc(ctx, categoryIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, categoryIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepositoryPort_FindCategoriesByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCategoriesByIDs'
+type MockCategoryRepositoryPort_FindCategoriesByIDs_Call struct {
+	*mock.Call
+}
+
+// FindCategoriesByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryIDs []string
+func (_e *MockCategoryRepositoryPort_Expecter) FindCategoriesByIDs(ctx interface{}, categoryIDs interface{}) *MockCategoryRepositoryPort_FindCategoriesByIDs_Call {
+	return &MockCategoryRepositoryPort_FindCategoriesByIDs_Call{Call: _e.mock.On("FindCategoriesByIDs", ctx, categoryIDs)}
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoriesByIDs_Call) Run(run func(ctx context.Context, categoryIDs []string)) *MockCategoryRepositoryPort_FindCategoriesByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoriesByIDs_Call) Return(categorys []domain.Category, err error) *MockCategoryRepositoryPort_FindCategoriesByIDs_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoriesByIDs_Call) RunAndReturn(run func(ctx context.Context, categoryIDs []string) ([]domain.Category, error)) *MockCategoryRepositoryPort_FindCategoriesByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCategoryAncestors provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) FindCategoryAncestors(ctx context.Context, categoryID string) ([]domain.Category, error) {
+	ret := _mock.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCategoryAncestors")
+	}
+
+	var r0 []domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Category, error)); ok {
+		return returnFunc(ctx, categoryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Category); ok {
+		r0 = returnFunc(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepositoryPort_FindCategoryAncestors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCategoryAncestors'
+type MockCategoryRepositoryPort_FindCategoryAncestors_Call struct {
+	*mock.Call
+}
+
+// FindCategoryAncestors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID string
+func (_e *MockCategoryRepositoryPort_Expecter) FindCategoryAncestors(ctx interface{}, categoryID interface{}) *MockCategoryRepositoryPort_FindCategoryAncestors_Call {
+	return &MockCategoryRepositoryPort_FindCategoryAncestors_Call{Call: _e.mock.On("FindCategoryAncestors", ctx, categoryID)}
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryAncestors_Call) Run(run func(ctx context.Context, categoryID string)) *MockCategoryRepositoryPort_FindCategoryAncestors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryAncestors_Call) Return(categorys []domain.Category, err error) *MockCategoryRepositoryPort_FindCategoryAncestors_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryAncestors_Call) RunAndReturn(run func(ctx context.Context, categoryID string) ([]domain.Category, error)) *MockCategoryRepositoryPort_FindCategoryAncestors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCategoryByID provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) FindCategoryByID(ctx context.Context, categoryID string) (*domain.Category, error) {
+	ret := _mock.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCategoryByID")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Category, error)); ok {
+		return returnFunc(ctx, categoryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Category); ok {
+		r0 = returnFunc(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepositoryPort_FindCategoryByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCategoryByID'
+type MockCategoryRepositoryPort_FindCategoryByID_Call struct {
+	*mock.Call
+}
+
+// FindCategoryByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID string
+func (_e *MockCategoryRepositoryPort_Expecter) FindCategoryByID(ctx interface{}, categoryID interface{}) *MockCategoryRepositoryPort_FindCategoryByID_Call {
+	return &MockCategoryRepositoryPort_FindCategoryByID_Call{Call: _e.mock.On("FindCategoryByID", ctx, categoryID)}
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryByID_Call) Run(run func(ctx context.Context, categoryID string)) *MockCategoryRepositoryPort_FindCategoryByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryByID_Call) Return(category *domain.Category, err error) *MockCategoryRepositoryPort_FindCategoryByID_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryByID_Call) RunAndReturn(run func(ctx context.Context, categoryID string) (*domain.Category, error)) *MockCategoryRepositoryPort_FindCategoryByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCategoryBySlug provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) FindCategoryBySlug(ctx context.Context, slug string) (*domain.Category, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCategoryBySlug")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Category, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Category); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepositoryPort_FindCategoryBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCategoryBySlug'
+type MockCategoryRepositoryPort_FindCategoryBySlug_Call struct {
+	*mock.Call
+}
+
+// FindCategoryBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockCategoryRepositoryPort_Expecter) FindCategoryBySlug(ctx interface{}, slug interface{}) *MockCategoryRepositoryPort_FindCategoryBySlug_Call {
+	return &MockCategoryRepositoryPort_FindCategoryBySlug_Call{Call: _e.mock.On("FindCategoryBySlug", ctx, slug)}
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockCategoryRepositoryPort_FindCategoryBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryBySlug_Call) Return(category *domain.Category, err error) *MockCategoryRepositoryPort_FindCategoryBySlug_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Category, error)) *MockCategoryRepositoryPort_FindCategoryBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCategoryChildren provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) FindCategoryChildren(ctx context.Context, parentID string) ([]domain.Category, error) {
+	ret := _mock.Called(ctx, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCategoryChildren")
+	}
+
+	var r0 []domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Category, error)); ok {
+		return returnFunc(ctx, parentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Category); ok {
+		r0 = returnFunc(ctx, parentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepositoryPort_FindCategoryChildren_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCategoryChildren'
+type MockCategoryRepositoryPort_FindCategoryChildren_Call struct {
+	*mock.Call
+}
+
+// FindCategoryChildren is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID string
+func (_e *MockCategoryRepositoryPort_Expecter) FindCategoryChildren(ctx interface{}, parentID interface{}) *MockCategoryRepositoryPort_FindCategoryChildren_Call {
+	return &MockCategoryRepositoryPort_FindCategoryChildren_Call{Call: _e.mock.On("FindCategoryChildren", ctx, parentID)}
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryChildren_Call) Run(run func(ctx context.Context, parentID string)) *MockCategoryRepositoryPort_FindCategoryChildren_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryChildren_Call) Return(categorys []domain.Category, err error) *MockCategoryRepositoryPort_FindCategoryChildren_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategoryChildren_Call) RunAndReturn(run func(ctx context.Context, parentID string) ([]domain.Category, error)) *MockCategoryRepositoryPort_FindCategoryChildren_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCategorySummaryBySlug provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) FindCategorySummaryBySlug(ctx context.Context, slug string) (*domain.CategorySummary, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCategorySummaryBySlug")
+	}
+
+	var r0 *domain.CategorySummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.CategorySummary, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.CategorySummary); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CategorySummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCategorySummaryBySlug'
+type MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call struct {
+	*mock.Call
+}
+
+// FindCategorySummaryBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockCategoryRepositoryPort_Expecter) FindCategorySummaryBySlug(ctx interface{}, slug interface{}) *MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call {
+	return &MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call{Call: _e.mock.On("FindCategorySummaryBySlug", ctx, slug)}
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call) Return(categorySummary *domain.CategorySummary, err error) *MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call {
+	_c.Call.Return(categorySummary, err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.CategorySummary, error)) *MockCategoryRepositoryPort_FindCategorySummaryBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.Category, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.Category); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepositoryPort_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type MockCategoryRepositoryPort_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCategoryRepositoryPort_Expecter) ListCategories(ctx interface{}) *MockCategoryRepositoryPort_ListCategories_Call {
+	return &MockCategoryRepositoryPort_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+}
+
+func (_c *MockCategoryRepositoryPort_ListCategories_Call) Run(run func(ctx context.Context)) *MockCategoryRepositoryPort_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_ListCategories_Call) Return(categorys []domain.Category, err error) *MockCategoryRepositoryPort_ListCategories_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_ListCategories_Call) RunAndReturn(run func(ctx context.Context) ([]domain.Category, error)) *MockCategoryRepositoryPort_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategorySummaries provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) ListCategorySummaries(ctx context.Context) ([]domain.CategorySummary, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategorySummaries")
+	}
+
+	var r0 []domain.CategorySummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.CategorySummary, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.CategorySummary); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CategorySummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepositoryPort_ListCategorySummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategorySummaries'
+type MockCategoryRepositoryPort_ListCategorySummaries_Call struct {
+	*mock.Call
+}
+
+// ListCategorySummaries is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCategoryRepositoryPort_Expecter) ListCategorySummaries(ctx interface{}) *MockCategoryRepositoryPort_ListCategorySummaries_Call {
+	return &MockCategoryRepositoryPort_ListCategorySummaries_Call{Call: _e.mock.On("ListCategorySummaries", ctx)}
+}
+
+func (_c *MockCategoryRepositoryPort_ListCategorySummaries_Call) Run(run func(ctx context.Context)) *MockCategoryRepositoryPort_ListCategorySummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_ListCategorySummaries_Call) Return(categorySummarys []domain.CategorySummary, err error) *MockCategoryRepositoryPort_ListCategorySummaries_Call {
+	_c.Call.Return(categorySummarys, err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_ListCategorySummaries_Call) RunAndReturn(run func(ctx context.Context) ([]domain.CategorySummary, error)) *MockCategoryRepositoryPort_ListCategorySummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeCategories provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) MergeCategories(ctx context.Context, sourceID string, targetID string) error {
+	ret := _mock.Called(ctx, sourceID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeCategories")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, sourceID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepositoryPort_MergeCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeCategories'
+type MockCategoryRepositoryPort_MergeCategories_Call struct {
+	*mock.Call
+}
+
+// MergeCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceID string
+//   - targetID string
+func (_e *MockCategoryRepositoryPort_Expecter) MergeCategories(ctx interface{}, sourceID interface{}, targetID interface{}) *MockCategoryRepositoryPort_MergeCategories_Call {
+	return &MockCategoryRepositoryPort_MergeCategories_Call{Call: _e.mock.On("MergeCategories", ctx, sourceID, targetID)}
+}
+
+func (_c *MockCategoryRepositoryPort_MergeCategories_Call) Run(run func(ctx context.Context, sourceID string, targetID string)) *MockCategoryRepositoryPort_MergeCategories_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -308,6 +10319,68 @@ func (_c *MockAuthRepositoryPort_FindUserByEmail_Call) Run(run func(ctx context.
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_MergeCategories_Call) Return(err error) *MockCategoryRepositoryPort_MergeCategories_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepositoryPort_MergeCategories_Call) RunAndReturn(run func(ctx context.Context, sourceID string, targetID string) error) *MockCategoryRepositoryPort_MergeCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function for the type MockCategoryRepositoryPort
+func (_mock *MockCategoryRepositoryPort) UpdateCategory(ctx context.Context, c *domain.Category) error {
+	ret := _mock.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Category) error); ok {
+		r0 = returnFunc(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepositoryPort_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type MockCategoryRepositoryPort_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c *domain.Category
+func (_e *MockCategoryRepositoryPort_Expecter) UpdateCategory(ctx interface{}, c interface{}) *MockCategoryRepositoryPort_UpdateCategory_Call {
+	return &MockCategoryRepositoryPort_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, c)}
+}
+
+func (_c *MockCategoryRepositoryPort_UpdateCategory_Call) Run(run func(ctx context.Context, c *domain.Category)) *MockCategoryRepositoryPort_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Category)
+		}
 		run(
 			arg0,
 			arg1,
@@ -316,65 +10389,90 @@ func (_c *MockAuthRepositoryPort_FindUserByEmail_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByEmail_Call) Return(user *domain.User, err error) *MockAuthRepositoryPort_FindUserByEmail_Call {
-	_c.Call.Return(user, err)
+func (_c *MockCategoryRepositoryPort_UpdateCategory_Call) Return(err error) *MockCategoryRepositoryPort_UpdateCategory_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockAuthRepositoryPort_FindUserByEmail_Call {
+func (_c *MockCategoryRepositoryPort_UpdateCategory_Call) RunAndReturn(run func(ctx context.Context, c *domain.Category) error) *MockCategoryRepositoryPort_UpdateCategory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindUserByID provides a mock function for the type MockAuthRepositoryPort
-func (_mock *MockAuthRepositoryPort) FindUserByID(ctx context.Context, userID string) (*domain.User, error) {
-	ret := _mock.Called(ctx, userID)
+// NewMockRateLimitStorePort creates a new instance of MockRateLimitStorePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRateLimitStorePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRateLimitStorePort {
+	mock := &MockRateLimitStorePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRateLimitStorePort is an autogenerated mock type for the RateLimitStorePort type
+type MockRateLimitStorePort struct {
+	mock.Mock
+}
+
+type MockRateLimitStorePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRateLimitStorePort) EXPECT() *MockRateLimitStorePort_Expecter {
+	return &MockRateLimitStorePort_Expecter{mock: &_m.Mock}
+}
+
+// DeleteFullBuckets provides a mock function for the type MockRateLimitStorePort
+func (_mock *MockRateLimitStorePort) DeleteFullBuckets(ctx context.Context, now time.Time) (int, error) {
+	ret := _mock.Called(ctx, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindUserByID")
+		panic("no return value specified for DeleteFullBuckets")
 	}
 
-	var r0 *domain.User
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, now)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, now)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthRepositoryPort_FindUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByID'
-type MockAuthRepositoryPort_FindUserByID_Call struct {
+// MockRateLimitStorePort_DeleteFullBuckets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFullBuckets'
+type MockRateLimitStorePort_DeleteFullBuckets_Call struct {
 	*mock.Call
 }
 
-// FindUserByID is a helper method to define mock.On call
+// DeleteFullBuckets is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockAuthRepositoryPort_Expecter) FindUserByID(ctx interface{}, userID interface{}) *MockAuthRepositoryPort_FindUserByID_Call {
-	return &MockAuthRepositoryPort_FindUserByID_Call{Call: _e.mock.On("FindUserByID", ctx, userID)}
+//   - now time.Time
+func (_e *MockRateLimitStorePort_Expecter) DeleteFullBuckets(ctx interface{}, now interface{}) *MockRateLimitStorePort_DeleteFullBuckets_Call {
+	return &MockRateLimitStorePort_DeleteFullBuckets_Call{Call: _e.mock.On("DeleteFullBuckets", ctx, now)}
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByID_Call) Run(run func(ctx context.Context, userID string)) *MockAuthRepositoryPort_FindUserByID_Call {
+func (_c *MockRateLimitStorePort_DeleteFullBuckets_Call) Run(run func(ctx context.Context, now time.Time)) *MockRateLimitStorePort_DeleteFullBuckets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(time.Time)
 		}
 		run(
 			arg0,
@@ -384,57 +10482,59 @@ func (_c *MockAuthRepositoryPort_FindUserByID_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByID_Call) Return(user *domain.User, err error) *MockAuthRepositoryPort_FindUserByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockRateLimitStorePort_DeleteFullBuckets_Call) Return(n int, err error) *MockRateLimitStorePort_DeleteFullBuckets_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.User, error)) *MockAuthRepositoryPort_FindUserByID_Call {
+func (_c *MockRateLimitStorePort_DeleteFullBuckets_Call) RunAndReturn(run func(ctx context.Context, now time.Time) (int, error)) *MockRateLimitStorePort_DeleteFullBuckets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindUserByUsername provides a mock function for the type MockAuthRepositoryPort
-func (_mock *MockAuthRepositoryPort) FindUserByUsername(ctx context.Context, username string) (*domain.User, error) {
-	ret := _mock.Called(ctx, username)
+// TakeToken provides a mock function for the type MockRateLimitStorePort
+func (_mock *MockRateLimitStorePort) TakeToken(ctx context.Context, key string, policy domain.RateLimitPolicy, now time.Time) (*domain.RateLimitResult, error) {
+	ret := _mock.Called(ctx, key, policy, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindUserByUsername")
+		panic("no return value specified for TakeToken")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.RateLimitResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.RateLimitPolicy, time.Time) (*domain.RateLimitResult, error)); ok {
+		return returnFunc(ctx, key, policy, now)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.RateLimitPolicy, time.Time) *domain.RateLimitResult); ok {
+		r0 = returnFunc(ctx, key, policy, now)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.RateLimitResult)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.RateLimitPolicy, time.Time) error); ok {
+		r1 = returnFunc(ctx, key, policy, now)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthRepositoryPort_FindUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByUsername'
-type MockAuthRepositoryPort_FindUserByUsername_Call struct {
+// MockRateLimitStorePort_TakeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeToken'
+type MockRateLimitStorePort_TakeToken_Call struct {
 	*mock.Call
 }
 
-// FindUserByUsername is a helper method to define mock.On call
+// TakeToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockAuthRepositoryPort_Expecter) FindUserByUsername(ctx interface{}, username interface{}) *MockAuthRepositoryPort_FindUserByUsername_Call {
-	return &MockAuthRepositoryPort_FindUserByUsername_Call{Call: _e.mock.On("FindUserByUsername", ctx, username)}
+//   - key string
+//   - policy domain.RateLimitPolicy
+//   - now time.Time
+func (_e *MockRateLimitStorePort_Expecter) TakeToken(ctx interface{}, key interface{}, policy interface{}, now interface{}) *MockRateLimitStorePort_TakeToken_Call {
+	return &MockRateLimitStorePort_TakeToken_Call{Call: _e.mock.On("TakeToken", ctx, key, policy, now)}
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockAuthRepositoryPort_FindUserByUsername_Call {
+func (_c *MockRateLimitStorePort_TakeToken_Call) Run(run func(ctx context.Context, key string, policy domain.RateLimitPolicy, now time.Time)) *MockRateLimitStorePort_TakeToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -444,31 +10544,41 @@ func (_c *MockAuthRepositoryPort_FindUserByUsername_Call) Run(run func(ctx conte
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 domain.RateLimitPolicy
+		if args[2] != nil {
+			arg2 = args[2].(domain.RateLimitPolicy)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByUsername_Call) Return(user *domain.User, err error) *MockAuthRepositoryPort_FindUserByUsername_Call {
-	_c.Call.Return(user, err)
+func (_c *MockRateLimitStorePort_TakeToken_Call) Return(rateLimitResult *domain.RateLimitResult, err error) *MockRateLimitStorePort_TakeToken_Call {
+	_c.Call.Return(rateLimitResult, err)
 	return _c
 }
 
-func (_c *MockAuthRepositoryPort_FindUserByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*domain.User, error)) *MockAuthRepositoryPort_FindUserByUsername_Call {
+func (_c *MockRateLimitStorePort_TakeToken_Call) RunAndReturn(run func(ctx context.Context, key string, policy domain.RateLimitPolicy, now time.Time) (*domain.RateLimitResult, error)) *MockRateLimitStorePort_TakeToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPostServicePort creates a new instance of MockPostServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockRevisionRepositoryPort creates a new instance of MockRevisionRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockPostServicePort(t interface {
+func NewMockRevisionRepositoryPort(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockPostServicePort {
-	mock := &MockPostServicePort{}
+}) *MockRevisionRepositoryPort {
+	mock := &MockRevisionRepositoryPort{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -476,124 +10586,118 @@ func NewMockPostServicePort(t interface {
 	return mock
 }
 
-// MockPostServicePort is an autogenerated mock type for the PostServicePort type
-type MockPostServicePort struct {
+// MockRevisionRepositoryPort is an autogenerated mock type for the RevisionRepositoryPort type
+type MockRevisionRepositoryPort struct {
 	mock.Mock
 }
 
-type MockPostServicePort_Expecter struct {
+type MockRevisionRepositoryPort_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockPostServicePort) EXPECT() *MockPostServicePort_Expecter {
-	return &MockPostServicePort_Expecter{mock: &_m.Mock}
+func (_m *MockRevisionRepositoryPort) EXPECT() *MockRevisionRepositoryPort_Expecter {
+	return &MockRevisionRepositoryPort_Expecter{mock: &_m.Mock}
 }
 
-// CreatePost provides a mock function for the type MockPostServicePort
-func (_mock *MockPostServicePort) CreatePost(ctx context.Context, req *domain.Post, categoryIDs []string) (*domain.Post, error) {
-	ret := _mock.Called(ctx, req, categoryIDs)
+// CreateRevision provides a mock function for the type MockRevisionRepositoryPort
+func (_mock *MockRevisionRepositoryPort) CreateRevision(ctx context.Context, r *domain.PostRevision) error {
+	ret := _mock.Called(ctx, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePost")
+		panic("no return value specified for CreateRevision")
 	}
 
-	var r0 *domain.Post
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post, []string) (*domain.Post, error)); ok {
-		return returnFunc(ctx, req, categoryIDs)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post, []string) *domain.Post); ok {
-		r0 = returnFunc(ctx, req, categoryIDs)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Post)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Post, []string) error); ok {
-		r1 = returnFunc(ctx, req, categoryIDs)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PostRevision) error); ok {
+		r0 = returnFunc(ctx, r)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockPostServicePort_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
-type MockPostServicePort_CreatePost_Call struct {
+// MockRevisionRepositoryPort_CreateRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRevision'
+type MockRevisionRepositoryPort_CreateRevision_Call struct {
 	*mock.Call
 }
 
-// CreatePost is a helper method to define mock.On call
+// CreateRevision is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *domain.Post
-//   - categoryIDs []string
-func (_e *MockPostServicePort_Expecter) CreatePost(ctx interface{}, req interface{}, categoryIDs interface{}) *MockPostServicePort_CreatePost_Call {
-	return &MockPostServicePort_CreatePost_Call{Call: _e.mock.On("CreatePost", ctx, req, categoryIDs)}
+//   - r *domain.PostRevision
+func (_e *MockRevisionRepositoryPort_Expecter) CreateRevision(ctx interface{}, r interface{}) *MockRevisionRepositoryPort_CreateRevision_Call {
+	return &MockRevisionRepositoryPort_CreateRevision_Call{Call: _e.mock.On("CreateRevision", ctx, r)}
 }
 
-func (_c *MockPostServicePort_CreatePost_Call) Run(run func(ctx context.Context, req *domain.Post, categoryIDs []string)) *MockPostServicePort_CreatePost_Call {
+func (_c *MockRevisionRepositoryPort_CreateRevision_Call) Run(run func(ctx context.Context, r *domain.PostRevision)) *MockRevisionRepositoryPort_CreateRevision_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Post
+		var arg1 *domain.PostRevision
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Post)
-		}
-		var arg2 []string
-		if args[2] != nil {
-			arg2 = args[2].([]string)
+			arg1 = args[1].(*domain.PostRevision)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostServicePort_CreatePost_Call) Return(post *domain.Post, err error) *MockPostServicePort_CreatePost_Call {
-	_c.Call.Return(post, err)
+func (_c *MockRevisionRepositoryPort_CreateRevision_Call) Return(err error) *MockRevisionRepositoryPort_CreateRevision_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPostServicePort_CreatePost_Call) RunAndReturn(run func(ctx context.Context, req *domain.Post, categoryIDs []string) (*domain.Post, error)) *MockPostServicePort_CreatePost_Call {
+func (_c *MockRevisionRepositoryPort_CreateRevision_Call) RunAndReturn(run func(ctx context.Context, r *domain.PostRevision) error) *MockRevisionRepositoryPort_CreateRevision_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeletePost provides a mock function for the type MockPostServicePort
-func (_mock *MockPostServicePort) DeletePost(ctx context.Context, id string, userID string) error {
-	ret := _mock.Called(ctx, id, userID)
+// FindRevisionByID provides a mock function for the type MockRevisionRepositoryPort
+func (_mock *MockRevisionRepositoryPort) FindRevisionByID(ctx context.Context, postID string, revisionID string) (*domain.PostRevision, error) {
+	ret := _mock.Called(ctx, postID, revisionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePost")
+		panic("no return value specified for FindRevisionByID")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, id, userID)
+	var r0 *domain.PostRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.PostRevision, error)); ok {
+		return returnFunc(ctx, postID, revisionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.PostRevision); ok {
+		r0 = returnFunc(ctx, postID, revisionID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PostRevision)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, postID, revisionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPostServicePort_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
-type MockPostServicePort_DeletePost_Call struct {
+// MockRevisionRepositoryPort_FindRevisionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRevisionByID'
+type MockRevisionRepositoryPort_FindRevisionByID_Call struct {
 	*mock.Call
 }
 
-// DeletePost is a helper method to define mock.On call
+// FindRevisionByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-//   - userID string
-func (_e *MockPostServicePort_Expecter) DeletePost(ctx interface{}, id interface{}, userID interface{}) *MockPostServicePort_DeletePost_Call {
-	return &MockPostServicePort_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, id, userID)}
+//   - postID string
+//   - revisionID string
+func (_e *MockRevisionRepositoryPort_Expecter) FindRevisionByID(ctx interface{}, postID interface{}, revisionID interface{}) *MockRevisionRepositoryPort_FindRevisionByID_Call {
+	return &MockRevisionRepositoryPort_FindRevisionByID_Call{Call: _e.mock.On("FindRevisionByID", ctx, postID, revisionID)}
 }
 
-func (_c *MockPostServicePort_DeletePost_Call) Run(run func(ctx context.Context, id string, userID string)) *MockPostServicePort_DeletePost_Call {
+func (_c *MockRevisionRepositoryPort_FindRevisionByID_Call) Run(run func(ctx context.Context, postID string, revisionID string)) *MockRevisionRepositoryPort_FindRevisionByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -616,57 +10720,57 @@ func (_c *MockPostServicePort_DeletePost_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockPostServicePort_DeletePost_Call) Return(err error) *MockPostServicePort_DeletePost_Call {
-	_c.Call.Return(err)
+func (_c *MockRevisionRepositoryPort_FindRevisionByID_Call) Return(postRevision *domain.PostRevision, err error) *MockRevisionRepositoryPort_FindRevisionByID_Call {
+	_c.Call.Return(postRevision, err)
 	return _c
 }
 
-func (_c *MockPostServicePort_DeletePost_Call) RunAndReturn(run func(ctx context.Context, id string, userID string) error) *MockPostServicePort_DeletePost_Call {
+func (_c *MockRevisionRepositoryPort_FindRevisionByID_Call) RunAndReturn(run func(ctx context.Context, postID string, revisionID string) (*domain.PostRevision, error)) *MockRevisionRepositoryPort_FindRevisionByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPost provides a mock function for the type MockPostServicePort
-func (_mock *MockPostServicePort) GetPost(ctx context.Context, id string) (*domain.Post, error) {
-	ret := _mock.Called(ctx, id)
+// FindRevisionsByPostID provides a mock function for the type MockRevisionRepositoryPort
+func (_mock *MockRevisionRepositoryPort) FindRevisionsByPostID(ctx context.Context, postID string) ([]domain.PostRevision, error) {
+	ret := _mock.Called(ctx, postID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPost")
+		panic("no return value specified for FindRevisionsByPostID")
 	}
 
-	var r0 *domain.Post
+	var r0 []domain.PostRevision
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.PostRevision, error)); ok {
+		return returnFunc(ctx, postID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.PostRevision); ok {
+		r0 = returnFunc(ctx, postID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Post)
+			r0 = ret.Get(0).([]domain.PostRevision)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, id)
+		r1 = returnFunc(ctx, postID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostServicePort_GetPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPost'
-type MockPostServicePort_GetPost_Call struct {
+// MockRevisionRepositoryPort_FindRevisionsByPostID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRevisionsByPostID'
+type MockRevisionRepositoryPort_FindRevisionsByPostID_Call struct {
 	*mock.Call
 }
 
-// GetPost is a helper method to define mock.On call
+// FindRevisionsByPostID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockPostServicePort_Expecter) GetPost(ctx interface{}, id interface{}) *MockPostServicePort_GetPost_Call {
-	return &MockPostServicePort_GetPost_Call{Call: _e.mock.On("GetPost", ctx, id)}
+//   - postID string
+func (_e *MockRevisionRepositoryPort_Expecter) FindRevisionsByPostID(ctx interface{}, postID interface{}) *MockRevisionRepositoryPort_FindRevisionsByPostID_Call {
+	return &MockRevisionRepositoryPort_FindRevisionsByPostID_Call{Call: _e.mock.On("FindRevisionsByPostID", ctx, postID)}
 }
 
-func (_c *MockPostServicePort_GetPost_Call) Run(run func(ctx context.Context, id string)) *MockPostServicePort_GetPost_Call {
+func (_c *MockRevisionRepositoryPort_FindRevisionsByPostID_Call) Run(run func(ctx context.Context, postID string)) *MockRevisionRepositoryPort_FindRevisionsByPostID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -684,119 +10788,224 @@ func (_c *MockPostServicePort_GetPost_Call) Run(run func(ctx context.Context, id
 	return _c
 }
 
-func (_c *MockPostServicePort_GetPost_Call) Return(post *domain.Post, err error) *MockPostServicePort_GetPost_Call {
-	_c.Call.Return(post, err)
+func (_c *MockRevisionRepositoryPort_FindRevisionsByPostID_Call) Return(postRevisions []domain.PostRevision, err error) *MockRevisionRepositoryPort_FindRevisionsByPostID_Call {
+	_c.Call.Return(postRevisions, err)
 	return _c
 }
 
-func (_c *MockPostServicePort_GetPost_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Post, error)) *MockPostServicePort_GetPost_Call {
+func (_c *MockRevisionRepositoryPort_FindRevisionsByPostID_Call) RunAndReturn(run func(ctx context.Context, postID string) ([]domain.PostRevision, error)) *MockRevisionRepositoryPort_FindRevisionsByPostID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListPosts provides a mock function for the type MockPostServicePort
-func (_mock *MockPostServicePort) ListPosts(ctx context.Context) ([]*domain.Post, error) {
-	ret := _mock.Called(ctx)
+// NewMockTokenRevocationStorePort creates a new instance of MockTokenRevocationStorePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenRevocationStorePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenRevocationStorePort {
+	mock := &MockTokenRevocationStorePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenRevocationStorePort is an autogenerated mock type for the TokenRevocationStorePort type
+type MockTokenRevocationStorePort struct {
+	mock.Mock
+}
+
+type MockTokenRevocationStorePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenRevocationStorePort) EXPECT() *MockTokenRevocationStorePort_Expecter {
+	return &MockTokenRevocationStorePort_Expecter{mock: &_m.Mock}
+}
+
+// DeleteExpired provides a mock function for the type MockTokenRevocationStorePort
+func (_mock *MockTokenRevocationStorePort) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	ret := _mock.Called(ctx, now)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListPosts")
+		panic("no return value specified for DeleteExpired")
 	}
 
-	var r0 []*domain.Post
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Post, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, now)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Post); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, now)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Post)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostServicePort_ListPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPosts'
-type MockPostServicePort_ListPosts_Call struct {
+// MockTokenRevocationStorePort_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type MockTokenRevocationStorePort_DeleteExpired_Call struct {
 	*mock.Call
 }
 
-// ListPosts is a helper method to define mock.On call
+// DeleteExpired is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockPostServicePort_Expecter) ListPosts(ctx interface{}) *MockPostServicePort_ListPosts_Call {
-	return &MockPostServicePort_ListPosts_Call{Call: _e.mock.On("ListPosts", ctx)}
+//   - now time.Time
+func (_e *MockTokenRevocationStorePort_Expecter) DeleteExpired(ctx interface{}, now interface{}) *MockTokenRevocationStorePort_DeleteExpired_Call {
+	return &MockTokenRevocationStorePort_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", ctx, now)}
 }
 
-func (_c *MockPostServicePort_ListPosts_Call) Run(run func(ctx context.Context)) *MockPostServicePort_ListPosts_Call {
+func (_c *MockTokenRevocationStorePort_DeleteExpired_Call) Run(run func(ctx context.Context, now time.Time)) *MockTokenRevocationStorePort_DeleteExpired_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostServicePort_ListPosts_Call) Return(posts []*domain.Post, err error) *MockPostServicePort_ListPosts_Call {
-	_c.Call.Return(posts, err)
+func (_c *MockTokenRevocationStorePort_DeleteExpired_Call) Return(n int, err error) *MockTokenRevocationStorePort_DeleteExpired_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockPostServicePort_ListPosts_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Post, error)) *MockPostServicePort_ListPosts_Call {
+func (_c *MockTokenRevocationStorePort_DeleteExpired_Call) RunAndReturn(run func(ctx context.Context, now time.Time) (int, error)) *MockTokenRevocationStorePort_DeleteExpired_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListPostsByUser provides a mock function for the type MockPostServicePort
-func (_mock *MockPostServicePort) ListPostsByUser(ctx context.Context, userID string) ([]*domain.Post, error) {
-	ret := _mock.Called(ctx, userID)
+// IsTokenRevoked provides a mock function for the type MockTokenRevocationStorePort
+func (_mock *MockTokenRevocationStorePort) IsTokenRevoked(ctx context.Context, tokenID string, sessionID string, userID string, issuedAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, tokenID, sessionID, userID, issuedAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListPostsByUser")
+		panic("no return value specified for IsTokenRevoked")
 	}
 
-	var r0 []*domain.Post
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Post, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, tokenID, sessionID, userID, issuedAt)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Post); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) bool); ok {
+		r0 = returnFunc(ctx, tokenID, sessionID, userID, issuedAt)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Post)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, tokenID, sessionID, userID, issuedAt)
 	} else {
 		r1 = ret.Error(1)
 	}
-	return r0, r1
+	return r0, r1
+}
+
+// MockTokenRevocationStorePort_IsTokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTokenRevoked'
+type MockTokenRevocationStorePort_IsTokenRevoked_Call struct {
+	*mock.Call
+}
+
+// IsTokenRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - sessionID string
+//   - userID string
+//   - issuedAt time.Time
+func (_e *MockTokenRevocationStorePort_Expecter) IsTokenRevoked(ctx interface{}, tokenID interface{}, sessionID interface{}, userID interface{}, issuedAt interface{}) *MockTokenRevocationStorePort_IsTokenRevoked_Call {
+	return &MockTokenRevocationStorePort_IsTokenRevoked_Call{Call: _e.mock.On("IsTokenRevoked", ctx, tokenID, sessionID, userID, issuedAt)}
+}
+
+func (_c *MockTokenRevocationStorePort_IsTokenRevoked_Call) Run(run func(ctx context.Context, tokenID string, sessionID string, userID string, issuedAt time.Time)) *MockTokenRevocationStorePort_IsTokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenRevocationStorePort_IsTokenRevoked_Call) Return(b bool, err error) *MockTokenRevocationStorePort_IsTokenRevoked_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockTokenRevocationStorePort_IsTokenRevoked_Call) RunAndReturn(run func(ctx context.Context, tokenID string, sessionID string, userID string, issuedAt time.Time) (bool, error)) *MockTokenRevocationStorePort_IsTokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function for the type MockTokenRevocationStorePort
+func (_mock *MockTokenRevocationStorePort) RevokeToken(ctx context.Context, id string, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, id, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockPostServicePort_ListPostsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPostsByUser'
-type MockPostServicePort_ListPostsByUser_Call struct {
+// MockTokenRevocationStorePort_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockTokenRevocationStorePort_RevokeToken_Call struct {
 	*mock.Call
 }
 
-// ListPostsByUser is a helper method to define mock.On call
+// RevokeToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockPostServicePort_Expecter) ListPostsByUser(ctx interface{}, userID interface{}) *MockPostServicePort_ListPostsByUser_Call {
-	return &MockPostServicePort_ListPostsByUser_Call{Call: _e.mock.On("ListPostsByUser", ctx, userID)}
+//   - id string
+//   - expiresAt time.Time
+func (_e *MockTokenRevocationStorePort_Expecter) RevokeToken(ctx interface{}, id interface{}, expiresAt interface{}) *MockTokenRevocationStorePort_RevokeToken_Call {
+	return &MockTokenRevocationStorePort_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, id, expiresAt)}
 }
 
-func (_c *MockPostServicePort_ListPostsByUser_Call) Run(run func(ctx context.Context, userID string)) *MockPostServicePort_ListPostsByUser_Call {
+func (_c *MockTokenRevocationStorePort_RevokeToken_Call) Run(run func(ctx context.Context, id string, expiresAt time.Time)) *MockTokenRevocationStorePort_RevokeToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -806,68 +11015,61 @@ func (_c *MockPostServicePort_ListPostsByUser_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostServicePort_ListPostsByUser_Call) Return(posts []*domain.Post, err error) *MockPostServicePort_ListPostsByUser_Call {
-	_c.Call.Return(posts, err)
+func (_c *MockTokenRevocationStorePort_RevokeToken_Call) Return(err error) *MockTokenRevocationStorePort_RevokeToken_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPostServicePort_ListPostsByUser_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.Post, error)) *MockPostServicePort_ListPostsByUser_Call {
+func (_c *MockTokenRevocationStorePort_RevokeToken_Call) RunAndReturn(run func(ctx context.Context, id string, expiresAt time.Time) error) *MockTokenRevocationStorePort_RevokeToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePost provides a mock function for the type MockPostServicePort
-func (_mock *MockPostServicePort) UpdatePost(ctx context.Context, id string, userID string, req *domain.Post, categoryIDs *[]string) (*domain.Post, error) {
-	ret := _mock.Called(ctx, id, userID, req, categoryIDs)
+// RevokeUserTokens provides a mock function for the type MockTokenRevocationStorePort
+func (_mock *MockTokenRevocationStorePort) RevokeUserTokens(ctx context.Context, userID string, issuedBefore time.Time, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, userID, issuedBefore, expiresAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePost")
+		panic("no return value specified for RevokeUserTokens")
 	}
 
-	var r0 *domain.Post
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *domain.Post, *[]string) (*domain.Post, error)); ok {
-		return returnFunc(ctx, id, userID, req, categoryIDs)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *domain.Post, *[]string) *domain.Post); ok {
-		r0 = returnFunc(ctx, id, userID, req, categoryIDs)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Post)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *domain.Post, *[]string) error); ok {
-		r1 = returnFunc(ctx, id, userID, req, categoryIDs)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, issuedBefore, expiresAt)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockPostServicePort_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
-type MockPostServicePort_UpdatePost_Call struct {
+// MockTokenRevocationStorePort_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type MockTokenRevocationStorePort_RevokeUserTokens_Call struct {
 	*mock.Call
 }
 
-// UpdatePost is a helper method to define mock.On call
+// RevokeUserTokens is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
 //   - userID string
-//   - req *domain.Post
-//   - categoryIDs *[]string
-func (_e *MockPostServicePort_Expecter) UpdatePost(ctx interface{}, id interface{}, userID interface{}, req interface{}, categoryIDs interface{}) *MockPostServicePort_UpdatePost_Call {
-	return &MockPostServicePort_UpdatePost_Call{Call: _e.mock.On("UpdatePost", ctx, id, userID, req, categoryIDs)}
+//   - issuedBefore time.Time
+//   - expiresAt time.Time
+func (_e *MockTokenRevocationStorePort_Expecter) RevokeUserTokens(ctx interface{}, userID interface{}, issuedBefore interface{}, expiresAt interface{}) *MockTokenRevocationStorePort_RevokeUserTokens_Call {
+	return &MockTokenRevocationStorePort_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens", ctx, userID, issuedBefore, expiresAt)}
 }
 
-func (_c *MockPostServicePort_UpdatePost_Call) Run(run func(ctx context.Context, id string, userID string, req *domain.Post, categoryIDs *[]string)) *MockPostServicePort_UpdatePost_Call {
+func (_c *MockTokenRevocationStorePort_RevokeUserTokens_Call) Run(run func(ctx context.Context, userID string, issuedBefore time.Time, expiresAt time.Time)) *MockTokenRevocationStorePort_RevokeUserTokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -877,46 +11079,41 @@ func (_c *MockPostServicePort_UpdatePost_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(time.Time)
 		}
-		var arg3 *domain.Post
+		var arg3 time.Time
 		if args[3] != nil {
-			arg3 = args[3].(*domain.Post)
-		}
-		var arg4 *[]string
-		if args[4] != nil {
-			arg4 = args[4].(*[]string)
+			arg3 = args[3].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
-			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostServicePort_UpdatePost_Call) Return(post *domain.Post, err error) *MockPostServicePort_UpdatePost_Call {
-	_c.Call.Return(post, err)
+func (_c *MockTokenRevocationStorePort_RevokeUserTokens_Call) Return(err error) *MockTokenRevocationStorePort_RevokeUserTokens_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPostServicePort_UpdatePost_Call) RunAndReturn(run func(ctx context.Context, id string, userID string, req *domain.Post, categoryIDs *[]string) (*domain.Post, error)) *MockPostServicePort_UpdatePost_Call {
+func (_c *MockTokenRevocationStorePort_RevokeUserTokens_Call) RunAndReturn(run func(ctx context.Context, userID string, issuedBefore time.Time, expiresAt time.Time) error) *MockTokenRevocationStorePort_RevokeUserTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPostRepositoryPort creates a new instance of MockPostRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSearchPort creates a new instance of MockSearchPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockPostRepositoryPort(t interface {
+func NewMockSearchPort(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockPostRepositoryPort {
-	mock := &MockPostRepositoryPort{}
+}) *MockSearchPort {
+	mock := &MockSearchPort{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -924,120 +11121,114 @@ func NewMockPostRepositoryPort(t interface {
 	return mock
 }
 
-// MockPostRepositoryPort is an autogenerated mock type for the PostRepositoryPort type
-type MockPostRepositoryPort struct {
+// MockSearchPort is an autogenerated mock type for the SearchPort type
+type MockSearchPort struct {
 	mock.Mock
 }
 
-type MockPostRepositoryPort_Expecter struct {
+type MockSearchPort_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockPostRepositoryPort) EXPECT() *MockPostRepositoryPort_Expecter {
-	return &MockPostRepositoryPort_Expecter{mock: &_m.Mock}
+func (_m *MockSearchPort) EXPECT() *MockSearchPort_Expecter {
+	return &MockSearchPort_Expecter{mock: &_m.Mock}
 }
 
-// AddCategoriesToPost provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) AddCategoriesToPost(ctx context.Context, postID string, categoryIDs []string) error {
-	ret := _mock.Called(ctx, postID, categoryIDs)
+// IndexPost provides a mock function for the type MockSearchPort
+func (_mock *MockSearchPort) IndexPost(ctx context.Context, p *domain.Post) error {
+	ret := _mock.Called(ctx, p)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddCategoriesToPost")
+		panic("no return value specified for IndexPost")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
-		r0 = returnFunc(ctx, postID, categoryIDs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post) error); ok {
+		r0 = returnFunc(ctx, p)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPostRepositoryPort_AddCategoriesToPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCategoriesToPost'
-type MockPostRepositoryPort_AddCategoriesToPost_Call struct {
+// MockSearchPort_IndexPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IndexPost'
+type MockSearchPort_IndexPost_Call struct {
 	*mock.Call
 }
 
-// AddCategoriesToPost is a helper method to define mock.On call
+// IndexPost is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-//   - categoryIDs []string
-func (_e *MockPostRepositoryPort_Expecter) AddCategoriesToPost(ctx interface{}, postID interface{}, categoryIDs interface{}) *MockPostRepositoryPort_AddCategoriesToPost_Call {
-	return &MockPostRepositoryPort_AddCategoriesToPost_Call{Call: _e.mock.On("AddCategoriesToPost", ctx, postID, categoryIDs)}
+//   - p *domain.Post
+func (_e *MockSearchPort_Expecter) IndexPost(ctx interface{}, p interface{}) *MockSearchPort_IndexPost_Call {
+	return &MockSearchPort_IndexPost_Call{Call: _e.mock.On("IndexPost", ctx, p)}
 }
 
-func (_c *MockPostRepositoryPort_AddCategoriesToPost_Call) Run(run func(ctx context.Context, postID string, categoryIDs []string)) *MockPostRepositoryPort_AddCategoriesToPost_Call {
+func (_c *MockSearchPort_IndexPost_Call) Run(run func(ctx context.Context, p *domain.Post)) *MockSearchPort_IndexPost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *domain.Post
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 []string
-		if args[2] != nil {
-			arg2 = args[2].([]string)
+			arg1 = args[1].(*domain.Post)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_AddCategoriesToPost_Call) Return(err error) *MockPostRepositoryPort_AddCategoriesToPost_Call {
+func (_c *MockSearchPort_IndexPost_Call) Return(err error) *MockSearchPort_IndexPost_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_AddCategoriesToPost_Call) RunAndReturn(run func(ctx context.Context, postID string, categoryIDs []string) error) *MockPostRepositoryPort_AddCategoriesToPost_Call {
+func (_c *MockSearchPort_IndexPost_Call) RunAndReturn(run func(ctx context.Context, p *domain.Post) error) *MockSearchPort_IndexPost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePost provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) CreatePost(ctx context.Context, p *domain.Post) error {
-	ret := _mock.Called(ctx, p)
+// RemovePost provides a mock function for the type MockSearchPort
+func (_mock *MockSearchPort) RemovePost(ctx context.Context, postID string) error {
+	ret := _mock.Called(ctx, postID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePost")
+		panic("no return value specified for RemovePost")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post) error); ok {
-		r0 = returnFunc(ctx, p)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, postID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPostRepositoryPort_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
-type MockPostRepositoryPort_CreatePost_Call struct {
+// MockSearchPort_RemovePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePost'
+type MockSearchPort_RemovePost_Call struct {
 	*mock.Call
 }
 
-// CreatePost is a helper method to define mock.On call
+// RemovePost is a helper method to define mock.On call
 //   - ctx context.Context
-//   - p *domain.Post
-func (_e *MockPostRepositoryPort_Expecter) CreatePost(ctx interface{}, p interface{}) *MockPostRepositoryPort_CreatePost_Call {
-	return &MockPostRepositoryPort_CreatePost_Call{Call: _e.mock.On("CreatePost", ctx, p)}
+//   - postID string
+func (_e *MockSearchPort_Expecter) RemovePost(ctx interface{}, postID interface{}) *MockSearchPort_RemovePost_Call {
+	return &MockSearchPort_RemovePost_Call{Call: _e.mock.On("RemovePost", ctx, postID)}
 }
 
-func (_c *MockPostRepositoryPort_CreatePost_Call) Run(run func(ctx context.Context, p *domain.Post)) *MockPostRepositoryPort_CreatePost_Call {
+func (_c *MockSearchPort_RemovePost_Call) Run(run func(ctx context.Context, postID string)) *MockSearchPort_RemovePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Post
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Post)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -1047,54 +11238,71 @@ func (_c *MockPostRepositoryPort_CreatePost_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_CreatePost_Call) Return(err error) *MockPostRepositoryPort_CreatePost_Call {
+func (_c *MockSearchPort_RemovePost_Call) Return(err error) *MockSearchPort_RemovePost_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_CreatePost_Call) RunAndReturn(run func(ctx context.Context, p *domain.Post) error) *MockPostRepositoryPort_CreatePost_Call {
+func (_c *MockSearchPort_RemovePost_Call) RunAndReturn(run func(ctx context.Context, postID string) error) *MockSearchPort_RemovePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeletePost provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) DeletePost(ctx context.Context, postID string) error {
-	ret := _mock.Called(ctx, postID)
+// SearchPosts provides a mock function for the type MockSearchPort
+func (_mock *MockSearchPort) SearchPosts(ctx context.Context, q domain.PostSearchQuery) ([]domain.PostSearchHit, int, error) {
+	ret := _mock.Called(ctx, q)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePost")
+		panic("no return value specified for SearchPosts")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, postID)
+	var r0 []domain.PostSearchHit
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostSearchQuery) ([]domain.PostSearchHit, int, error)); ok {
+		return returnFunc(ctx, q)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PostSearchQuery) []domain.PostSearchHit); ok {
+		r0 = returnFunc(ctx, q)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PostSearchHit)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PostSearchQuery) int); ok {
+		r1 = returnFunc(ctx, q)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.PostSearchQuery) error); ok {
+		r2 = returnFunc(ctx, q)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockPostRepositoryPort_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
-type MockPostRepositoryPort_DeletePost_Call struct {
+// MockSearchPort_SearchPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchPosts'
+type MockSearchPort_SearchPosts_Call struct {
 	*mock.Call
 }
 
-// DeletePost is a helper method to define mock.On call
+// SearchPosts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-func (_e *MockPostRepositoryPort_Expecter) DeletePost(ctx interface{}, postID interface{}) *MockPostRepositoryPort_DeletePost_Call {
-	return &MockPostRepositoryPort_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, postID)}
+//   - q domain.PostSearchQuery
+func (_e *MockSearchPort_Expecter) SearchPosts(ctx interface{}, q interface{}) *MockSearchPort_SearchPosts_Call {
+	return &MockSearchPort_SearchPosts_Call{Call: _e.mock.On("SearchPosts", ctx, q)}
 }
 
-func (_c *MockPostRepositoryPort_DeletePost_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_DeletePost_Call {
+func (_c *MockSearchPort_SearchPosts_Call) Run(run func(ctx context.Context, q domain.PostSearchQuery)) *MockSearchPort_SearchPosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.PostSearchQuery
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.PostSearchQuery)
 		}
 		run(
 			arg0,
@@ -1104,102 +11312,150 @@ func (_c *MockPostRepositoryPort_DeletePost_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_DeletePost_Call) Return(err error) *MockPostRepositoryPort_DeletePost_Call {
-	_c.Call.Return(err)
+func (_c *MockSearchPort_SearchPosts_Call) Return(postSearchHits []domain.PostSearchHit, n int, err error) *MockSearchPort_SearchPosts_Call {
+	_c.Call.Return(postSearchHits, n, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_DeletePost_Call) RunAndReturn(run func(ctx context.Context, postID string) error) *MockPostRepositoryPort_DeletePost_Call {
+func (_c *MockSearchPort_SearchPosts_Call) RunAndReturn(run func(ctx context.Context, q domain.PostSearchQuery) ([]domain.PostSearchHit, int, error)) *MockSearchPort_SearchPosts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindPostByID provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) FindPostByID(ctx context.Context, postID string) (*domain.Post, error) {
-	ret := _mock.Called(ctx, postID)
+// NewMockTagServicePort creates a new instance of MockTagServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTagServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTagServicePort {
+	mock := &MockTagServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTagServicePort is an autogenerated mock type for the TagServicePort type
+type MockTagServicePort struct {
+	mock.Mock
+}
+
+type MockTagServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTagServicePort) EXPECT() *MockTagServicePort_Expecter {
+	return &MockTagServicePort_Expecter{mock: &_m.Mock}
+}
+
+// ListTags provides a mock function for the type MockTagServicePort
+func (_mock *MockTagServicePort) ListTags(ctx context.Context) ([]domain.TagSummary, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindPostByID")
+		panic("no return value specified for ListTags")
 	}
 
-	var r0 *domain.Post
+	var r0 []domain.TagSummary
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
-		return returnFunc(ctx, postID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.TagSummary, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
-		r0 = returnFunc(ctx, postID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.TagSummary); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Post)
+			r0 = ret.Get(0).([]domain.TagSummary)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, postID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostRepositoryPort_FindPostByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostByID'
-type MockPostRepositoryPort_FindPostByID_Call struct {
+// MockTagServicePort_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type MockTagServicePort_ListTags_Call struct {
 	*mock.Call
 }
 
-// FindPostByID is a helper method to define mock.On call
+// ListTags is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-func (_e *MockPostRepositoryPort_Expecter) FindPostByID(ctx interface{}, postID interface{}) *MockPostRepositoryPort_FindPostByID_Call {
-	return &MockPostRepositoryPort_FindPostByID_Call{Call: _e.mock.On("FindPostByID", ctx, postID)}
+func (_e *MockTagServicePort_Expecter) ListTags(ctx interface{}) *MockTagServicePort_ListTags_Call {
+	return &MockTagServicePort_ListTags_Call{Call: _e.mock.On("ListTags", ctx)}
 }
 
-func (_c *MockPostRepositoryPort_FindPostByID_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_FindPostByID_Call {
+func (_c *MockTagServicePort_ListTags_Call) Run(run func(ctx context.Context)) *MockTagServicePort_ListTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_FindPostByID_Call) Return(post *domain.Post, err error) *MockPostRepositoryPort_FindPostByID_Call {
-	_c.Call.Return(post, err)
+func (_c *MockTagServicePort_ListTags_Call) Return(tagSummarys []domain.TagSummary, err error) *MockTagServicePort_ListTags_Call {
+	_c.Call.Return(tagSummarys, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_FindPostByID_Call) RunAndReturn(run func(ctx context.Context, postID string) (*domain.Post, error)) *MockPostRepositoryPort_FindPostByID_Call {
+func (_c *MockTagServicePort_ListTags_Call) RunAndReturn(run func(ctx context.Context) ([]domain.TagSummary, error)) *MockTagServicePort_ListTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindPostBySlug provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) FindPostBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+// NewMockTagRepositoryPort creates a new instance of MockTagRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTagRepositoryPort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTagRepositoryPort {
+	mock := &MockTagRepositoryPort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTagRepositoryPort is an autogenerated mock type for the TagRepositoryPort type
+type MockTagRepositoryPort struct {
+	mock.Mock
+}
+
+type MockTagRepositoryPort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTagRepositoryPort) EXPECT() *MockTagRepositoryPort_Expecter {
+	return &MockTagRepositoryPort_Expecter{mock: &_m.Mock}
+}
+
+// FindTagBySlug provides a mock function for the type MockTagRepositoryPort
+func (_mock *MockTagRepositoryPort) FindTagBySlug(ctx context.Context, slug string) (*domain.Tag, error) {
 	ret := _mock.Called(ctx, slug)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindPostBySlug")
+		panic("no return value specified for FindTagBySlug")
 	}
 
-	var r0 *domain.Post
+	var r0 *domain.Tag
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Post, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Tag, error)); ok {
 		return returnFunc(ctx, slug)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Post); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Tag); ok {
 		r0 = returnFunc(ctx, slug)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Post)
+			r0 = ret.Get(0).(*domain.Tag)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
@@ -1210,19 +11466,19 @@ func (_mock *MockPostRepositoryPort) FindPostBySlug(ctx context.Context, slug st
 	return r0, r1
 }
 
-// MockPostRepositoryPort_FindPostBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostBySlug'
-type MockPostRepositoryPort_FindPostBySlug_Call struct {
+// MockTagRepositoryPort_FindTagBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTagBySlug'
+type MockTagRepositoryPort_FindTagBySlug_Call struct {
 	*mock.Call
 }
 
-// FindPostBySlug is a helper method to define mock.On call
+// FindTagBySlug is a helper method to define mock.On call
 //   - ctx context.Context
 //   - slug string
-func (_e *MockPostRepositoryPort_Expecter) FindPostBySlug(ctx interface{}, slug interface{}) *MockPostRepositoryPort_FindPostBySlug_Call {
-	return &MockPostRepositoryPort_FindPostBySlug_Call{Call: _e.mock.On("FindPostBySlug", ctx, slug)}
+func (_e *MockTagRepositoryPort_Expecter) FindTagBySlug(ctx interface{}, slug interface{}) *MockTagRepositoryPort_FindTagBySlug_Call {
+	return &MockTagRepositoryPort_FindTagBySlug_Call{Call: _e.mock.On("FindTagBySlug", ctx, slug)}
 }
 
-func (_c *MockPostRepositoryPort_FindPostBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockPostRepositoryPort_FindPostBySlug_Call {
+func (_c *MockTagRepositoryPort_FindTagBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockTagRepositoryPort_FindTagBySlug_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1240,133 +11496,127 @@ func (_c *MockPostRepositoryPort_FindPostBySlug_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_FindPostBySlug_Call) Return(post *domain.Post, err error) *MockPostRepositoryPort_FindPostBySlug_Call {
-	_c.Call.Return(post, err)
+func (_c *MockTagRepositoryPort_FindTagBySlug_Call) Return(tag *domain.Tag, err error) *MockTagRepositoryPort_FindTagBySlug_Call {
+	_c.Call.Return(tag, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_FindPostBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Post, error)) *MockPostRepositoryPort_FindPostBySlug_Call {
+func (_c *MockTagRepositoryPort_FindTagBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Tag, error)) *MockTagRepositoryPort_FindTagBySlug_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindPostsByUserID provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) FindPostsByUserID(ctx context.Context, userID string) ([]*domain.Post, error) {
-	ret := _mock.Called(ctx, userID)
+// ListTagSummaries provides a mock function for the type MockTagRepositoryPort
+func (_mock *MockTagRepositoryPort) ListTagSummaries(ctx context.Context) ([]domain.TagSummary, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindPostsByUserID")
+		panic("no return value specified for ListTagSummaries")
 	}
 
-	var r0 []*domain.Post
+	var r0 []domain.TagSummary
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Post, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.TagSummary, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Post); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.TagSummary); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Post)
+			r0 = ret.Get(0).([]domain.TagSummary)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostRepositoryPort_FindPostsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostsByUserID'
-type MockPostRepositoryPort_FindPostsByUserID_Call struct {
+// MockTagRepositoryPort_ListTagSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTagSummaries'
+type MockTagRepositoryPort_ListTagSummaries_Call struct {
 	*mock.Call
 }
 
-// FindPostsByUserID is a helper method to define mock.On call
+// ListTagSummaries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockPostRepositoryPort_Expecter) FindPostsByUserID(ctx interface{}, userID interface{}) *MockPostRepositoryPort_FindPostsByUserID_Call {
-	return &MockPostRepositoryPort_FindPostsByUserID_Call{Call: _e.mock.On("FindPostsByUserID", ctx, userID)}
+func (_e *MockTagRepositoryPort_Expecter) ListTagSummaries(ctx interface{}) *MockTagRepositoryPort_ListTagSummaries_Call {
+	return &MockTagRepositoryPort_ListTagSummaries_Call{Call: _e.mock.On("ListTagSummaries", ctx)}
 }
 
-func (_c *MockPostRepositoryPort_FindPostsByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockPostRepositoryPort_FindPostsByUserID_Call {
+func (_c *MockTagRepositoryPort_ListTagSummaries_Call) Run(run func(ctx context.Context)) *MockTagRepositoryPort_ListTagSummaries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_FindPostsByUserID_Call) Return(posts []*domain.Post, err error) *MockPostRepositoryPort_FindPostsByUserID_Call {
-	_c.Call.Return(posts, err)
+func (_c *MockTagRepositoryPort_ListTagSummaries_Call) Return(tagSummarys []domain.TagSummary, err error) *MockTagRepositoryPort_ListTagSummaries_Call {
+	_c.Call.Return(tagSummarys, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_FindPostsByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.Post, error)) *MockPostRepositoryPort_FindPostsByUserID_Call {
+func (_c *MockTagRepositoryPort_ListTagSummaries_Call) RunAndReturn(run func(ctx context.Context) ([]domain.TagSummary, error)) *MockTagRepositoryPort_ListTagSummaries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPostCategories provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) GetPostCategories(ctx context.Context, postID string) ([]domain.Category, error) {
-	ret := _mock.Called(ctx, postID)
+// UpsertTags provides a mock function for the type MockTagRepositoryPort
+func (_mock *MockTagRepositoryPort) UpsertTags(ctx context.Context, tags []domain.Tag) ([]domain.Tag, error) {
+	ret := _mock.Called(ctx, tags)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPostCategories")
+		panic("no return value specified for UpsertTags")
 	}
 
-	var r0 []domain.Category
+	var r0 []domain.Tag
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Category, error)); ok {
-		return returnFunc(ctx, postID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Tag) ([]domain.Tag, error)); ok {
+		return returnFunc(ctx, tags)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Category); ok {
-		r0 = returnFunc(ctx, postID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Tag) []domain.Tag); ok {
+		r0 = returnFunc(ctx, tags)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Category)
+			r0 = ret.Get(0).([]domain.Tag)
 		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, postID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.Tag) error); ok {
+		r1 = returnFunc(ctx, tags)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostRepositoryPort_GetPostCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostCategories'
-type MockPostRepositoryPort_GetPostCategories_Call struct {
+// MockTagRepositoryPort_UpsertTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertTags'
+type MockTagRepositoryPort_UpsertTags_Call struct {
 	*mock.Call
 }
 
-// GetPostCategories is a helper method to define mock.On call
+// UpsertTags is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-func (_e *MockPostRepositoryPort_Expecter) GetPostCategories(ctx interface{}, postID interface{}) *MockPostRepositoryPort_GetPostCategories_Call {
-	return &MockPostRepositoryPort_GetPostCategories_Call{Call: _e.mock.On("GetPostCategories", ctx, postID)}
+//   - tags []domain.Tag
+func (_e *MockTagRepositoryPort_Expecter) UpsertTags(ctx interface{}, tags interface{}) *MockTagRepositoryPort_UpsertTags_Call {
+	return &MockTagRepositoryPort_UpsertTags_Call{Call: _e.mock.On("UpsertTags", ctx, tags)}
 }
 
-func (_c *MockPostRepositoryPort_GetPostCategories_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_GetPostCategories_Call {
+func (_c *MockTagRepositoryPort_UpsertTags_Call) Run(run func(ctx context.Context, tags []domain.Tag)) *MockTagRepositoryPort_UpsertTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 []domain.Tag
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].([]domain.Tag)
 		}
 		run(
 			arg0,
@@ -1376,173 +11626,241 @@ func (_c *MockPostRepositoryPort_GetPostCategories_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_GetPostCategories_Call) Return(categorys []domain.Category, err error) *MockPostRepositoryPort_GetPostCategories_Call {
-	_c.Call.Return(categorys, err)
+func (_c *MockTagRepositoryPort_UpsertTags_Call) Return(tags1 []domain.Tag, err error) *MockTagRepositoryPort_UpsertTags_Call {
+	_c.Call.Return(tags1, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_GetPostCategories_Call) RunAndReturn(run func(ctx context.Context, postID string) ([]domain.Category, error)) *MockPostRepositoryPort_GetPostCategories_Call {
+func (_c *MockTagRepositoryPort_UpsertTags_Call) RunAndReturn(run func(ctx context.Context, tags []domain.Tag) ([]domain.Tag, error)) *MockTagRepositoryPort_UpsertTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListPosts provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) ListPosts(ctx context.Context) ([]*domain.Post, error) {
-	ret := _mock.Called(ctx)
+// NewMockUserServicePort creates a new instance of MockUserServicePort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserServicePort(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserServicePort {
+	mock := &MockUserServicePort{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserServicePort is an autogenerated mock type for the UserServicePort type
+type MockUserServicePort struct {
+	mock.Mock
+}
+
+type MockUserServicePort_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserServicePort) EXPECT() *MockUserServicePort_Expecter {
+	return &MockUserServicePort_Expecter{mock: &_m.Mock}
+}
+
+// ChangeRole provides a mock function for the type MockUserServicePort
+func (_mock *MockUserServicePort) ChangeRole(ctx context.Context, actor domain.Actor, userID string, role string) (*domain.User, error) {
+	ret := _mock.Called(ctx, actor, userID, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListPosts")
+		panic("no return value specified for ChangeRole")
 	}
 
-	var r0 []*domain.Post
+	var r0 *domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Post, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, actor, userID, role)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Post); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, actor, userID, role)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Post)
+			r0 = ret.Get(0).(*domain.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Actor, string, string) error); ok {
+		r1 = returnFunc(ctx, actor, userID, role)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostRepositoryPort_ListPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPosts'
-type MockPostRepositoryPort_ListPosts_Call struct {
+// MockUserServicePort_ChangeRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeRole'
+type MockUserServicePort_ChangeRole_Call struct {
 	*mock.Call
 }
 
-// ListPosts is a helper method to define mock.On call
+// ChangeRole is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockPostRepositoryPort_Expecter) ListPosts(ctx interface{}) *MockPostRepositoryPort_ListPosts_Call {
-	return &MockPostRepositoryPort_ListPosts_Call{Call: _e.mock.On("ListPosts", ctx)}
+//   - actor domain.Actor
+//   - userID string
+//   - role string
+func (_e *MockUserServicePort_Expecter) ChangeRole(ctx interface{}, actor interface{}, userID interface{}, role interface{}) *MockUserServicePort_ChangeRole_Call {
+	return &MockUserServicePort_ChangeRole_Call{Call: _e.mock.On("ChangeRole", ctx, actor, userID, role)}
 }
 
-func (_c *MockPostRepositoryPort_ListPosts_Call) Run(run func(ctx context.Context)) *MockPostRepositoryPort_ListPosts_Call {
+func (_c *MockUserServicePort_ChangeRole_Call) Run(run func(ctx context.Context, actor domain.Actor, userID string, role string)) *MockUserServicePort_ChangeRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 domain.Actor
+		if args[1] != nil {
+			arg1 = args[1].(domain.Actor)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_ListPosts_Call) Return(posts []*domain.Post, err error) *MockPostRepositoryPort_ListPosts_Call {
-	_c.Call.Return(posts, err)
+func (_c *MockUserServicePort_ChangeRole_Call) Return(user *domain.User, err error) *MockUserServicePort_ChangeRole_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_ListPosts_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Post, error)) *MockPostRepositoryPort_ListPosts_Call {
+func (_c *MockUserServicePort_ChangeRole_Call) RunAndReturn(run func(ctx context.Context, actor domain.Actor, userID string, role string) (*domain.User, error)) *MockUserServicePort_ChangeRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveCategoriesFromPost provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) RemoveCategoriesFromPost(ctx context.Context, postID string) error {
-	ret := _mock.Called(ctx, postID)
+// DeleteUser provides a mock function for the type MockUserServicePort
+func (_mock *MockUserServicePort) DeleteUser(ctx context.Context, actor domain.Actor, userID string, req domain.DeleteUserReq) error {
+	ret := _mock.Called(ctx, actor, userID, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveCategoriesFromPost")
+		panic("no return value specified for DeleteUser")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, postID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor, string, domain.DeleteUserReq) error); ok {
+		r0 = returnFunc(ctx, actor, userID, req)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPostRepositoryPort_RemoveCategoriesFromPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCategoriesFromPost'
-type MockPostRepositoryPort_RemoveCategoriesFromPost_Call struct {
+// MockUserServicePort_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockUserServicePort_DeleteUser_Call struct {
 	*mock.Call
 }
 
-// RemoveCategoriesFromPost is a helper method to define mock.On call
+// DeleteUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-func (_e *MockPostRepositoryPort_Expecter) RemoveCategoriesFromPost(ctx interface{}, postID interface{}) *MockPostRepositoryPort_RemoveCategoriesFromPost_Call {
-	return &MockPostRepositoryPort_RemoveCategoriesFromPost_Call{Call: _e.mock.On("RemoveCategoriesFromPost", ctx, postID)}
+//   - actor domain.Actor
+//   - userID string
+//   - req domain.DeleteUserReq
+func (_e *MockUserServicePort_Expecter) DeleteUser(ctx interface{}, actor interface{}, userID interface{}, req interface{}) *MockUserServicePort_DeleteUser_Call {
+	return &MockUserServicePort_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, actor, userID, req)}
 }
 
-func (_c *MockPostRepositoryPort_RemoveCategoriesFromPost_Call) Run(run func(ctx context.Context, postID string)) *MockPostRepositoryPort_RemoveCategoriesFromPost_Call {
+func (_c *MockUserServicePort_DeleteUser_Call) Run(run func(ctx context.Context, actor domain.Actor, userID string, req domain.DeleteUserReq)) *MockUserServicePort_DeleteUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.Actor
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.Actor)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.DeleteUserReq
+		if args[3] != nil {
+			arg3 = args[3].(domain.DeleteUserReq)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_RemoveCategoriesFromPost_Call) Return(err error) *MockPostRepositoryPort_RemoveCategoriesFromPost_Call {
+func (_c *MockUserServicePort_DeleteUser_Call) Return(err error) *MockUserServicePort_DeleteUser_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_RemoveCategoriesFromPost_Call) RunAndReturn(run func(ctx context.Context, postID string) error) *MockPostRepositoryPort_RemoveCategoriesFromPost_Call {
+func (_c *MockUserServicePort_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, actor domain.Actor, userID string, req domain.DeleteUserReq) error) *MockUserServicePort_DeleteUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePost provides a mock function for the type MockPostRepositoryPort
-func (_mock *MockPostRepositoryPort) UpdatePost(ctx context.Context, p *domain.Post) error {
-	ret := _mock.Called(ctx, p)
+// GetUser provides a mock function for the type MockUserServicePort
+func (_mock *MockUserServicePort) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePost")
+		panic("no return value specified for GetUser")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Post) error); ok {
-		r0 = returnFunc(ctx, p)
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPostRepositoryPort_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
-type MockPostRepositoryPort_UpdatePost_Call struct {
+// MockUserServicePort_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockUserServicePort_GetUser_Call struct {
 	*mock.Call
 }
 
-// UpdatePost is a helper method to define mock.On call
+// GetUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - p *domain.Post
-func (_e *MockPostRepositoryPort_Expecter) UpdatePost(ctx interface{}, p interface{}) *MockPostRepositoryPort_UpdatePost_Call {
-	return &MockPostRepositoryPort_UpdatePost_Call{Call: _e.mock.On("UpdatePost", ctx, p)}
+//   - userID string
+func (_e *MockUserServicePort_Expecter) GetUser(ctx interface{}, userID interface{}) *MockUserServicePort_GetUser_Call {
+	return &MockUserServicePort_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID)}
 }
 
-func (_c *MockPostRepositoryPort_UpdatePost_Call) Run(run func(ctx context.Context, p *domain.Post)) *MockPostRepositoryPort_UpdatePost_Call {
+func (_c *MockUserServicePort_GetUser_Call) Run(run func(ctx context.Context, userID string)) *MockUserServicePort_GetUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Post
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Post)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -1552,81 +11870,65 @@ func (_c *MockPostRepositoryPort_UpdatePost_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_UpdatePost_Call) Return(err error) *MockPostRepositoryPort_UpdatePost_Call {
-	_c.Call.Return(err)
+func (_c *MockUserServicePort_GetUser_Call) Return(user *domain.User, err error) *MockUserServicePort_GetUser_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockPostRepositoryPort_UpdatePost_Call) RunAndReturn(run func(ctx context.Context, p *domain.Post) error) *MockPostRepositoryPort_UpdatePost_Call {
+func (_c *MockUserServicePort_GetUser_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.User, error)) *MockUserServicePort_GetUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCategoryRepositoryPort creates a new instance of MockCategoryRepositoryPort. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockCategoryRepositoryPort(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockCategoryRepositoryPort {
-	mock := &MockCategoryRepositoryPort{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockCategoryRepositoryPort is an autogenerated mock type for the CategoryRepositoryPort type
-type MockCategoryRepositoryPort struct {
-	mock.Mock
-}
-
-type MockCategoryRepositoryPort_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockCategoryRepositoryPort) EXPECT() *MockCategoryRepositoryPort_Expecter {
-	return &MockCategoryRepositoryPort_Expecter{mock: &_m.Mock}
-}
-
-// CreateCategory provides a mock function for the type MockCategoryRepositoryPort
-func (_mock *MockCategoryRepositoryPort) CreateCategory(ctx context.Context, c *domain.Category) error {
-	ret := _mock.Called(ctx, c)
+// ListUsers provides a mock function for the type MockUserServicePort
+func (_mock *MockUserServicePort) ListUsers(ctx context.Context, opts domain.UserListOptions) (*domain.UserPage, error) {
+	ret := _mock.Called(ctx, opts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCategory")
+		panic("no return value specified for ListUsers")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Category) error); ok {
-		r0 = returnFunc(ctx, c)
+	var r0 *domain.UserPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserListOptions) (*domain.UserPage, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserListOptions) *domain.UserPage); ok {
+		r0 = returnFunc(ctx, opts)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserPage)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockCategoryRepositoryPort_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
-type MockCategoryRepositoryPort_CreateCategory_Call struct {
+// MockUserServicePort_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockUserServicePort_ListUsers_Call struct {
 	*mock.Call
 }
 
-// CreateCategory is a helper method to define mock.On call
+// ListUsers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - c *domain.Category
-func (_e *MockCategoryRepositoryPort_Expecter) CreateCategory(ctx interface{}, c interface{}) *MockCategoryRepositoryPort_CreateCategory_Call {
-	return &MockCategoryRepositoryPort_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, c)}
+//   - opts domain.UserListOptions
+func (_e *MockUserServicePort_Expecter) ListUsers(ctx interface{}, opts interface{}) *MockUserServicePort_ListUsers_Call {
+	return &MockUserServicePort_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, opts)}
 }
 
-func (_c *MockCategoryRepositoryPort_CreateCategory_Call) Run(run func(ctx context.Context, c *domain.Category)) *MockCategoryRepositoryPort_CreateCategory_Call {
+func (_c *MockUserServicePort_ListUsers_Call) Run(run func(ctx context.Context, opts domain.UserListOptions)) *MockUserServicePort_ListUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Category
+		var arg1 domain.UserListOptions
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Category)
+			arg1 = args[1].(domain.UserListOptions)
 		}
 		run(
 			arg0,
@@ -1636,142 +11938,154 @@ func (_c *MockCategoryRepositoryPort_CreateCategory_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockCategoryRepositoryPort_CreateCategory_Call) Return(err error) *MockCategoryRepositoryPort_CreateCategory_Call {
-	_c.Call.Return(err)
+func (_c *MockUserServicePort_ListUsers_Call) Return(userPage *domain.UserPage, err error) *MockUserServicePort_ListUsers_Call {
+	_c.Call.Return(userPage, err)
 	return _c
 }
 
-func (_c *MockCategoryRepositoryPort_CreateCategory_Call) RunAndReturn(run func(ctx context.Context, c *domain.Category) error) *MockCategoryRepositoryPort_CreateCategory_Call {
+func (_c *MockUserServicePort_ListUsers_Call) RunAndReturn(run func(ctx context.Context, opts domain.UserListOptions) (*domain.UserPage, error)) *MockUserServicePort_ListUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindCategoryByID provides a mock function for the type MockCategoryRepositoryPort
-func (_mock *MockCategoryRepositoryPort) FindCategoryByID(ctx context.Context, categoryID string) (*domain.Category, error) {
-	ret := _mock.Called(ctx, categoryID)
+// SuspendUser provides a mock function for the type MockUserServicePort
+func (_mock *MockUserServicePort) SuspendUser(ctx context.Context, actor domain.Actor, userID string) (*domain.User, error) {
+	ret := _mock.Called(ctx, actor, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindCategoryByID")
+		panic("no return value specified for SuspendUser")
 	}
 
-	var r0 *domain.Category
+	var r0 *domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Category, error)); ok {
-		return returnFunc(ctx, categoryID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, actor, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Category); ok {
-		r0 = returnFunc(ctx, categoryID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Actor, string) *domain.User); ok {
+		r0 = returnFunc(ctx, actor, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Category)
+			r0 = ret.Get(0).(*domain.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, categoryID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Actor, string) error); ok {
+		r1 = returnFunc(ctx, actor, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCategoryRepositoryPort_FindCategoryByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCategoryByID'
-type MockCategoryRepositoryPort_FindCategoryByID_Call struct {
+// MockUserServicePort_SuspendUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendUser'
+type MockUserServicePort_SuspendUser_Call struct {
 	*mock.Call
 }
 
-// FindCategoryByID is a helper method to define mock.On call
+// SuspendUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - categoryID string
-func (_e *MockCategoryRepositoryPort_Expecter) FindCategoryByID(ctx interface{}, categoryID interface{}) *MockCategoryRepositoryPort_FindCategoryByID_Call {
-	return &MockCategoryRepositoryPort_FindCategoryByID_Call{Call: _e.mock.On("FindCategoryByID", ctx, categoryID)}
+//   - actor domain.Actor
+//   - userID string
+func (_e *MockUserServicePort_Expecter) SuspendUser(ctx interface{}, actor interface{}, userID interface{}) *MockUserServicePort_SuspendUser_Call {
+	return &MockUserServicePort_SuspendUser_Call{Call: _e.mock.On("SuspendUser", ctx, actor, userID)}
 }
 
-func (_c *MockCategoryRepositoryPort_FindCategoryByID_Call) Run(run func(ctx context.Context, categoryID string)) *MockCategoryRepositoryPort_FindCategoryByID_Call {
+func (_c *MockUserServicePort_SuspendUser_Call) Run(run func(ctx context.Context, actor domain.Actor, userID string)) *MockUserServicePort_SuspendUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.Actor
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.Actor)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCategoryRepositoryPort_FindCategoryByID_Call) Return(category *domain.Category, err error) *MockCategoryRepositoryPort_FindCategoryByID_Call {
-	_c.Call.Return(category, err)
+func (_c *MockUserServicePort_SuspendUser_Call) Return(user *domain.User, err error) *MockUserServicePort_SuspendUser_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockCategoryRepositoryPort_FindCategoryByID_Call) RunAndReturn(run func(ctx context.Context, categoryID string) (*domain.Category, error)) *MockCategoryRepositoryPort_FindCategoryByID_Call {
+func (_c *MockUserServicePort_SuspendUser_Call) RunAndReturn(run func(ctx context.Context, actor domain.Actor, userID string) (*domain.User, error)) *MockUserServicePort_SuspendUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListCategories provides a mock function for the type MockCategoryRepositoryPort
-func (_mock *MockCategoryRepositoryPort) ListCategories(ctx context.Context) ([]domain.Category, error) {
-	ret := _mock.Called(ctx)
+// UnsuspendUser provides a mock function for the type MockUserServicePort
+func (_mock *MockUserServicePort) UnsuspendUser(ctx context.Context, userID string) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListCategories")
+		panic("no return value specified for UnsuspendUser")
 	}
 
-	var r0 []domain.Category
+	var r0 *domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.Category, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.Category); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Category)
+			r0 = ret.Get(0).(*domain.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCategoryRepositoryPort_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
-type MockCategoryRepositoryPort_ListCategories_Call struct {
+// MockUserServicePort_UnsuspendUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsuspendUser'
+type MockUserServicePort_UnsuspendUser_Call struct {
 	*mock.Call
 }
 
-// ListCategories is a helper method to define mock.On call
+// UnsuspendUser is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockCategoryRepositoryPort_Expecter) ListCategories(ctx interface{}) *MockCategoryRepositoryPort_ListCategories_Call {
-	return &MockCategoryRepositoryPort_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+//   - userID string
+func (_e *MockUserServicePort_Expecter) UnsuspendUser(ctx interface{}, userID interface{}) *MockUserServicePort_UnsuspendUser_Call {
+	return &MockUserServicePort_UnsuspendUser_Call{Call: _e.mock.On("UnsuspendUser", ctx, userID)}
 }
 
-func (_c *MockCategoryRepositoryPort_ListCategories_Call) Run(run func(ctx context.Context)) *MockCategoryRepositoryPort_ListCategories_Call {
+func (_c *MockUserServicePort_UnsuspendUser_Call) Run(run func(ctx context.Context, userID string)) *MockUserServicePort_UnsuspendUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCategoryRepositoryPort_ListCategories_Call) Return(categorys []domain.Category, err error) *MockCategoryRepositoryPort_ListCategories_Call {
-	_c.Call.Return(categorys, err)
+func (_c *MockUserServicePort_UnsuspendUser_Call) Return(user *domain.User, err error) *MockUserServicePort_UnsuspendUser_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockCategoryRepositoryPort_ListCategories_Call) RunAndReturn(run func(ctx context.Context) ([]domain.Category, error)) *MockCategoryRepositoryPort_ListCategories_Call {
+func (_c *MockUserServicePort_UnsuspendUser_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.User, error)) *MockUserServicePort_UnsuspendUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,146 @@
+package diff
+
+import "strings"
+
+type Op string
+
+const (
+	OpEqual  Op = "equal"
+	OpInsert Op = "insert"
+	OpDelete Op = "delete"
+)
+
+// Line is one line of a diff. Inserted lines come from the new text, deleted
+// and equal lines from the old one.
+type Line struct {
+	Op   Op     `json:"op"`
+	Text string `json:"text"`
+}
+
+// MaxEdits bounds the edit distance Lines searches for. Keeping the trace of
+// every step takes memory quadratic in the distance, so texts further apart
+// than this are shown as the old text deleted and the new one inserted.
+const MaxEdits = 1000
+
+// Lines returns the shortest line-based edit script turning a into b, using
+// Myers' O(ND) algorithm. Lines the texts start and end with are equal
+// whatever the distance, so they are matched before searching.
+func Lines(a, b string) []Line {
+	x, y := splitLines(a), splitLines(b)
+
+	pre := 0
+	for pre < len(x) && pre < len(y) && x[pre] == y[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(x)-pre && suf < len(y)-pre && x[len(x)-1-suf] == y[len(y)-1-suf] {
+		suf++
+	}
+
+	var lines []Line
+	for _, text := range x[:pre] {
+		lines = append(lines, Line{Op: OpEqual, Text: text})
+	}
+	lines = append(lines, middle(x[pre:len(x)-suf], y[pre:len(y)-suf])...)
+	for _, text := range x[len(x)-suf:] {
+		lines = append(lines, Line{Op: OpEqual, Text: text})
+	}
+	return lines
+}
+
+// middle diffs x and y, or replaces x with y when they are more than MaxEdits
+// apart
+func middle(x, y []string) []Line {
+	n, m := len(x), len(y)
+	if n+m == 0 {
+		return nil
+	}
+	maxD := min(n+m, MaxEdits)
+	offset := maxD + 1
+
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+	for d := 0; d <= maxD; d++ {
+		// Only diagonals -d-1..d+1 are read when backtracking through step d
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+
+		for k := -d; k <= d; k += 2 {
+			var xi int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				xi = v[offset+k+1]
+			} else {
+				xi = v[offset+k-1] + 1
+			}
+			yi := xi - k
+			for xi < n && yi < m && x[xi] == y[yi] {
+				xi++
+				yi++
+			}
+			v[offset+k] = xi
+
+			if xi >= n && yi >= m {
+				return backtrack(trace, x, y)
+			}
+		}
+	}
+
+	return replace(x, y)
+}
+
+func replace(x, y []string) []Line {
+	lines := make([]Line, 0, len(x)+len(y))
+	for _, text := range x {
+		lines = append(lines, Line{Op: OpDelete, Text: text})
+	}
+	for _, text := range y {
+		lines = append(lines, Line{Op: OpInsert, Text: text})
+	}
+	return lines
+}
+
+func backtrack(trace [][]int, x, y []string) []Line {
+	var lines []Line
+	xi, yi := len(x), len(y)
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+
+		k := xi - yi
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for xi > prevX && yi > prevY {
+			xi--
+			yi--
+			lines = append(lines, Line{Op: OpEqual, Text: x[xi]})
+		}
+		if d > 0 {
+			if xi == prevX {
+				lines = append(lines, Line{Op: OpInsert, Text: y[yi-1]})
+			} else {
+				lines = append(lines, Line{Op: OpDelete, Text: x[xi-1]})
+			}
+		}
+		xi, yi = prevX, prevY
+	}
+
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return lines
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
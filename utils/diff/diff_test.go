@@ -0,0 +1,98 @@
+//go:build unit
+
+package diff_test
+
+import (
+	"blogg/utils/diff"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	t.Run("mark every line of identical texts equal", func(t *testing.T) {
+		assert.Equal(t, []diff.Line{
+			{Op: diff.OpEqual, Text: "a"},
+			{Op: diff.OpEqual, Text: "b"},
+		}, diff.Lines("a\nb\n", "a\r\nb"))
+	})
+
+	t.Run("return nothing for two empty texts", func(t *testing.T) {
+		assert.Empty(t, diff.Lines("", ""))
+	})
+
+	t.Run("insert every line into an empty text", func(t *testing.T) {
+		assert.Equal(t, []diff.Line{
+			{Op: diff.OpInsert, Text: "a"},
+			{Op: diff.OpInsert, Text: "b"},
+		}, diff.Lines("", "a\nb"))
+	})
+
+	t.Run("delete every line of a text that is emptied", func(t *testing.T) {
+		assert.Equal(t, []diff.Line{
+			{Op: diff.OpDelete, Text: "a"},
+			{Op: diff.OpDelete, Text: "b"},
+		}, diff.Lines("a\nb", ""))
+	})
+
+	t.Run("insert lines in the middle", func(t *testing.T) {
+		assert.Equal(t, []diff.Line{
+			{Op: diff.OpEqual, Text: "a"},
+			{Op: diff.OpInsert, Text: "b"},
+			{Op: diff.OpEqual, Text: "c"},
+		}, diff.Lines("a\nc", "a\nb\nc"))
+	})
+
+	t.Run("delete lines in the middle", func(t *testing.T) {
+		assert.Equal(t, []diff.Line{
+			{Op: diff.OpEqual, Text: "a"},
+			{Op: diff.OpDelete, Text: "b"},
+			{Op: diff.OpEqual, Text: "c"},
+		}, diff.Lines("a\nb\nc", "a\nc"))
+	})
+
+	t.Run("find the shortest script", func(t *testing.T) {
+		lines := diff.Lines("a\nb\nc\na\nb\nb\na", "c\nb\na\nb\na\nc")
+		assert.Equal(t, 5, countEdits(lines))
+	})
+
+	t.Run("replace texts further apart than MaxEdits", func(t *testing.T) {
+		n := diff.MaxEdits
+		a := numbered("old", n)
+		b := numbered("new", n)
+
+		lines := diff.Lines("head\n"+a+"\ntail", "head\n"+b+"\ntail")
+		assert.Len(t, lines, 2*n+2)
+		assert.Equal(t, diff.Line{Op: diff.OpEqual, Text: "head"}, lines[0])
+		assert.Equal(t, diff.Line{Op: diff.OpDelete, Text: "old 0"}, lines[1])
+		assert.Equal(t, diff.Line{Op: diff.OpInsert, Text: "new 0"}, lines[n+1])
+		assert.Equal(t, diff.Line{Op: diff.OpEqual, Text: "tail"}, lines[2*n+1])
+	})
+
+	t.Run("diff texts up to MaxEdits apart", func(t *testing.T) {
+		n := diff.MaxEdits / 2
+		lines := diff.Lines(numbered("old", n)+"\nsame", "same\n"+numbered("new", n))
+		assert.Equal(t, diff.MaxEdits, countEdits(lines))
+		assert.Contains(t, lines, diff.Line{Op: diff.OpEqual, Text: "same"})
+	})
+}
+
+func numbered(prefix string, n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%s %d", prefix, i)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func countEdits(lines []diff.Line) int {
+	edits := 0
+	for _, l := range lines {
+		if l.Op != diff.OpEqual {
+			edits++
+		}
+	}
+	return edits
+}
@@ -3,6 +3,7 @@ package errs
 import (
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type AppError struct {
@@ -10,6 +11,9 @@ type AppError struct {
 	Message    string
 	StatusCode int
 	Err        error
+	RetryAfter time.Duration // Sent as Retry-After when set
+
+	base *AppError // The error this one was copied from
 }
 
 func (e *AppError) Error() string {
@@ -24,6 +28,23 @@ func (e *AppError) Unwrap() error {
 	return e.Err
 }
 
+// Is reports copies made with WithRetryAfter as the error they came from
+func (e *AppError) Is(target error) bool {
+	return e.base != nil && e.base == target
+}
+
+// WithRetryAfter returns a copy of e that tells clients when to try again.
+// errors.Is still matches the copy against e.
+func (e *AppError) WithRetryAfter(d time.Duration) *AppError {
+	copied := *e
+	copied.RetryAfter = d
+	copied.base = e
+	if e.base != nil {
+		copied.base = e.base
+	}
+	return &copied
+}
+
 type Params struct {
 	Code       string
 	Message    string
@@ -73,6 +94,14 @@ func NewUnauthorizedError(message string) *AppError {
 	}
 }
 
+func NewForbiddenError(message string) *AppError {
+	return &AppError{
+		Code:       "FORBIDDEN",
+		Message:    message,
+		StatusCode: http.StatusForbidden,
+	}
+}
+
 func NewInternalError(err error) *AppError {
 	return &AppError{
 		Code:       "INTERNAL_ERROR",
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 var (
@@ -15,31 +16,84 @@ var (
 )
 
 type JWTClaims struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid,omitempty"` // Login session the token was issued to
+	MFA       bool   `json:"mfa,omitempty"` // The session was started with a second factor
+	Purpose   string `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
 
 type JWTManager struct {
-	secretKey  string
+	keys       *KeySet
+	keysErr    error // Set when the configured keys could not be loaded
 	expiration time.Duration
 }
 
 const (
-	DefaultJWTExpiration = 24 * time.Hour
+	DefaultJWTExpiration = 15 * time.Minute // Sessions outlive this through refresh tokens
 	DefaultJWTSecret     = "default-secret-key-change-in-production"
+
+	// MFATokenExpiration bounds the time between the password and the code
+	MFATokenExpiration = 5 * time.Minute
+	// OIDCFlowExpiration bounds the time spent at an identity provider
+	OIDCFlowExpiration = 10 * time.Minute
+
+	// AccessTokenType and AccessTokenAudience mark access tokens, in the typ
+	// header (RFC 9068) and the aud claim. MFA and OIDC flow tokens are signed
+	// with the same keys, so anyone verifying tokens against the JWKS must
+	// check both before treating a token as a login.
+	AccessTokenType     = "at+jwt"
+	AccessTokenAudience = "blogg"
+
+	// Tokens that only this service reads
+	internalTokenType = "JWT"
+	mfaAudience       = "blogg:mfa"
+	oidcFlowAudience  = "blogg:oidc-flow"
+
+	purposeMFA  = "mfa"
+	purposeOIDC = "oidc"
 )
 
+func init() {
+	// Revocations of all of a user's tokens compare iat with the moment of
+	// revocation, so a token issued later in the same second must not look
+	// older than it. NumericDate may be fractional (RFC 7519 section 2).
+	jwt.TimePrecision = time.Microsecond
+}
+
+// OIDCFlowClaims carry the secrets of an OIDC login from the redirect to the
+// provider until the callback
+type OIDCFlowClaims struct {
+	Purpose      string `json:"purpose"`
+	Provider     string `json:"provider"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+	jwt.RegisteredClaims
+}
+
+// NewJWTManager signs tokens with HS256 and secretKey, or with the keys from
+// DefaultKeySet when secretKey is empty
 func NewJWTManager(secretKey string, expiration time.Duration) *JWTManager {
-	if secretKey == "" {
-		secretKey = getEnv("JWT_SECRET", DefaultJWTSecret)
+	if secretKey != "" {
+		return NewJWTManagerWithKeys(NewHMACKeySet(secretKey), expiration)
 	}
+
+	keys, err := DefaultKeySet()
+	jm := NewJWTManagerWithKeys(keys, expiration)
+	jm.keysErr = err
+	return jm
+}
+
+func NewJWTManagerWithKeys(keys *KeySet, expiration time.Duration) *JWTManager {
 	if expiration <= 0 {
 		expiration = getEnvAsDuration("JWT_EXPIRATION", DefaultJWTExpiration)
 	}
 
 	return &JWTManager{
-		secretKey:  secretKey,
+		keys:       keys,
 		expiration: expiration,
 	}
 }
@@ -48,50 +102,150 @@ func NewDefaultJWTManager() *JWTManager {
 	return NewJWTManager("", 0)
 }
 
-func (jm *JWTManager) GenerateToken(userID, username string) (string, error) {
+func (jm *JWTManager) GenerateToken(userID, username, role, sessionID string, mfa bool) (string, error) {
 	claims := JWTClaims{
-		UserID:   userID,
-		Username: username,
+		UserID:    userID,
+		Username:  username,
+		Role:      role,
+		SessionID: sessionID,
+		MFA:       mfa,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(), // jti, the handle used to revoke this token
+			Audience:  jwt.ClaimStrings{AccessTokenAudience},
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(jm.expiration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(jm.secretKey))
+	return jm.sign(claims, AccessTokenType)
 }
 
+// GenerateMFAToken signs a short-lived token proving that userID passed the
+// password step of a login. It is not accepted as an access token.
+func (jm *JWTManager) GenerateMFAToken(userID string) (string, error) {
+	now := time.Now()
+	claims := JWTClaims{
+		UserID:  userID,
+		Purpose: purposeMFA,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Audience:  jwt.ClaimStrings{mfaAudience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(MFATokenExpiration)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	return jm.sign(claims, internalTokenType)
+}
+
+// Validate parses an access token
 func (jm *JWTManager) Validate(tokenString string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return []byte(jm.secretKey), nil
-	})
+	claims, err := jm.parse(tokenString, AccessTokenType, AccessTokenAudience)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Purpose != "" {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
 
+// ValidateMFAToken parses a token from GenerateMFAToken
+func (jm *JWTManager) ValidateMFAToken(tokenString string) (*JWTClaims, error) {
+	claims, err := jm.parse(tokenString, internalTokenType, mfaAudience)
 	if err != nil {
-		if errors.Is(err, jwt.ErrTokenExpired) {
-			return nil, ErrExpiredToken
-		}
+		return nil, err
+	}
+	if claims.Purpose != purposeMFA {
 		return nil, ErrInvalidToken
 	}
+	return claims, nil
+}
+
+// GenerateOIDCFlowToken signs the state, nonce and PKCE verifier of an OIDC
+// login so that they can be kept in a cookie
+func (jm *JWTManager) GenerateOIDCFlowToken(provider, state, nonce, codeVerifier string) (string, error) {
+	now := time.Now()
+	claims := OIDCFlowClaims{
+		Purpose:      purposeOIDC,
+		Provider:     provider,
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{oidcFlowAudience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(OIDCFlowExpiration)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+
+	return jm.sign(claims, internalTokenType)
+}
 
-	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
-		return claims, nil
+// ValidateOIDCFlowToken parses a token from GenerateOIDCFlowToken
+func (jm *JWTManager) ValidateOIDCFlowToken(tokenString string) (*OIDCFlowClaims, error) {
+	claims := &OIDCFlowClaims{}
+	if err := jm.parseClaims(tokenString, claims, internalTokenType, oidcFlowAudience); err != nil {
+		return nil, err
+	}
+	if claims.Purpose != purposeOIDC {
+		return nil, ErrInvalidToken
 	}
+	return claims, nil
+}
 
-	return nil, ErrInvalidToken
+// JWKS returns the public keys that verify tokens from this manager
+func (jm *JWTManager) JWKS() JWKSet {
+	if jm.keysErr != nil {
+		return JWKSet{Keys: []JWK{}}
+	}
+	return jm.keys.JWKS()
 }
 
-func (jm *JWTManager) RefreshToken(tokenString string) (string, error) {
-	claims, err := jm.Validate(tokenString)
-	if err != nil && !errors.Is(err, ErrExpiredToken) {
-		return "", err
+func (jm *JWTManager) sign(claims jwt.Claims, typ string) (string, error) {
+	if jm.keysErr != nil {
+		return "", jm.keysErr
 	}
+	return jm.keys.sign(claims, typ)
+}
+
+func (jm *JWTManager) keyFunc(t *jwt.Token) (any, error) {
+	if jm.keysErr != nil {
+		return nil, jm.keysErr
+	}
+	return jm.keys.keyFunc(t)
+}
+
+func (jm *JWTManager) parse(tokenString, typ, audience string) (*JWTClaims, error) {
+	claims := &JWTClaims{}
+	if err := jm.parseClaims(tokenString, claims, typ, audience); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// parseClaims verifies the token and that it was issued as typ for audience,
+// so that one kind of token is never accepted as another
+func (jm *JWTManager) parseClaims(tokenString string, claims jwt.Claims, typ, audience string) error {
+	token, err := jwt.ParseWithClaims(tokenString, claims, jm.keyFunc, jwt.WithAudience(audience))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return ErrExpiredToken
+		}
+		return ErrInvalidToken
+	}
+
+	if !token.Valid || token.Header["typ"] != typ {
+		return ErrInvalidToken
+	}
+	return nil
+}
 
-	return jm.GenerateToken(claims.UserID, claims.Username)
+// Expiration returns the lifetime of generated tokens
+func (jm *JWTManager) Expiration() time.Duration {
+	return jm.expiration
 }
 
 func getEnv(key, defaultValue string) string {
@@ -0,0 +1,337 @@
+package jwthelper
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits is the smallest RSA key accepted for signing or verification
+const minRSABits = 2048
+
+// KeySet holds the key new tokens are signed with and every key tokens are
+// still verified with. Asymmetric keys are named by their JWK thumbprint
+// (RFC 7638), which goes into the kid header, so a key keeps its kid when it
+// moves from signing to verification only. To rotate, sign with a new key
+// and keep the old public key until the tokens it signed have expired.
+type KeySet struct {
+	method  jwt.SigningMethod
+	signKID string // Empty for HMAC
+	signKey any    // []byte, ed25519.PrivateKey or *rsa.PrivateKey
+
+	public map[string]publicKey // By kid
+	order  []string             // Public kids, signing key first
+
+	// secret verifies HS256 tokens: the signing key in HMAC mode, or the
+	// previous secret while moving to asymmetric keys
+	secret        []byte
+	defaultSecret bool
+}
+
+type publicKey struct {
+	method jwt.SigningMethod
+	key    crypto.PublicKey
+	jwk    JWK
+}
+
+// JWK is a public key in JSON Web Key form (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"` // OKP
+	X   string `json:"x,omitempty"`   // OKP
+	N   string `json:"n,omitempty"`   // RSA
+	E   string `json:"e,omitempty"`   // RSA
+}
+
+// JWKSet is the document served at /.well-known/jwks.json
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewHMACKeySet signs and verifies tokens with HS256 and secret
+func NewHMACKeySet(secret string) *KeySet {
+	return &KeySet{
+		method:        jwt.SigningMethodHS256,
+		signKey:       []byte(secret),
+		public:        map[string]publicKey{},
+		secret:        []byte(secret),
+		defaultSecret: secret == DefaultJWTSecret,
+	}
+}
+
+// NewKeySet signs tokens with signer, using EdDSA for Ed25519 keys and RS256
+// for RSA keys. Tokens signed by any of previous are still accepted.
+func NewKeySet(signer crypto.Signer, previous ...crypto.PublicKey) (*KeySet, error) {
+	ks := &KeySet{public: map[string]publicKey{}}
+
+	signing, err := ks.addPublicKey(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	ks.method = signing.method
+	ks.signKID = signing.jwk.Kid
+	ks.signKey = signer
+
+	for _, key := range previous {
+		if _, err := ks.addPublicKey(key); err != nil {
+			return nil, err
+		}
+	}
+	return ks, nil
+}
+
+// AcceptSecret makes ks accept HS256 tokens signed with secret, so that
+// sessions survive the move from a shared secret to asymmetric keys. Drop it
+// once those tokens have expired.
+func (ks *KeySet) AcceptSecret(secret string) {
+	ks.secret = []byte(secret)
+	ks.defaultSecret = secret == DefaultJWTSecret
+}
+
+// UsesDefaultSecret reports whether tokens are signed or verified with
+// DefaultJWTSecret, which anyone reading the source can forge tokens with
+func (ks *KeySet) UsesDefaultSecret() bool {
+	return ks.defaultSecret
+}
+
+// Algorithm is the alg new tokens are signed with
+func (ks *KeySet) Algorithm() string {
+	return ks.method.Alg()
+}
+
+// JWKS returns the public keys tokens are verified with. It is empty for an
+// HMAC key set, whose secret cannot be published.
+func (ks *KeySet) JWKS() JWKSet {
+	set := JWKSet{Keys: make([]JWK, 0, len(ks.order))}
+	for _, kid := range ks.order {
+		set.Keys = append(set.Keys, ks.public[kid].jwk)
+	}
+	return set
+}
+
+func (ks *KeySet) sign(claims jwt.Claims, typ string) (string, error) {
+	token := jwt.NewWithClaims(ks.method, claims)
+	token.Header["typ"] = typ
+	if ks.signKID != "" {
+		token.Header["kid"] = ks.signKID
+	}
+	return token.SignedString(ks.signKey)
+}
+
+// keyFunc picks the key named by the kid header, and only for the algorithm
+// that key was published with
+func (ks *KeySet) keyFunc(t *jwt.Token) (any, error) {
+	if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+		if ks.secret == nil {
+			return nil, ErrInvalidToken
+		}
+		return ks.secret, nil
+	}
+
+	kid, _ := t.Header["kid"].(string)
+	key, ok := ks.public[kid]
+	if !ok || key.method.Alg() != t.Method.Alg() {
+		return nil, ErrInvalidToken
+	}
+	return key.key, nil
+}
+
+func (ks *KeySet) addPublicKey(key crypto.PublicKey) (publicKey, error) {
+	var pk publicKey
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		pk = publicKey{
+			method: jwt.SigningMethodEdDSA,
+			key:    k,
+			jwk: JWK{
+				Kty: "OKP",
+				Use: "sig",
+				Alg: jwt.SigningMethodEdDSA.Alg(),
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(k),
+			},
+		}
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSABits {
+			return pk, fmt.Errorf("jwt: RSA key has %d bits, need at least %d", k.N.BitLen(), minRSABits)
+		}
+		pk = publicKey{
+			method: jwt.SigningMethodRS256,
+			key:    k,
+			jwk: JWK{
+				Kty: "RSA",
+				Use: "sig",
+				Alg: jwt.SigningMethodRS256.Alg(),
+				N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+			},
+		}
+	default:
+		return pk, fmt.Errorf("jwt: unsupported key type %T, use Ed25519 or RSA", key)
+	}
+
+	pk.jwk.Kid = thumbprint(pk.jwk)
+	if _, ok := ks.public[pk.jwk.Kid]; !ok {
+		ks.order = append(ks.order, pk.jwk.Kid)
+	}
+	ks.public[pk.jwk.Kid] = pk
+	return pk, nil
+}
+
+// thumbprint is the RFC 7638 thumbprint of k: the SHA-256 of its required
+// members in lexicographic order
+func thumbprint(k JWK) string {
+	var members []byte
+	switch k.Kty {
+	case "OKP":
+		members, _ = json.Marshal(struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{k.Crv, k.Kty, k.X})
+	case "RSA":
+		members, _ = json.Marshal(struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{k.E, k.Kty, k.N})
+	}
+	sum := sha256.Sum256(members)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+var (
+	defaultKeysOnce sync.Once
+	defaultKeys     *KeySet
+	defaultKeysErr  error
+)
+
+// DefaultKeySet loads the keys configured in the environment, once:
+//
+//   - JWT_PRIVATE_KEY_FILE: PEM Ed25519 or RSA private key to sign with. When
+//     unset, tokens are signed with HS256 and JWT_SECRET.
+//   - JWT_PUBLIC_KEY_FILES: comma separated PEM files of previous public keys
+//     that are still accepted.
+//   - JWT_SECRET: the HS256 secret. Alongside a private key it is only
+//     accepted for verification.
+func DefaultKeySet() (*KeySet, error) {
+	defaultKeysOnce.Do(func() {
+		defaultKeys, defaultKeysErr = loadKeySet()
+	})
+	return defaultKeys, defaultKeysErr
+}
+
+func loadKeySet() (*KeySet, error) {
+	privateFile := os.Getenv("JWT_PRIVATE_KEY_FILE")
+	if privateFile == "" {
+		return NewHMACKeySet(getEnv("JWT_SECRET", DefaultJWTSecret)), nil
+	}
+
+	data, err := os.ReadFile(privateFile)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: reading private key: %w", err)
+	}
+	signer, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: %s: %w", privateFile, err)
+	}
+
+	var previous []crypto.PublicKey
+	for _, file := range strings.Split(os.Getenv("JWT_PUBLIC_KEY_FILES"), ",") {
+		file = strings.TrimSpace(file)
+		if file == "" {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: reading public key: %w", err)
+		}
+		keys, err := parsePublicKeys(data)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: %s: %w", file, err)
+		}
+		previous = append(previous, keys...)
+	}
+
+	ks, err := NewKeySet(signer, previous...)
+	if err != nil {
+		return nil, err
+	}
+	if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		ks.AcceptSecret(secret)
+	}
+	return ks, nil
+}
+
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported key type %T", key)
+		}
+		return signer, nil
+	default:
+		return nil, fmt.Errorf("unexpected PEM block %q", block.Type)
+	}
+}
+
+// parsePublicKeys reads every PEM block in data, so one file can hold all
+// the keys being retired
+func parsePublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		data = rest
+
+		var key crypto.PublicKey
+		var err error
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		default:
+			err = fmt.Errorf("unexpected PEM block %q", block.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM block found")
+	}
+	return keys, nil
+}
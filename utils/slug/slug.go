@@ -0,0 +1,73 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Make builds a URL slug from s. Letters (including non-Latin scripts and their
+// combining marks) and digits are lowercased and kept; every other run of
+// characters collapses into a single hyphen.
+func Make(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+
+	return b.String()
+}
+
+// asciiFolds covers Latin letters that have no decomposed form to strip
+var asciiFolds = strings.NewReplacer("ß", "ss", "æ", "ae", "œ", "oe", "ø", "o", "đ", "d", "ł", "l", "þ", "th", "ð", "d")
+
+// MakeASCII builds a slug made only of a-z, 0-9 and hyphens. Accented Latin
+// letters lose their accents; characters with no ASCII equivalent, such as Thai
+// script, are dropped. The result may be empty.
+func MakeASCII(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+
+	folded := asciiFolds.Replace(strings.ToLower(s))
+	for _, r := range norm.NFKD.String(folded) {
+		// Stripped accents and vowel signs are part of the surrounding word
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+
+	return b.String()
+}
+
+// Truncate shortens a slug to at most max bytes, cutting at a hyphen where
+// possible so words are not split
+func Truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	s = s[:max]
+	if i := strings.LastIndexByte(s, '-'); i > 0 {
+		s = s[:i]
+	}
+	return strings.TrimRight(s, "-")
+}
@@ -0,0 +1,75 @@
+//go:build unit
+
+package slug_test
+
+import (
+	"blogg/utils/slug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "lowercase and hyphenate words", in: "Hello, World!", want: "hello-world"},
+		{name: "collapse runs of separators", in: "  Go --  web__apps  ", want: "go-web-apps"},
+		{name: "keep non-Latin letters and their marks", in: "สวัสดี ชาวโลก", want: "สวัสดี-ชาวโลก"},
+		{name: "keep accented letters", in: "Café Crème", want: "café-crème"},
+		{name: "return nothing for empty input", in: "", want: ""},
+		{name: "return nothing for punctuation only", in: "?!...", want: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, slug.Make(tc.in))
+		})
+	}
+}
+
+func TestMakeASCII(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "lowercase and hyphenate words", in: "Hello, World!", want: "hello-world"},
+		{name: "strip accents", in: "Café Crème Brûlée", want: "cafe-creme-brulee"},
+		{name: "fold letters without a decomposed form", in: "Straße Æsir Øresund Łódź", want: "strasse-aesir-oresund-lodz"},
+		{name: "fold compatibility characters", in: "Ｇｏ ﬁle", want: "go-file"},
+		{name: "drop scripts with no ASCII form", in: "สวัสดี Go", want: "go"},
+		{name: "return nothing for a title in another script", in: "สวัสดี", want: ""},
+		{name: "return nothing for empty input", in: "", want: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, slug.MakeASCII(tc.in))
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		max  int
+		want string
+	}{
+		{name: "leave a short slug alone", in: "hello-world", max: 20, want: "hello-world"},
+		{name: "leave a slug of exactly max alone", in: "hello-world", max: 11, want: "hello-world"},
+		{name: "cut at the last hyphen", in: "hello-wonderful-world", max: 12, want: "hello"},
+		{name: "drop a hyphen at the cut", in: "hello-world", max: 6, want: "hello"},
+		{name: "cut a single long word", in: "supercalifragilistic", max: 5, want: "super"},
+		{name: "return nothing for empty input", in: "", max: 10, want: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, slug.Truncate(tc.in, tc.max))
+		})
+	}
+}
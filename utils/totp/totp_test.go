@@ -0,0 +1,85 @@
+//go:build unit
+
+package totp_test
+
+import (
+	"blogg/utils/totp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA-1 seed of RFC 6238 appendix B, "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B, truncated from 8 to 6 digits as RFC 4226 does
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+
+	for _, tc := range tests {
+		t.Run(time.Unix(tc.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			code, err := totp.Code(rfcSecret, totp.Step(time.Unix(tc.unix, 0)))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, code)
+		})
+	}
+
+	t.Run("accept a lowercase secret", func(t *testing.T) {
+		code, err := totp.Code(strings.ToLower(rfcSecret), totp.Step(time.Unix(59, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, "287082", code)
+	})
+
+	t.Run("reject a secret that is not base32", func(t *testing.T) {
+		_, err := totp.Code("not base32!", 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := totp.Step(now)
+	codeAt := func(t *testing.T, step int64) string {
+		code, err := totp.Code(rfcSecret, step)
+		require.NoError(t, err)
+		return code
+	}
+
+	for offset := int64(-totp.Skew); offset <= totp.Skew; offset++ {
+		step, ok := totp.Validate(rfcSecret, codeAt(t, current+offset), now)
+		assert.True(t, ok, "step %+d is inside the skew window", offset)
+		assert.Equal(t, current+offset, step)
+	}
+
+	for _, offset := range []int64{-totp.Skew - 1, totp.Skew + 1} {
+		_, ok := totp.Validate(rfcSecret, codeAt(t, current+offset), now)
+		assert.False(t, ok, "step %+d is outside the skew window", offset)
+	}
+
+	t.Run("reject a code of the wrong length", func(t *testing.T) {
+		_, ok := totp.Validate(rfcSecret, "05047", now)
+		assert.False(t, ok)
+	})
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32, "160 bits in unpadded base32")
+
+	_, err = totp.Code(secret, 1)
+	assert.NoError(t, err)
+}